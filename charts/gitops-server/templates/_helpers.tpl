@@ -83,3 +83,51 @@ Return the target Kubernetes version
 {{- default .Capabilities.KubeVersion.Version .Values.kubeVersion -}}
 {{- end -}}
 {{- end -}}
+
+{{/*
+Rules the cluster fetchers need in the namespace they discover clusters in
+*/}}
+{{- define "chart.clusterFetcherRules" -}}
+{{- if has "secrets" .Values.clusterFetchers.names }}
+- apiGroups: [ "" ]
+  resources: [ "secrets" ]
+  verbs: [ "list" ]
+{{- end }}
+{{- if has "capi" .Values.clusterFetchers.names }}
+- apiGroups: [ "cluster.x-k8s.io" ]
+  resources: [ "clusters" ]
+  verbs: [ "list" ]
+- apiGroups: [ "" ]
+  resources: [ "secrets" ]
+  verbs: [ "get" ]
+{{- end }}
+{{- end }}
+
+{{/*
+Rules the server needs in its own namespace
+*/}}
+{{- define "chart.serverNamespaceRules" -}}
+{{- if eq .Values.reconciliationHistory.store "configmap" }}
+# The reconciliation history is kept in one ConfigMap per object
+- apiGroups: [ "" ]
+  resources: [ "configmaps" ]
+  verbs: [ "get", "list", "create", "update", "delete" ]
+{{- end }}
+{{- if has "api-token" .Values.authMethods }}
+# The API tokens are kept in the gitops-api-tokens Secret, which can't be
+# restricted by name when it's created
+- apiGroups: [ "" ]
+  resources: [ "secrets" ]
+  verbs: [ "get", "update" ]
+  resourceNames: [ "gitops-api-tokens" ]
+- apiGroups: [ "" ]
+  resources: [ "secrets" ]
+  verbs: [ "create" ]
+{{- end }}
+{{- if eq .Values.sessionStore "secrets" }}
+# Each session is kept in a Secret
+- apiGroups: [ "" ]
+  resources: [ "secrets" ]
+  verbs: [ "get", "list", "create", "update", "delete" ]
+{{- end }}
+{{- end }}
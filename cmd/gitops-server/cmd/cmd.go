@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"io/fs"
 	"net"
@@ -30,12 +31,17 @@ import (
 	k8sMetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/core/audit"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/fetcher"
+	"github.com/weaveworks/weave-gitops/core/fluxmetrics"
+	"github.com/weaveworks/weave-gitops/core/history"
 	"github.com/weaveworks/weave-gitops/core/logger"
 	"github.com/weaveworks/weave-gitops/core/nsaccess"
+	"github.com/weaveworks/weave-gitops/core/search"
 	core "github.com/weaveworks/weave-gitops/core/server"
+	"github.com/weaveworks/weave-gitops/pkg/configmaps"
 	"github.com/weaveworks/weave-gitops/pkg/featureflags"
 	"github.com/weaveworks/weave-gitops/pkg/health"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
@@ -43,12 +49,23 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
 	"github.com/weaveworks/weave-gitops/pkg/telemetry"
+	"github.com/weaveworks/weave-gitops/pkg/tracing"
 )
 
 const (
 	// Allowed login requests per second
 	loginRequestRateLimit            = 20
 	InsecureNoAuthenticationUserFlag = "insecure-no-authentication-user"
+	// Reconciliation history stores
+	reconciliationHistoryMemory    = "memory"
+	reconciliationHistoryConfigMap = "configmap"
+	// Audit sinks
+	auditSinkStdout = "stdout"
+	auditSinkFile   = "file"
+	auditSinkEvents = "events"
+	// Session stores
+	sessionStoreMemory  = "memory"
+	sessionStoreSecrets = "secrets"
 )
 
 // Options contains all the options for the gitops-server command.
@@ -79,6 +96,32 @@ type Options struct {
 	// Metrics
 	EnableMetrics  bool
 	MetricsAddress string
+	// Clusters
+	ClusterFetchers         []string
+	ClusterFetcherNamespace string
+	// Reconciliation history
+	ReconciliationHistory     string
+	ReconciliationHistorySize int
+	// Freeze windows
+	FreezeWindows bool
+	// Search
+	SearchIndex bool
+	// Controller logs
+	ControllerLogs bool
+	// Health checks
+	HealthChecksConfigMap string
+	// Audit log
+	AuditSinks     []string
+	AuditFile      string
+	AuditMaxEvents int
+	// Policies
+	PoliciesConfigMap string
+	// Sessions
+	SessionLifetime    time.Duration
+	SessionIdleTimeout time.Duration
+	SessionStore       string
+	// Tracing
+	Tracing tracing.Options
 
 	UseK8sCachedClients bool
 }
@@ -118,18 +161,57 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().StringVar(&options.OIDC.RedirectURL, "oidc-redirect-url", "", "The OAuth2 redirect URL")
 	cmd.Flags().DurationVar(&options.OIDC.TokenDuration, "oidc-token-duration", time.Hour, "The duration of the ID token. It should be set in the format: number + time unit (s,m,h) e.g., 20m")
 	cmd.Flags().StringVar(&options.OIDC.ClaimsConfig.Username, "oidc-username-claim", auth.ClaimUsername, "JWT claim to use as the user name. By default email, which is expected to be a unique identifier of the end user. Admins can choose other claims, such as sub or name, depending on their provider")
-	cmd.Flags().StringVar(&options.OIDC.ClaimsConfig.Groups, "oidc-groups-claim", auth.ClaimGroups, "JWT claim to use as the user's group. If the claim is present it must be an array of strings")
+	cmd.Flags().StringVar(&options.OIDC.ClaimsConfig.Groups, "oidc-groups-claim", auth.ClaimGroups, "JWT claim to use as the user's group. If the claim is present it must be an array of strings. Nested claims can be used with a path separated by dots, e.g. realm_access.roles")
+	cmd.Flags().BoolVar(&options.OIDC.ClaimsConfig.UserInfo, "oidc-groups-from-userinfo", false, "Add the claims the ID token doesn't have from the provider's userinfo endpoint, for providers that leave groups out of ID tokens. The userinfo response must be about the ID token's subject. The claims are cached for 5 minutes per access token")
+	cmd.Flags().StringVar(&options.OIDC.ClaimsConfig.GroupsFilter, "oidc-groups-filter", "", "Regular expression the user's groups must match to be used when impersonating, e.g. ^gitops-")
+	cmd.Flags().StringArrayVar(&options.OIDC.ClaimsConfig.GroupsMappings, "oidc-groups-mapping", []string{}, "Rename the user's groups before impersonating, as pattern=replacement, e.g. ^gitops-(.*)$=$1. The first matching mapping is used, and groups renamed to an empty string are dropped. Can be repeated")
 	cmd.Flags().StringSliceVar(&options.OIDC.Scopes, "custom-oidc-scopes", auth.DefaultScopes, "Customise the requested scopes for then OIDC authentication flow - openid will always be requested")
 	// OIDC prefixes
 	cmd.Flags().StringVar(&options.OIDC.UsernamePrefix, "oidc-username-prefix", "", "Prefix to add to the username when impersonating")
 	cmd.Flags().StringVar(&options.OIDC.GroupsPrefix, "oidc-group-prefix", "", "Prefix to add to the groups when impersonating")
 	// auth
 	cmd.Flags().StringVar(&options.NoAuthUser, InsecureNoAuthenticationUserFlag, "", "A kubernetes user to impersonate for all requests, no authentication will be performed")
+	cmd.Flags().DurationVar(&options.SessionLifetime, "session-lifetime", 0, "How long users stay signed in, the OIDC token duration (1h by default) if 0. OIDC ID tokens that expire during the session are renewed with the refresh token")
+	cmd.Flags().DurationVar(&options.SessionIdleTimeout, "session-idle-timeout", 0, "Sign users out after they haven't used the dashboard for this long, e.g. 30m. Disabled if 0")
+	cmd.Flags().StringVar(&options.SessionStore, "session-store", sessionStoreMemory, fmt.Sprintf("Where sessions are kept, valid values are %s,%s. With %s sessions survive restarts and are shared by replicas, and the service account needs to manage Secrets in the server's namespace", sessionStoreMemory, sessionStoreSecrets, sessionStoreSecrets))
 
 	// Metrics
-	cmd.Flags().BoolVar(&options.EnableMetrics, "enable-metrics", false, "Starts the metrics listener")
+	cmd.Flags().BoolVar(&options.EnableMetrics, "enable-metrics", false, "Starts the metrics listener, which also exports the readiness of the Flux objects on all clusters from the search index, refreshed every 30 seconds. The service account needs to list and watch Kustomizations, HelmReleases and sources")
 	cmd.Flags().StringVar(&options.MetricsAddress, "metrics-address", ":2112", "If the metrics listener is enabled, bind to this address")
 
+	// Clusters
+	cmd.Flags().StringSliceVar(&options.ClusterFetchers, "cluster-fetchers", []string{fetcher.DefaultFetcherName}, fmt.Sprintf("Which cluster fetchers to use to discover clusters, valid values are %s", strings.Join(fetcher.AllFetcherNames(), ",")))
+	cmd.Flags().StringVar(&options.ClusterFetcherNamespace, "cluster-fetcher-namespace", "", "Namespace to discover kubeconfig Secrets and CAPI Clusters in, all namespaces if empty")
+
+	// Reconciliation history
+	cmd.Flags().StringVar(&options.ReconciliationHistory, "reconciliation-history", "", fmt.Sprintf("Record the reconciliation history of Kustomizations and HelmReleases, valid values are %s. Disabled if empty. The service account needs to list Kustomizations and HelmReleases, and to get, list, create, update and delete the %s-* ConfigMaps, one per object, when using %s", strings.Join([]string{reconciliationHistoryMemory, reconciliationHistoryConfigMap}, ","), history.DefaultConfigMapName, reconciliationHistoryConfigMap))
+	cmd.Flags().IntVar(&options.ReconciliationHistorySize, "reconciliation-history-size", history.DefaultMaxRecords, "Number of reconciliations to keep for each object")
+
+	// Freeze windows
+	cmd.Flags().BoolVar(&options.FreezeWindows, "freeze-windows", false, "Allow suspending Flux objects until a given time or during recurring freeze windows, and resume them automatically. The service account needs to list and patch Flux objects")
+
+	// Search
+	cmd.Flags().BoolVar(&options.SearchIndex, "search-index", false, "Index the Flux objects on all clusters in memory to search them, refreshed every 30 seconds from watches. The service account needs to list and watch Flux objects")
+
+	// Controller logs
+	cmd.Flags().BoolVar(&options.ControllerLogs, "controller-logs", false, "Let users read the entries about the objects they can read from the logs of the Flux controllers. The service account needs to list deployments and pods, and to get pods/log, in the Flux namespaces")
+
+	// Health checks
+	cmd.Flags().StringVar(&options.HealthChecksConfigMap, "health-checks-configmap", "", "Name of a ConfigMap in the server's namespace with CEL health check rules for custom resources, reloaded when changed. The service account needs to get the ConfigMap")
+
+	// Audit log
+	cmd.Flags().StringSliceVar(&options.AuditSinks, "audit-sinks", []string{}, fmt.Sprintf("Where to write the audit log of the actions users take, valid values are %s. The service account needs to create Events when using %s, the Events of cluster-scoped objects are created in the server's namespace. Only the most recent events are kept in memory to be listed, the sinks are the durable record", strings.Join([]string{auditSinkStdout, auditSinkFile, auditSinkEvents}, ","), auditSinkEvents))
+	cmd.Flags().StringVar(&options.AuditFile, "audit-file", "", fmt.Sprintf("Path of the file the audit log is appended to, as JSON lines, when using %s", auditSinkFile))
+	cmd.Flags().IntVar(&options.AuditMaxEvents, "audit-max-events", audit.DefaultMaxEvents, "Number of the most recent audit events kept in memory to be listed")
+
+	// Policies
+	cmd.Flags().StringVar(&options.PoliciesConfigMap, "policies-configmap", "", "Name of a ConfigMap in the server's namespace with the policies allowing users to sync, suspend and resume objects, and to read session logs and audit events, on top of their Kubernetes RBAC. These are denied when the ConfigMap doesn't exist, and allowed to everyone if empty. Reloaded when changed. The service account needs to get the ConfigMap")
+
+	// Tracing
+	cmd.Flags().StringVar(&options.Tracing.Exporter, "tracing-exporter", tracing.ExporterNone, fmt.Sprintf("Where to export the OpenTelemetry traces of requests and of the calls to the clusters, valid values are %s", strings.Join(tracing.AllExporters(), ",")))
+	cmd.Flags().StringVar(&options.Tracing.Endpoint, "tracing-endpoint", "", fmt.Sprintf("URL of the OTLP collector the traces are sent to when using %s or %s, e.g. http://otel-collector:4318. The OTEL_EXPORTER_OTLP_* environment variables are used if empty", tracing.ExporterOTLPGRPC, tracing.ExporterOTLPHTTP))
+	cmd.Flags().StringVar(&options.Tracing.File, "tracing-file", "", fmt.Sprintf("Path of the file the traces are appended to, as JSON lines, when using %s", tracing.ExporterFile))
+
 	return cmd
 }
 
@@ -147,6 +229,42 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("%s flag set but no user specified", InsecureNoAuthenticationUserFlag)
 	}
 
+	if err := fetcher.ValidateFetcherNames(options.ClusterFetchers); err != nil {
+		return err
+	}
+
+	switch options.ReconciliationHistory {
+	case "", reconciliationHistoryMemory, reconciliationHistoryConfigMap:
+	default:
+		return fmt.Errorf("invalid reconciliation history %q, valid values are %s,%s", options.ReconciliationHistory, reconciliationHistoryMemory, reconciliationHistoryConfigMap)
+	}
+
+	for _, sink := range options.AuditSinks {
+		switch sink {
+		case auditSinkStdout, auditSinkEvents:
+		case auditSinkFile:
+			if options.AuditFile == "" {
+				return fmt.Errorf("the %s audit sink needs --audit-file to be set", auditSinkFile)
+			}
+		default:
+			return fmt.Errorf("invalid audit sink %q, valid values are %s,%s,%s", sink, auditSinkStdout, auditSinkFile, auditSinkEvents)
+		}
+	}
+
+	switch options.SessionStore {
+	case sessionStoreMemory, sessionStoreSecrets:
+	default:
+		return fmt.Errorf("invalid session store %q, valid values are %s,%s", options.SessionStore, sessionStoreMemory, sessionStoreSecrets)
+	}
+
+	if options.SessionLifetime < 0 || options.SessionIdleTimeout < 0 {
+		return errors.New("--session-lifetime and --session-idle-timeout can't be negative")
+	}
+
+	if err := options.Tracing.Validate(); err != nil {
+		return err
+	}
+
 	mux := http.NewServeMux()
 
 	mux.Handle("/health/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -185,15 +303,23 @@ func runCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	sessionManager := scs.New()
-	// TODO: Make this configurable
-	sessionManager.Lifetime = 24 * time.Hour
+
+	if options.SessionStore == sessionStoreSecrets {
+		sessionStore := auth.NewSecretSessionStore(rawClient, namespace)
+		sessionManager.Store = sessionStore
+
+		go sessionStore.StartCleanup(cmd.Context(), log)
+	}
+
 	authServer, err := auth.InitAuthServer(cmd.Context(), log, rawClient, auth.AuthParams{
-		OIDCConfig:        options.OIDC,
-		OIDCSecretName:    options.OIDCSecret,
-		AuthMethodStrings: options.AuthMethods,
-		NoAuthUser:        options.NoAuthUser,
-		Namespace:         namespace,
-		SessionManager:    sessionManager,
+		OIDCConfig:         options.OIDC,
+		OIDCSecretName:     options.OIDCSecret,
+		AuthMethodStrings:  options.AuthMethods,
+		NoAuthUser:         options.NoAuthUser,
+		Namespace:          namespace,
+		SessionManager:     sessionManager,
+		SessionLifetime:    options.SessionLifetime,
+		SessionIdleTimeout: options.SessionIdleTimeout,
 	})
 	if err != nil {
 		return fmt.Errorf("could not initialise authentication server: %w", err)
@@ -207,6 +333,11 @@ func runCmd(cmd *cobra.Command, args []string) error {
 
 	ctx := context.Background()
 
+	shutdownTracing, err := tracing.Setup(ctx, options.Tracing)
+	if err != nil {
+		return err
+	}
+
 	oidcPrefixes := kube.UserPrefixes{
 		UsernamePrefix: options.OIDC.UsernamePrefix,
 		GroupsPrefix:   options.OIDC.GroupsPrefix,
@@ -242,18 +373,111 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		cl = cluster.NewDelegatingCacheCluster(cl, rest, scheme)
 	}
 
-	fetcher := fetcher.NewSingleClusterFetcher(cl)
+	fetchers := []clustersmngr.ClusterFetcher{}
+
+	for _, name := range options.ClusterFetchers {
+		switch name {
+		case fetcher.DefaultFetcherName:
+			fetchers = append(fetchers, fetcher.NewSingleClusterFetcher(cl))
+		case fetcher.SecretsFetcherName:
+			fetchers = append(fetchers, fetcher.NewSecretsClusterFetcher(log, rawClient, options.ClusterFetcherNamespace, scheme, oidcPrefixes, cluster.DefaultKubeConfigOptions...))
+		case fetcher.CAPIFetcherName:
+			fetchers = append(fetchers, fetcher.NewCAPIClusterFetcher(log, rawClient, options.ClusterFetcherNamespace, scheme, oidcPrefixes, cluster.DefaultKubeConfigOptions...))
+		}
+	}
+
+	log.Info("Using cluster fetchers", "fetchers", options.ClusterFetchers)
 
-	clustersManager := clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{fetcher}, nsaccess.NewChecker(nsaccess.DefautltWegoAppRules), log)
+	clustersManager := clustersmngr.NewClustersManager(fetchers, nsaccess.NewChecker(nsaccess.DefautltWegoAppRules), log)
 	clustersManager.Start(ctx)
 
 	healthChecker := health.NewHealthChecker()
 
+	if options.HealthChecksConfigMap != "" {
+		celHealthChecker := health.NewCELHealthChecker(healthChecker)
+		healthChecker = celHealthChecker
+
+		log.Info("Loading health check rules", "configmap", options.HealthChecksConfigMap)
+
+		go configmaps.Watch(ctx, log, rawClient, client.ObjectKey{Namespace: namespace, Name: options.HealthChecksConfigMap}, celHealthChecker)
+	}
+
 	coreConfig, err := core.NewCoreConfig(log, rest, clusterName, clustersManager, healthChecker)
 	if err != nil {
 		return fmt.Errorf("could not create core config: %w", err)
 	}
 
+	switch options.ReconciliationHistory {
+	case reconciliationHistoryMemory:
+		coreConfig.HistoryStore = history.NewMemoryStore(options.ReconciliationHistorySize)
+	case reconciliationHistoryConfigMap:
+		coreConfig.HistoryStore = history.NewConfigMapStore(log, rawClient, namespace, history.DefaultConfigMapName, options.ReconciliationHistorySize)
+	}
+
+	if coreConfig.HistoryStore != nil {
+		log.Info("Recording reconciliation history", "store", options.ReconciliationHistory)
+
+		go history.NewRecorder(log, clustersManager, coreConfig.HistoryStore).Start(ctx)
+	}
+
+	if options.FreezeWindows {
+		log.Info("Applying freeze windows")
+
+		coreConfig.FreezeWindows = true
+
+		go core.NewFreezeScheduler(log, clustersManager).Start(ctx)
+	}
+
+	coreConfig.ControllerLogs = options.ControllerLogs
+
+	if options.SearchIndex {
+		log.Info("Indexing objects for search")
+
+		coreConfig.SearchIndex = search.NewIndex(log, clustersManager)
+
+		go coreConfig.SearchIndex.Start(ctx)
+	}
+
+	auditSinks := []audit.Sink{}
+
+	for _, sink := range options.AuditSinks {
+		switch sink {
+		case auditSinkStdout:
+			auditSinks = append(auditSinks, audit.NewJSONLinesSink(os.Stdout))
+		case auditSinkFile:
+			fileSink, err := audit.NewFileSink(options.AuditFile)
+			if err != nil {
+				return err
+			}
+
+			auditSinks = append(auditSinks, fileSink)
+		case auditSinkEvents:
+			auditSinks = append(auditSinks, audit.NewEventSink(clustersManager, namespace))
+		}
+	}
+
+	log.Info("Auditing user actions", "sinks", options.AuditSinks)
+
+	coreConfig.AuditLog = audit.NewLog(log, options.AuditMaxEvents, auditSinks...)
+
+	coreConfig.APITokens = authServer.APITokens()
+	coreConfig.Sessions = auth.NewSessionAdmin(sessionManager)
+
+	if options.PoliciesConfigMap != "" {
+		authorizer := auth.NewPolicyAuthorizer()
+		key := client.ObjectKey{Namespace: namespace, Name: options.PoliciesConfigMap}
+
+		log.Info("Loading policies", "configmap", options.PoliciesConfigMap)
+
+		if err := configmaps.Load(ctx, rawClient, key, authorizer); err != nil {
+			return fmt.Errorf("could not load policies: %w", err)
+		}
+
+		coreConfig.Authorizer = authorizer
+
+		go configmaps.Watch(ctx, log, rawClient, key, authorizer)
+	}
+
 	appAndProfilesHandlers, err := server.NewHandlers(ctx, log,
 		&server.Config{
 			CoreServerConfig: coreConfig,
@@ -289,6 +513,8 @@ func runCmd(cmd *cobra.Command, args []string) error {
 
 	handler = middleware.WithLogging(log, handler)
 
+	handler = middleware.WithRequestID(handler)
+
 	handler = sessionManager.LoadAndSave(handler)
 
 	addr := net.JoinHostPort(options.Host, options.Port)
@@ -311,10 +537,24 @@ func runCmd(cmd *cobra.Command, args []string) error {
 
 	if options.EnableMetrics {
 		metricsMux := http.NewServeMux()
+
+		// The readiness of the Flux objects on all the clusters, from the
+		// search index so scrapes don't query the clusters
+		index := coreConfig.SearchIndex
+		if index == nil {
+			index = search.NewIndex(log, clustersManager)
+
+			go index.Start(ctx)
+		}
+
+		fluxRegistry := prometheus.NewRegistry()
+		fluxRegistry.MustRegister(fluxmetrics.NewCollector(index))
+
 		gatherers := prometheus.Gatherers{
 			prometheus.DefaultGatherer,
 			k8sMetrics.Registry,
 			clustersmngr.Registry,
+			fluxRegistry,
 		}
 		metricsMux.Handle("/metrics", promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{}))
 
@@ -352,6 +592,10 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if err := shutdownTracing(ctx); err != nil {
+		return fmt.Errorf("tracing shutdown failed: %w", err)
+	}
+
 	return nil
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/create/dashboard"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/create/terraform"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/create/token"
 )
 
 type CreateCommandFlags struct {
@@ -34,6 +35,11 @@ gitops create terraform my-resource \
   --path ./terraform \
   --interval 1m \
   --export > ./clusters/my-cluster/infra/terraform-my-resource.yaml
+
+# Create an API token for a CI job
+gitops create token ci-status \
+  --namespace flux-system \
+  --user ci@example.com
 		`,
 	}
 
@@ -42,6 +48,7 @@ gitops create terraform my-resource \
 
 	cmd.AddCommand(dashboard.DashboardCommand(opts))
 	cmd.AddCommand(terraform.Command(opts))
+	cmd.AddCommand(token.Command(opts))
 
 	return cmd
 }
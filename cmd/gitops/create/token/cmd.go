@@ -0,0 +1,99 @@
+package token
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+var kubeConfigArgs *genericclioptions.ConfigFlags
+
+type tokenFlags struct {
+	User   string
+	Groups []string
+	Scopes []string
+	TTL    time.Duration
+}
+
+var flags tokenFlags
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create an API token for the Weave GitOps dashboard",
+		Long: `Create a long-lived API token authenticating requests to the dashboard API
+as a user, for scripts and CI jobs. The dashboard needs the api-token auth method,
+and the namespace is the one it's installed in. The token is only printed once.`,
+		Example: `
+# Create a read-only token for a CI job, lasting 30 days
+gitops create token ci-status --namespace flux-system --user ci@example.com --groups ci
+
+# Create a token that can also sync and suspend objects, lasting a week
+gitops create token ci-deploy --namespace flux-system --user ci@example.com --groups ci --scopes read,write --ttl 168h
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := cmd.Flags().GetString("namespace")
+			if err != nil {
+				return err
+			}
+
+			context, err := cmd.Flags().GetString("context")
+			if err != nil {
+				return err
+			}
+
+			kubeConfigArgs.Namespace = &namespace
+			kubeConfigArgs.Context = &context
+
+			cfg, err := kubeConfigArgs.ToRESTConfig()
+			if err != nil {
+				return err
+			}
+
+			scheme, err := kube.CreateScheme()
+			if err != nil {
+				return err
+			}
+
+			kubeClient, err := client.New(cfg, client.Options{Scheme: scheme})
+			if err != nil {
+				return fmt.Errorf("error creating Kubernetes client: %w", err)
+			}
+
+			store := auth.NewAPITokenStore(logr.Discard(), kubeClient, namespace)
+			principal := &auth.UserPrincipal{ID: flags.User, Groups: flags.Groups}
+
+			raw, token, err := store.Create(cmd.Context(), principal, args[0], flags.Scopes, flags.TTL)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.ErrOrStderr(), "Created token %s for %s, expiring at %s\n", token.ID, token.Owner, token.ExpiresAt.Format(time.RFC3339))
+			fmt.Fprintln(cmd.OutOrStdout(), raw)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.User, "user", "", "The user the token authenticates as")
+	cmd.Flags().StringSliceVar(&flags.Groups, "groups", []string{}, "The groups the token authenticates with, kept until it expires or is revoked")
+	cmd.Flags().StringSliceVar(&flags.Scopes, "scopes", []string{auth.APITokenScopeRead}, fmt.Sprintf("What the token allows, %s only allows reading and %s allows any request", auth.APITokenScopeRead, auth.APITokenScopeWrite))
+	cmd.Flags().DurationVar(&flags.TTL, "ttl", auth.DefaultAPITokenTTL, fmt.Sprintf("How long the token lasts, up to %s", auth.MaxAPITokenTTL))
+	cobra.CheckErr(cmd.MarkFlagRequired("user"))
+
+	kubeConfigArgs = run.GetKubeConfigArgs()
+	kubeConfigArgs.AddFlags(cmd.Flags())
+	kubeConfigArgs.KubeConfig = &opts.Kubeconfig
+
+	return cmd
+}
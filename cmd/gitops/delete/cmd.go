@@ -5,6 +5,7 @@ import (
 
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/delete/terraform"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/delete/token"
 )
 
 func GetCommand(opts *config.Options) *cobra.Command {
@@ -14,6 +15,7 @@ func GetCommand(opts *config.Options) *cobra.Command {
 	}
 
 	cmd.AddCommand(terraform.Command(opts))
+	cmd.AddCommand(token.Command(opts))
 
 	return cmd
 }
@@ -0,0 +1,73 @@
+package token
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+var kubeConfigArgs *genericclioptions.ConfigFlags
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token",
+		Args:  cobra.ExactArgs(1),
+		Short: "Revoke an API token for the Weave GitOps dashboard",
+		Example: `
+# Revoke a token by its ID
+gitops delete token --namespace flux-system 3f2a9c1e0b7d4e5f
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := cmd.Flags().GetString("namespace")
+			if err != nil {
+				return err
+			}
+
+			context, err := cmd.Flags().GetString("context")
+			if err != nil {
+				return err
+			}
+
+			kubeConfigArgs.Namespace = &namespace
+			kubeConfigArgs.Context = &context
+
+			cfg, err := kubeConfigArgs.ToRESTConfig()
+			if err != nil {
+				return err
+			}
+
+			scheme, err := kube.CreateScheme()
+			if err != nil {
+				return err
+			}
+
+			kubeClient, err := client.New(cfg, client.Options{Scheme: scheme})
+			if err != nil {
+				return fmt.Errorf("error creating Kubernetes client: %w", err)
+			}
+
+			store := auth.NewAPITokenStore(logr.Discard(), kubeClient, namespace)
+			if err := store.Revoke(cmd.Context(), "", args[0]); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Revoked token %s\n", args[0])
+
+			return nil
+		},
+	}
+
+	kubeConfigArgs = run.GetKubeConfigArgs()
+	kubeConfigArgs.AddFlags(cmd.Flags())
+	kubeConfigArgs.KubeConfig = &opts.Kubeconfig
+
+	return cmd
+}
@@ -0,0 +1,23 @@
+package diff
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/diff/kustomization"
+)
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Preview the changes to a resource",
+		Example: `
+# Show what would change if the Kustomization applied its latest source revision
+gitops diff kustomization --namespace flux-system my-kustomization
+`,
+	}
+
+	cmd.AddCommand(kustomization.Command(opts))
+
+	return cmd
+}
@@ -0,0 +1,173 @@
+package kustomization
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/kustomizediff"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+)
+
+var kubeConfigArgs *genericclioptions.ConfigFlags
+
+type diffFlags struct {
+	revision string
+	path     string
+}
+
+var flags diffFlags
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "kustomization",
+		Aliases: []string{"ks"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Preview the changes applying a Kustomization would make",
+		Long: `Build the manifests of a Kustomization and server-side dry-run apply them,
+showing the objects that would be created, updated or pruned.
+The manifests are taken from the latest artifact of the Kustomization's source,
+or from a local directory with --path.`,
+		Example: `
+# Show what would change if the Kustomization applied its latest source revision
+gitops diff kustomization --namespace flux-system my-kustomization
+
+# Only diff if the source is at the expected revision
+gitops diff kustomization --namespace flux-system my-kustomization --revision main@sha1:0c4ad2d0
+
+# Diff a local checkout of the repository
+gitops diff kustomization --namespace flux-system my-kustomization --path ./my-repo
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := cmd.Flags().GetString("namespace")
+			if err != nil {
+				return err
+			}
+
+			context, err := cmd.Flags().GetString("context")
+			if err != nil {
+				return err
+			}
+
+			kubeConfigArgs.Namespace = &namespace
+			kubeConfigArgs.Context = &context
+
+			cfg, err := kubeConfigArgs.ToRESTConfig()
+			if err != nil {
+				return err
+			}
+
+			scheme, err := kube.CreateScheme()
+			if err != nil {
+				return err
+			}
+
+			kubeClient, err := client.New(cfg, client.Options{Scheme: scheme})
+			if err != nil {
+				return fmt.Errorf("error creating Kubernetes client: %w", err)
+			}
+
+			ks := &kustomizev1.Kustomization{}
+			if err := kubeClient.Get(cmd.Context(), client.ObjectKey{Namespace: namespace, Name: args[0]}, ks); err != nil {
+				return err
+			}
+
+			dir := flags.path
+
+			if dir == "" {
+				dir, err = os.MkdirTemp("", "kustomization-diff-")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(dir)
+
+				if err := fetchArtifact(cmd, cfg, kubeClient, ks, dir); err != nil {
+					return err
+				}
+			}
+
+			objects, err := kustomizediff.Build(ks, dir)
+			if err != nil {
+				return err
+			}
+
+			diffs, err := kustomizediff.Diff(cmd.Context(), kubeClient, ks, objects)
+			if err != nil {
+				return err
+			}
+
+			printDiffs(cmd.OutOrStdout(), diffs)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.revision, "revision", "", "The revision the source is expected to be at, fails if the source has a different revision")
+	cmd.Flags().StringVar(&flags.path, "path", "", "Build the manifests from this local directory instead of the source artifact")
+	cmd.MarkFlagsMutuallyExclusive("revision", "path")
+
+	kubeConfigArgs = run.GetKubeConfigArgs()
+	kubeConfigArgs.AddFlags(cmd.Flags())
+	kubeConfigArgs.KubeConfig = &opts.Kubeconfig
+
+	return cmd
+}
+
+// fetchArtifact downloads the source artifact through the API server, as the
+// source-controller service is usually not reachable from outside the cluster.
+func fetchArtifact(cmd *cobra.Command, cfg *rest.Config, kubeClient client.Client, ks *kustomizev1.Kustomization, dir string) error {
+	artifact, err := kustomizediff.GetArtifact(cmd.Context(), kubeClient, ks)
+	if err != nil {
+		return err
+	}
+
+	if flags.revision != "" && flags.revision != artifact.Revision {
+		return fmt.Errorf("source is at revision %s, not %s", artifact.Revision, flags.revision)
+	}
+
+	artifactURL, err := kustomizediff.ServiceProxyURL(cfg, artifact.URL)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := rest.HTTPClientFor(cfg)
+	if err != nil {
+		return fmt.Errorf("error creating HTTP client: %w", err)
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "Diffing revision %s\n", artifact.Revision)
+
+	return kustomizediff.FetchArtifact(cmd.Context(), httpClient, artifactURL, dir)
+}
+
+func printDiffs(out io.Writer, diffs []kustomizediff.ObjectDiff) {
+	if len(diffs) == 0 {
+		fmt.Fprintln(out, "No changes")
+		return
+	}
+
+	for _, d := range diffs {
+		name := d.Name
+		if d.Namespace != "" {
+			name = d.Namespace + "/" + d.Name
+		}
+
+		fmt.Fprintf(out, "► %s %s %s\n", d.Kind, name, d.Action)
+
+		if d.Error != "" {
+			fmt.Fprintln(out, d.Error)
+		}
+
+		if d.Diff != "" {
+			fmt.Fprintln(out, d.Diff)
+		}
+	}
+}
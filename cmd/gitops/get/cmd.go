@@ -6,6 +6,9 @@ import (
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get/bcrypt"
 	configCmd "github.com/weaveworks/weave-gitops/cmd/gitops/get/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/get/drift"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/get/helmrelease"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/get/owner"
 )
 
 func GetCommand(opts *config.Options) *cobra.Command {
@@ -16,6 +19,15 @@ func GetCommand(opts *config.Options) *cobra.Command {
 # Get the CLI configuration for Weave GitOps
 gitops get config
 
+# Find the Flux automations managing a Deployment
+gitops get owner deployment/podinfo --namespace apps
+
+# Find the changes made outside of Flux to the objects of a Kustomization
+gitops get drift kustomization/apps --namespace flux-system
+
+# List the revisions of a HelmRelease
+gitops get helmrelease history --namespace flux-system podinfo
+
 # Generate a hashed secret
 PASSWORD="<your password>"
 echo -n $PASSWORD | gitops get bcrypt-hash`,
@@ -23,6 +35,9 @@ echo -n $PASSWORD | gitops get bcrypt-hash`,
 
 	cmd.AddCommand(bcrypt.HashCommand(opts))
 	cmd.AddCommand(configCmd.ConfigCommand(opts))
+	cmd.AddCommand(drift.Command(opts))
+	cmd.AddCommand(helmrelease.Command(opts))
+	cmd.AddCommand(owner.Command(opts))
 
 	return cmd
 }
@@ -0,0 +1,175 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/core/drift"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/kustomizediff"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+)
+
+var kubeConfigArgs *genericclioptions.ConfigFlags
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drift <kind>/<name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Display the changes made outside of Flux to the objects of a Kustomization or HelmRelease",
+		Long: `Compare the objects a Kustomization or HelmRelease applied with their live state,
+and display the fields that were changed or removed, and the objects that were
+deleted, e.g. with kubectl. Fields set by the API server or other controllers
+aren't reported. The kind is kustomization (ks) or helmrelease (hr).`,
+		Example: `
+# Find the changes made to the objects of a Kustomization
+gitops get drift kustomization/apps --namespace flux-system
+
+# Find the changes made to the objects of a HelmRelease
+gitops get drift hr/podinfo --namespace flux-system
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind, name, ok := strings.Cut(args[0], "/")
+			if !ok || kind == "" || name == "" {
+				return fmt.Errorf("%q should be <kind>/<name>", args[0])
+			}
+
+			namespace, err := cmd.Flags().GetString("namespace")
+			if err != nil {
+				return err
+			}
+
+			context, err := cmd.Flags().GetString("context")
+			if err != nil {
+				return err
+			}
+
+			kubeConfigArgs.Namespace = &namespace
+			kubeConfigArgs.Context = &context
+
+			cfg, err := kubeConfigArgs.ToRESTConfig()
+			if err != nil {
+				return err
+			}
+
+			scheme, err := kube.CreateScheme()
+			if err != nil {
+				return err
+			}
+
+			kubeClient, err := client.New(cfg, client.Options{Scheme: scheme})
+			if err != nil {
+				return fmt.Errorf("error creating Kubernetes client: %w", err)
+			}
+
+			key := client.ObjectKey{Namespace: namespace, Name: name}
+
+			var report *drift.Report
+
+			switch strings.ToLower(kind) {
+			case "kustomization", "kustomizations", "ks":
+				ks := &kustomizev1.Kustomization{}
+				if err := kubeClient.Get(cmd.Context(), key, ks); err != nil {
+					return err
+				}
+
+				report, err = drift.Kustomization(cmd.Context(), kubeClient, ks, artifactFetcher(cfg))
+				if err != nil {
+					return err
+				}
+			case "helmrelease", "helmreleases", "hr":
+				hr := &helmv2.HelmRelease{}
+				if err := kubeClient.Get(cmd.Context(), key, hr); err != nil {
+					return err
+				}
+
+				report, err = drift.HelmRelease(cmd.Context(), kubeClient, hr)
+				if err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("drift can only be detected for a kustomization or a helmrelease, not %q", kind)
+			}
+
+			for _, warning := range report.Warnings {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s\n", warning)
+			}
+
+			return printReport(cmd.OutOrStdout(), report)
+		},
+	}
+
+	kubeConfigArgs = run.GetKubeConfigArgs()
+	kubeConfigArgs.AddFlags(cmd.Flags())
+	kubeConfigArgs.KubeConfig = &opts.Kubeconfig
+
+	return cmd
+}
+
+// artifactFetcher downloads the source artifact through the API server, as
+// the source-controller service is usually not reachable from outside the
+// cluster.
+func artifactFetcher(cfg *rest.Config) drift.ArtifactFetcher {
+	return func(ctx context.Context, artifactURL, dir string) error {
+		proxyURL, err := kustomizediff.ServiceProxyURL(cfg, artifactURL)
+		if err != nil {
+			return err
+		}
+
+		httpClient, err := rest.HTTPClientFor(cfg)
+		if err != nil {
+			return fmt.Errorf("error creating HTTP client: %w", err)
+		}
+
+		return kustomizediff.FetchArtifact(ctx, httpClient, proxyURL, dir)
+	}
+}
+
+func printReport(out io.Writer, report *drift.Report) error {
+	if len(report.Objects) == 0 {
+		fmt.Fprintf(out, "No drift in %d objects\n", report.Checked)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "OBJECT\tFIELD\tAPPLIED\tLIVE")
+
+	for _, o := range report.Objects {
+		object := o.Kind + "/" + o.Name
+		if o.Namespace != "" {
+			object = fmt.Sprintf("%s/%s/%s", o.Kind, o.Namespace, o.Name)
+		}
+
+		switch {
+		case o.Deleted:
+			fmt.Fprintf(w, "%s\t-\t-\t<deleted>\n", object)
+		case o.Error != "":
+			fmt.Fprintf(w, "%s\t-\t-\t<error: %s>\n", object, o.Error)
+		}
+
+		for _, f := range o.Fields {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", object, f.Path, orNone(f.Applied), orNone(f.Live))
+		}
+	}
+
+	return w.Flush()
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+
+	return value
+}
@@ -0,0 +1,164 @@
+package helmrelease
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/core/helmstorage"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+)
+
+var kubeConfigArgs *genericclioptions.ConfigFlags
+
+type historyFlags struct {
+	values bool
+}
+
+var flags historyFlags
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "helmrelease",
+		Aliases: []string{"hr"},
+		Short:   "Display information about a HelmRelease",
+		Example: `
+# List the revisions of a HelmRelease
+gitops get helmrelease history --namespace flux-system podinfo
+`,
+	}
+
+	cmd.AddCommand(historyCommand(opts))
+
+	return cmd
+}
+
+func historyCommand(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Args:  cobra.ExactArgs(1),
+		Short: "List the revisions of a HelmRelease",
+		Long: `List the revisions of the Helm release of a HelmRelease that are kept in the
+Helm storage, latest first, with the chart version and status of each of them.
+Use --values to also show how the values changed from the previous revision.`,
+		Example: `
+# List the revisions of a HelmRelease
+gitops get helmrelease history --namespace flux-system podinfo
+
+# Show how the values changed in each revision
+gitops get helmrelease history --namespace flux-system podinfo --values
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := cmd.Flags().GetString("namespace")
+			if err != nil {
+				return err
+			}
+
+			context, err := cmd.Flags().GetString("context")
+			if err != nil {
+				return err
+			}
+
+			kubeConfigArgs.Namespace = &namespace
+			kubeConfigArgs.Context = &context
+
+			cfg, err := kubeConfigArgs.ToRESTConfig()
+			if err != nil {
+				return err
+			}
+
+			scheme, err := kube.CreateScheme()
+			if err != nil {
+				return err
+			}
+
+			kubeClient, err := client.New(cfg, client.Options{Scheme: scheme})
+			if err != nil {
+				return fmt.Errorf("error creating Kubernetes client: %w", err)
+			}
+
+			hr := &helmv2.HelmRelease{}
+			if err := kubeClient.Get(cmd.Context(), client.ObjectKey{Namespace: namespace, Name: args[0]}, hr); err != nil {
+				return err
+			}
+
+			releases, err := helmstorage.Revisions(cmd.Context(), kubeClient, hr)
+			if err != nil {
+				return err
+			}
+
+			if len(releases) == 0 {
+				fmt.Fprintf(cmd.ErrOrStderr(), "HelmRelease %s has no revisions\n", args[0])
+				return nil
+			}
+
+			if err := printRevisions(cmd.OutOrStdout(), releases); err != nil {
+				return err
+			}
+
+			if flags.values {
+				return printValuesDiffs(cmd.OutOrStdout(), releases)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&flags.values, "values", false, "Show how the values changed from the previous revision")
+
+	kubeConfigArgs = run.GetKubeConfigArgs()
+	kubeConfigArgs.AddFlags(cmd.Flags())
+	kubeConfigArgs.KubeConfig = &opts.Kubeconfig
+
+	return cmd
+}
+
+func printRevisions(out io.Writer, releases []*helmstorage.Release) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "REVISION\tCHART\tAPP VERSION\tSTATUS\tDEPLOYED\tDESCRIPTION")
+
+	for _, r := range releases {
+		deployed := "-"
+		if !r.Info.LastDeployed.IsZero() {
+			deployed = r.Info.LastDeployed.Local().Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(w, "%d\t%s-%s\t%s\t%s\t%s\t%s\n",
+			r.Version, r.Chart.Metadata.Name, r.Chart.Metadata.Version, r.Chart.Metadata.AppVersion, r.Info.Status, deployed, r.Info.Description)
+	}
+
+	return w.Flush()
+}
+
+func printValuesDiffs(out io.Writer, releases []*helmstorage.Release) error {
+	for i, r := range releases {
+		var previous *helmstorage.Release
+		if i+1 < len(releases) {
+			previous = releases[i+1]
+		}
+
+		diff, err := helmstorage.ValuesDiff(previous, r)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(out, "\nRevision %d:\n", r.Version)
+
+		if diff == "" {
+			fmt.Fprintln(out, "No changes to the values")
+			continue
+		}
+
+		fmt.Fprint(out, diff)
+	}
+
+	return nil
+}
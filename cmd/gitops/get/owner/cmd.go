@@ -0,0 +1,151 @@
+package owner
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/core/ownership"
+	"github.com/weaveworks/weave-gitops/core/search"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+)
+
+// clusterName is the name the objects are indexed under, as there's only
+// one cluster.
+const clusterName = "Default"
+
+var kubeConfigArgs *genericclioptions.ConfigFlags
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner <kind>/<name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Display the Flux automations managing an object",
+		Long: `Display the Flux automation that applied an object, then the one that applied
+that automation, and so on up to the source. Owners are found from the labels
+Flux adds to the objects it applies, or else from the Kustomizations' inventories.`,
+		Example: `
+# Find what deploys a Deployment in the "apps" namespace
+gitops get owner deployment/podinfo --namespace apps
+
+# Find what applied a custom resource
+gitops get owner certificates.cert-manager.io/my-cert --namespace apps
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resource, name, ok := strings.Cut(args[0], "/")
+			if !ok || resource == "" || name == "" {
+				return fmt.Errorf("%q should be <kind>/<name>", args[0])
+			}
+
+			namespace, err := cmd.Flags().GetString("namespace")
+			if err != nil {
+				return err
+			}
+
+			context, err := cmd.Flags().GetString("context")
+			if err != nil {
+				return err
+			}
+
+			kubeConfigArgs.Context = &context
+
+			cfg, err := kubeConfigArgs.ToRESTConfig()
+			if err != nil {
+				return err
+			}
+
+			mapper, err := kubeConfigArgs.ToRESTMapper()
+			if err != nil {
+				return err
+			}
+
+			gvk, err := mapper.KindFor(schema.ParseGroupResource(strings.ToLower(resource)).WithVersion(""))
+			if err != nil {
+				return fmt.Errorf("unknown kind %q: %w", resource, err)
+			}
+
+			mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+			if err != nil {
+				return err
+			}
+
+			if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+				namespace = ""
+			}
+
+			scheme, err := kube.CreateScheme()
+			if err != nil {
+				return err
+			}
+
+			kubeClient, err := client.New(cfg, client.Options{Scheme: scheme})
+			if err != nil {
+				return fmt.Errorf("error creating Kubernetes client: %w", err)
+			}
+
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(gvk)
+
+			if err := kubeClient.Get(cmd.Context(), client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+				return err
+			}
+
+			// The inventories are only indexed if an object has no labels
+			// to find its owner by.
+			var index *search.Index
+
+			lookup := func(kind, namespace, name string) []search.ObjectRef {
+				if index == nil {
+					index = search.NewClientIndex(logr.Discard(), clusterName, kubeClient)
+					index.Refresh(cmd.Context())
+				}
+
+				return ownership.IndexLookup(index, clusterName, nil)(kind, namespace, name)
+			}
+
+			owners, err := ownership.Chain(cmd.Context(), kubeClient, clusterName, obj, lookup)
+			if err != nil {
+				return err
+			}
+
+			if len(owners) == 0 {
+				fmt.Fprintf(cmd.ErrOrStderr(), "%s/%s isn't managed by Flux\n", gvk.Kind, name)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "AUTOMATION\tSOURCE\tFOUND BY")
+
+			for _, o := range owners {
+				source := "-"
+				if o.SourceRef != nil {
+					source = formatRef(*o.SourceRef)
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\n", formatRef(o.Automation), source, o.FoundBy)
+			}
+
+			return w.Flush()
+		},
+	}
+
+	kubeConfigArgs = run.GetKubeConfigArgs()
+	kubeConfigArgs.AddFlags(cmd.Flags())
+	kubeConfigArgs.KubeConfig = &opts.Kubeconfig
+
+	return cmd
+}
+
+func formatRef(ref search.ObjectRef) string {
+	return fmt.Sprintf("%s/%s/%s", ref.Kind, ref.Namespace, ref.Name)
+}
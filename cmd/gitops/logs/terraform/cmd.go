@@ -1,22 +1,27 @@
 package terraform
 
 import (
-	"bytes"
-	ctx "context"
 	"errors"
-	"fmt"
-	"io"
+	"time"
 
 	"github.com/spf13/cobra"
-	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/core/terraform"
 	"github.com/weaveworks/weave-gitops/pkg/run"
 )
 
+type logsFlags struct {
+	container string
+	follow    bool
+	since     time.Duration
+	tail      int64
+}
+
+var flags logsFlags
+
 var kubeConfigArgs *genericclioptions.ConfigFlags
 
 func Command(opts *config.Options) *cobra.Command {
@@ -28,6 +33,12 @@ func Command(opts *config.Options) *cobra.Command {
 		Example: `
 # Get the runner logs of a Terraform object in the "flux-system" namespace
 gitops logs terraform --namespace flux-system my-resource
+
+# Follow the runner logs of a Terraform object, across runner restarts
+gitops logs terraform --namespace flux-system my-resource --follow
+
+# Get the last 100 lines of the runner logs from the last 10 minutes
+gitops logs terraform --namespace flux-system my-resource --since 10m --tail 100
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			namespace, err := cmd.Flags().GetString("namespace")
@@ -48,36 +59,18 @@ gitops logs terraform --namespace flux-system my-resource
 				return err
 			}
 
-			// Runner pod is named after the Terraform object
-			podName := fmt.Sprintf("%s-tf-runner", args[0])
-
 			clientset, err := kubernetes.NewForConfig(cfg)
 			if err != nil {
 				return errors.New("error in getting access to K8S")
 			}
 
-			pod, err := clientset.CoreV1().Pods(namespace).Get(ctx.Background(), podName, metav1.GetOptions{})
-			if err != nil {
-				return err
-			}
-
-			req := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{})
-			podLogs, err := req.Stream(ctx.Background())
-			if err != nil {
-				return errors.New("error in opening stream")
-			}
-
-			defer podLogs.Close()
-
-			buf := new(bytes.Buffer)
-			_, err = io.Copy(buf, podLogs)
-			if err != nil {
-				return errors.New("error in copy information from podLogs to buf")
-			}
-
-			fmt.Print(buf.String())
-
-			return nil
+			return terraform.StreamRunnerLogs(cmd.Context(), clientset, namespace, args[0], terraform.LogOptions{
+				Container: flags.container,
+				Follow:    flags.follow,
+				Since:     flags.since,
+				Tail:      flags.tail,
+				Status:    cmd.ErrOrStderr(),
+			}, cmd.OutOrStdout())
 		},
 	}
 
@@ -85,5 +78,10 @@ gitops logs terraform --namespace flux-system my-resource
 	kubeConfigArgs.AddFlags(cmd.Flags())
 	kubeConfigArgs.KubeConfig = &opts.Kubeconfig
 
+	cmd.Flags().StringVar(&flags.container, "container", "", "The container of the runner pod to get the logs of, defaults to its only container")
+	cmd.Flags().BoolVarP(&flags.follow, "follow", "f", false, "Keep streaming the logs, reconnecting when the runner pod restarts")
+	cmd.Flags().DurationVar(&flags.since, "since", 0, "Only get the logs newer than a duration, e.g. 5s, 2m or 3h")
+	cmd.Flags().Int64Var(&flags.tail, "tail", -1, "The number of lines of the latest logs to get, all of them if negative")
+
 	return cmd
 }
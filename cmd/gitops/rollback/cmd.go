@@ -0,0 +1,23 @@
+package rollback
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/rollback/helmrelease"
+)
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll a resource back to a previous revision",
+		Example: `
+# Roll a HelmRelease back to its second revision
+gitops rollback helmrelease --namespace flux-system podinfo --to-revision 2
+`,
+	}
+
+	cmd.AddCommand(helmrelease.Command(opts))
+
+	return cmd
+}
@@ -0,0 +1,147 @@
+package helmrelease
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	"github.com/spf13/cobra"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/core/helmstorage"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+)
+
+var kubeConfigArgs *genericclioptions.ConfigFlags
+
+type rollbackFlags struct {
+	revision int
+	comment  string
+}
+
+var flags rollbackFlags
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "helmrelease",
+		Aliases: []string{"hr"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Roll a HelmRelease back to a previous revision",
+		Long: `Pin the chart version and values of a HelmRelease to those of a previous revision
+of its Helm release, so that helm-controller upgrades back to it. Who rolled it
+back, when and why is recorded in annotations of the HelmRelease.
+List the revisions with "gitops get helmrelease history".`,
+		Example: `
+# Roll a HelmRelease back to its second revision
+gitops rollback helmrelease --namespace flux-system podinfo --to-revision 2 --comment "Broke the ingress"
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := cmd.Flags().GetString("namespace")
+			if err != nil {
+				return err
+			}
+
+			context, err := cmd.Flags().GetString("context")
+			if err != nil {
+				return err
+			}
+
+			kubeConfigArgs.Namespace = &namespace
+			kubeConfigArgs.Context = &context
+
+			cfg, err := kubeConfigArgs.ToRESTConfig()
+			if err != nil {
+				return err
+			}
+
+			scheme, err := kube.CreateScheme()
+			if err != nil {
+				return err
+			}
+
+			kubeClient, err := client.New(cfg, client.Options{Scheme: scheme})
+			if err != nil {
+				return fmt.Errorf("error creating Kubernetes client: %w", err)
+			}
+
+			hr := &helmv2.HelmRelease{}
+			if err := kubeClient.Get(cmd.Context(), client.ObjectKey{Namespace: namespace, Name: args[0]}, hr); err != nil {
+				return err
+			}
+
+			if hr.Spec.KubeConfig != nil {
+				return fmt.Errorf("HelmRelease %s is released on another cluster, its storage can't be read", args[0])
+			}
+
+			storageSecret := &corev1.Secret{}
+			if err := kubeClient.Get(cmd.Context(), client.ObjectKey{
+				Name:      helmstorage.SecretName(helmstorage.ReleaseName(hr), flags.revision),
+				Namespace: helmstorage.StorageNamespace(hr),
+			}, storageSecret); err != nil {
+				return fmt.Errorf("failed to get revision %d: %w", flags.revision, err)
+			}
+
+			release, err := helmstorage.Decode(storageSecret)
+			if err != nil {
+				return err
+			}
+
+			patch := client.MergeFrom(hr.DeepCopy())
+
+			warnings, err := helmstorage.Rollback(hr, release, currentUser(cmd.Context(), kubeClient), flags.comment, time.Now())
+			if err != nil {
+				return err
+			}
+
+			if err := kubeClient.Patch(cmd.Context(), hr, patch); err != nil {
+				return err
+			}
+
+			for _, warning := range warnings {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s\n", warning)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "HelmRelease %s rolled back to revision %d (chart %s)\n", args[0], release.Version, release.Chart.Metadata.Version)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&flags.revision, "to-revision", 0, "The revision to roll back to")
+	cmd.Flags().StringVar(&flags.comment, "comment", "", "Why the HelmRelease is rolled back")
+	cobra.CheckErr(cmd.MarkFlagRequired("to-revision"))
+
+	kubeConfigArgs = run.GetKubeConfigArgs()
+	kubeConfigArgs.AddFlags(cmd.Flags())
+	kubeConfigArgs.KubeConfig = &opts.Kubeconfig
+
+	return cmd
+}
+
+// currentUser asks the API server who the user is, or else names them after
+// the kubeconfig user.
+func currentUser(ctx context.Context, kubeClient client.Client) string {
+	review := &authenticationv1.SelfSubjectReview{}
+	if err := kubeClient.Create(ctx, review); err == nil && review.Status.UserInfo.Username != "" {
+		return review.Status.UserInfo.Username
+	}
+
+	if rawConfig, err := kubeConfigArgs.ToRawKubeConfigLoader().RawConfig(); err == nil {
+		contextName := rawConfig.CurrentContext
+		if kubeConfigArgs.Context != nil && *kubeConfigArgs.Context != "" {
+			contextName = *kubeConfigArgs.Context
+		}
+
+		if kubeContext, ok := rawConfig.Contexts[contextName]; ok {
+			return kubeContext.AuthInfo
+		}
+	}
+
+	return "gitops-cli"
+}
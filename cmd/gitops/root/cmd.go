@@ -16,11 +16,14 @@ import (
 	cfg "github.com/weaveworks/weave-gitops/cmd/gitops/config"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/create"
 	deletepkg "github.com/weaveworks/weave-gitops/cmd/gitops/delete"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/diff"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/docs"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/logs"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/replan"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/resume"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/rollback"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/search"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/set"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/suspend"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
@@ -155,9 +158,12 @@ func RootCmd() *cobra.Command {
 	rootCmd.AddCommand(check.GetCommand(options))
 	rootCmd.AddCommand(create.GetCommand(options))
 	rootCmd.AddCommand(deletepkg.GetCommand(options))
+	rootCmd.AddCommand(diff.Command(options))
 	rootCmd.AddCommand(logs.GetCommand(options))
 	rootCmd.AddCommand(replan.Command(options))
 	rootCmd.AddCommand(resume.Command(options))
+	rootCmd.AddCommand(rollback.Command(options))
+	rootCmd.AddCommand(search.Command(options))
 	rootCmd.AddCommand(suspend.Command(options))
 
 	return rootCmd
@@ -0,0 +1,189 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/core/search"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+)
+
+// clusterName is the name the objects are indexed under, as there's only
+// one cluster.
+const clusterName = "Default"
+
+var kubeConfigArgs *genericclioptions.ConfigFlags
+
+type searchFlags struct {
+	Kind            string
+	Name            string
+	LabelSelector   string
+	Annotations     []string
+	SourceRef       string
+	Message         string
+	InventoryMember string
+	Limit           int
+}
+
+var flags searchFlags
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search [text...]",
+		Args:  cobra.ArbitraryArgs,
+		Short: "Search the Flux objects in the cluster",
+		Long: `Search the Kustomizations, HelmReleases and sources in the cluster, in all
+namespaces unless --namespace is set. The text is split into terms that must all
+match an object's name, kind, namespace, label values or condition messages, and
+the best matches are listed first.`,
+		Example: `
+# Search for objects named like podinfo
+gitops search podinfo
+
+# Find the Kustomizations that aren't ready because a build failed
+gitops search --kind Kustomization --message "build failed"
+
+# Find what deploys a Deployment
+gitops search --inventory-member Deployment/apps/podinfo
+
+# Find the objects reconciling a GitRepository
+gitops search --source GitRepository/flux-system/flux-system
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.Limit < 0 {
+				return fmt.Errorf("--limit can't be negative")
+			}
+
+			namespace, err := cmd.Flags().GetString("namespace")
+			if err != nil {
+				return err
+			}
+
+			context, err := cmd.Flags().GetString("context")
+			if err != nil {
+				return err
+			}
+
+			query := search.Query{
+				Text:        strings.Join(args, " "),
+				Kind:        flags.Kind,
+				Name:        flags.Name,
+				Annotations: flags.Annotations,
+				Message:     flags.Message,
+			}
+
+			if flags.LabelSelector != "" {
+				query.Labels, err = labels.Parse(flags.LabelSelector)
+				if err != nil {
+					return fmt.Errorf("invalid --label-selector: %w", err)
+				}
+			}
+
+			if flags.SourceRef != "" {
+				query.SourceRef, err = parseRef(flags.SourceRef)
+				if err != nil {
+					return fmt.Errorf("invalid --source: %w", err)
+				}
+			}
+
+			if flags.InventoryMember != "" {
+				query.InventoryMember, err = parseRef(flags.InventoryMember)
+				if err != nil {
+					return fmt.Errorf("invalid --inventory-member: %w", err)
+				}
+			}
+
+			if cmd.Flags().Changed("namespace") {
+				query.Allowed = func(_, ns string) bool {
+					return ns == namespace
+				}
+			}
+
+			kubeConfigArgs.Context = &context
+
+			cfg, err := kubeConfigArgs.ToRESTConfig()
+			if err != nil {
+				return err
+			}
+
+			scheme, err := kube.CreateScheme()
+			if err != nil {
+				return err
+			}
+
+			kubeClient, err := client.New(cfg, client.Options{Scheme: scheme})
+			if err != nil {
+				return fmt.Errorf("error creating Kubernetes client: %w", err)
+			}
+
+			index := search.NewClientIndex(logr.Discard(), clusterName, kubeClient)
+			index.Refresh(cmd.Context())
+
+			results := index.Search(query)
+			if len(results) == 0 {
+				fmt.Fprintln(cmd.ErrOrStderr(), "No objects found")
+				return nil
+			}
+
+			limit := len(results)
+			if flags.Limit > 0 {
+				limit = min(flags.Limit, limit)
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "KIND\tNAMESPACE\tNAME\tREADY\tMESSAGE")
+
+			for _, r := range results[:limit] {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.Kind, r.Namespace, r.Name, r.Ready, r.Message)
+			}
+
+			if err := w.Flush(); err != nil {
+				return err
+			}
+
+			if limit < len(results) {
+				fmt.Fprintf(cmd.ErrOrStderr(), "Showing %d of %d objects\n", limit, len(results))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.Kind, "kind", "", "Only list objects of the kind")
+	cmd.Flags().StringVar(&flags.Name, "name", "", "Only list objects whose name contains this")
+	cmd.Flags().StringVarP(&flags.LabelSelector, "label-selector", "l", "", "Only list objects matching the label selector")
+	cmd.Flags().StringArrayVar(&flags.Annotations, "annotation", []string{}, "Only list objects with the annotation, as key or key=value, can be repeated")
+	cmd.Flags().StringVar(&flags.SourceRef, "source", "", "Only list objects reconciling the source, as Kind/name or Kind/namespace/name")
+	cmd.Flags().StringVar(&flags.Message, "message", "", "Only list objects with a condition message containing this")
+	cmd.Flags().StringVar(&flags.InventoryMember, "inventory-member", "", "Only list Kustomizations that applied the object, as Kind/name or Kind/namespace/name. HelmRelease inventories aren't searched")
+	cmd.Flags().IntVar(&flags.Limit, "limit", 50, "The maximum number of objects to list, all of them if 0")
+
+	kubeConfigArgs = run.GetKubeConfigArgs()
+	kubeConfigArgs.AddFlags(cmd.Flags())
+	kubeConfigArgs.KubeConfig = &opts.Kubeconfig
+
+	return cmd
+}
+
+// parseRef parses Kind/name or Kind/namespace/name.
+func parseRef(s string) (*search.ObjectRef, error) {
+	parts := strings.Split(s, "/")
+
+	switch {
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return &search.ObjectRef{Kind: parts[0], Name: parts[1]}, nil
+	case len(parts) == 3 && parts[0] != "" && parts[2] != "":
+		return &search.ObjectRef{Kind: parts[0], Namespace: parts[1], Name: parts[2]}, nil
+	}
+
+	return nil, fmt.Errorf("%q should be Kind/name or Kind/namespace/name", s)
+}
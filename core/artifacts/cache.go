@@ -0,0 +1,319 @@
+// Package artifacts downloads the artifacts of Flux sources from
+// source-controller, and caches their files in memory by artifact digest.
+package artifacts
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// DefaultMaxArtifactSize is the maximum size of the files of an
+	// artifact.
+	DefaultMaxArtifactSize = 50 << 20
+	// DefaultMaxCacheSize is the maximum size of the files of all the cached
+	// artifacts.
+	DefaultMaxCacheSize = 200 << 20
+)
+
+// Artifact is the latest artifact of a source.
+type Artifact struct {
+	URL      string
+	Revision string
+	Digest   string
+}
+
+// FromSource returns the artifact in the status of the source, nil if it
+// has none.
+func FromSource(source *unstructured.Unstructured) *Artifact {
+	artifactURL, _, _ := unstructured.NestedString(source.Object, "status", "artifact", "url")
+	if artifactURL == "" {
+		return nil
+	}
+
+	revision, _, _ := unstructured.NestedString(source.Object, "status", "artifact", "revision")
+	digest, _, _ := unstructured.NestedString(source.Object, "status", "artifact", "digest")
+
+	return &Artifact{URL: artifactURL, Revision: revision, Digest: digest}
+}
+
+// key identifies the artifact's content. Artifacts from older versions of
+// source-controller have no digest, but a new revision has a new URL.
+func (a Artifact) key() string {
+	if a.Digest != "" {
+		return a.Digest
+	}
+
+	return a.URL + "@" + a.Revision
+}
+
+// File is a regular file in an artifact.
+type File struct {
+	// Path is relative to the root of the artifact, e.g. apps/podinfo.yaml.
+	Path string
+	Size int64
+}
+
+// Archive holds the files of an artifact.
+type Archive struct {
+	Artifact
+	// Files are sorted by path.
+	Files    []File
+	contents map[string][]byte
+	size     int64
+}
+
+// ReadFile returns the content of the file at the path.
+func (a *Archive) ReadFile(filePath string) ([]byte, bool) {
+	content, ok := a.contents[cleanPath(filePath)]
+	return content, ok
+}
+
+// Cache downloads artifacts, and keeps the most recently used ones until
+// their files take up more than its maximum size.
+type Cache struct {
+	httpClient      *http.Client
+	maxArtifactSize int64
+	maxCacheSize    int64
+
+	downloads singleflight.Group
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+	size    int64
+}
+
+// NewCache returns a Cache downloading artifacts with the HTTP client, whose
+// timeout bounds the downloads. A download is shared by all the callers
+// waiting for the artifact, so it isn't cancelled with any one of them.
+func NewCache(httpClient *http.Client, maxArtifactSize, maxCacheSize int64) *Cache {
+	return &Cache{
+		httpClient:      httpClient,
+		maxArtifactSize: maxArtifactSize,
+		maxCacheSize:    maxCacheSize,
+		lru:             list.New(),
+		entries:         map[string]*list.Element{},
+	}
+}
+
+// Get returns the files of the artifact, downloading it unless it's cached.
+func (c *Cache) Get(ctx context.Context, artifact Artifact) (*Archive, error) {
+	key := artifact.key()
+
+	if archive := c.cached(key); archive != nil {
+		return archive, nil
+	}
+
+	results := c.downloads.DoChan(key, func() (any, error) {
+		archive, err := c.download(context.WithoutCancel(ctx), artifact)
+		if err != nil {
+			return nil, err
+		}
+
+		c.add(key, archive)
+
+		return archive, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-results:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		return res.Val.(*Archive), nil
+	}
+}
+
+func (c *Cache) cached(key string) *Archive {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(e)
+		return e.Value.(*Archive)
+	}
+
+	return nil
+}
+
+func (c *Cache) add(key string, archive *Archive) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; ok {
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(archive)
+	c.size += archive.size
+
+	// The artifact that was just added is kept even if it's too large, as
+	// it's about to be read
+	for c.size > c.maxCacheSize && c.lru.Len() > 1 {
+		oldest := c.lru.Back()
+		evicted := oldest.Value.(*Archive)
+
+		c.lru.Remove(oldest)
+		delete(c.entries, evicted.key())
+		c.size -= evicted.size
+	}
+}
+
+func (c *Cache) download(ctx context.Context, artifact Artifact) (*Archive, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, artifact.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch artifact: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch artifact from %s: %s", artifact.URL, res.Status)
+	}
+
+	verifier, err := newDigestVerifier(artifact.Digest)
+	if err != nil {
+		return nil, err
+	}
+
+	// The whole tarball is hashed as it's read
+	body := io.TeeReader(res.Body, verifier)
+
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact: %w", err)
+	}
+	defer gz.Close()
+
+	archive := &Archive{
+		Artifact: artifact,
+		Files:    []File{},
+		contents: map[string][]byte{},
+	}
+
+	tr := tar.NewReader(gz)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read artifact: %w", err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		archive.size += header.Size
+		if archive.size > c.maxArtifactSize {
+			return nil, fmt.Errorf("artifact is larger than %d bytes", c.maxArtifactSize)
+		}
+
+		content, err := io.ReadAll(io.LimitReader(tr, header.Size))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from the artifact: %w", header.Name, err)
+		}
+
+		filePath := cleanPath(header.Name)
+		archive.Files = append(archive.Files, File{Path: filePath, Size: header.Size})
+		archive.contents[filePath] = content
+	}
+
+	if _, err := io.Copy(io.Discard, body); err != nil {
+		return nil, fmt.Errorf("failed to read artifact: %w", err)
+	}
+
+	if err := verifier.verify(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(archive.Files, func(i, j int) bool {
+		return archive.Files[i].Path < archive.Files[j].Path
+	})
+
+	return archive, nil
+}
+
+// digestVerifier hashes an artifact to check it against its digest.
+type digestVerifier struct {
+	digest string
+	hash   hash.Hash
+}
+
+// newDigestVerifier returns a verifier of the digest, e.g. sha256:<hex>.
+// Artifacts without a digest, from older versions of source-controller,
+// aren't checked.
+func newDigestVerifier(digest string) (*digestVerifier, error) {
+	v := &digestVerifier{digest: digest}
+
+	if digest == "" {
+		return v, nil
+	}
+
+	switch algorithm, _, _ := strings.Cut(digest, ":"); algorithm {
+	case "sha256":
+		v.hash = sha256.New()
+	case "sha384":
+		v.hash = sha512.New384()
+	case "sha512":
+		v.hash = sha512.New()
+	default:
+		return nil, fmt.Errorf("unsupported artifact digest algorithm %q", algorithm)
+	}
+
+	return v, nil
+}
+
+func (v *digestVerifier) Write(p []byte) (int, error) {
+	if v.hash == nil {
+		return len(p), nil
+	}
+
+	return v.hash.Write(p)
+}
+
+func (v *digestVerifier) verify() error {
+	if v.hash == nil {
+		return nil
+	}
+
+	algorithm, _, _ := strings.Cut(v.digest, ":")
+
+	if actual := algorithm + ":" + hex.EncodeToString(v.hash.Sum(nil)); actual != v.digest {
+		return fmt.Errorf("artifact digest %s doesn't match the expected %s", actual, v.digest)
+	}
+
+	return nil
+}
+
+// cleanPath makes the path relative to the root of the artifact, whether
+// it's e.g. ./apps/podinfo.yaml or /apps/podinfo.yaml.
+func cleanPath(filePath string) string {
+	return strings.TrimPrefix(path.Clean("/"+filePath), "/")
+}
@@ -0,0 +1,194 @@
+package artifacts_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/weave-gitops/core/artifacts"
+)
+
+func TestCacheGet(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var requests atomic.Int32
+
+	tarballs := map[string][]byte{
+		"/v1.tar.gz": makeTarball(g, map[string]string{
+			"./apps/podinfo.yaml": "kind: Deployment\n",
+			"./README.md":         "# Apps\n",
+		}),
+		"/v2.tar.gz": makeTarball(g, map[string]string{
+			"apps/podinfo.yaml": "kind: Deployment\nmetadata: {}\n",
+		}),
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		tarball, ok := tarballs[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		_, _ = w.Write(tarball)
+	}))
+	defer srv.Close()
+
+	// Only one of the artifacts fits in the cache
+	cache := artifacts.NewCache(srv.Client(), 1024, 40)
+	ctx := context.Background()
+
+	v1 := artifacts.Artifact{URL: srv.URL + "/v1.tar.gz", Revision: "main@sha1:1", Digest: digest(tarballs["/v1.tar.gz"])}
+
+	archive, err := cache.Get(ctx, v1)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(archive.Revision).To(Equal("main@sha1:1"))
+	g.Expect(archive.Files).To(Equal([]artifacts.File{
+		{Path: "README.md", Size: 7},
+		{Path: "apps/podinfo.yaml", Size: 17},
+	}))
+
+	content, ok := archive.ReadFile("/apps/podinfo.yaml")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(string(content)).To(Equal("kind: Deployment\n"))
+
+	_, ok = archive.ReadFile("apps/missing.yaml")
+	g.Expect(ok).To(BeFalse())
+
+	// Cached by digest
+	_, err = cache.Get(ctx, v1)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requests.Load()).To(Equal(int32(1)))
+
+	// Caching the second artifact evicts the first one
+	_, err = cache.Get(ctx, artifacts.Artifact{URL: srv.URL + "/v2.tar.gz", Digest: digest(tarballs["/v2.tar.gz"])})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = cache.Get(ctx, v1)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requests.Load()).To(Equal(int32(3)))
+
+	_, err = cache.Get(ctx, artifacts.Artifact{URL: srv.URL + "/v3.tar.gz", Digest: "sha256:3"})
+	g.Expect(err).To(MatchError(ContainSubstring("404 Not Found")))
+}
+
+func TestCacheGetTooLarge(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tarball := makeTarball(g, map[string]string{"big.yaml": string(make([]byte, 2048))})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(tarball)
+	}))
+	defer srv.Close()
+
+	cache := artifacts.NewCache(srv.Client(), 1024, 4096)
+
+	_, err := cache.Get(context.Background(), artifacts.Artifact{URL: srv.URL, Digest: "sha256:big"})
+	g.Expect(err).To(MatchError("artifact is larger than 1024 bytes"))
+}
+
+func TestCacheGetDigestMismatch(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tarball := makeTarball(g, map[string]string{"a.yaml": "a"})
+	tampered := makeTarball(g, map[string]string{"a.yaml": "tampered"})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(tampered)
+	}))
+	defer srv.Close()
+
+	cache := artifacts.NewCache(srv.Client(), 1024, 4096)
+
+	_, err := cache.Get(context.Background(), artifacts.Artifact{URL: srv.URL, Digest: digest(tarball)})
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't match the expected " + digest(tarball))))
+
+	_, err = cache.Get(context.Background(), artifacts.Artifact{URL: srv.URL, Digest: "md5:abc"})
+	g.Expect(err).To(MatchError(ContainSubstring("unsupported artifact digest algorithm")))
+}
+
+func TestCacheGetCancelled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tarball := makeTarball(g, map[string]string{"a.yaml": "a"})
+
+	requested := make(chan struct{})
+	release := make(chan struct{})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(requested)
+		<-release
+		_, _ = w.Write(tarball)
+	}))
+	defer srv.Close()
+
+	cache := artifacts.NewCache(srv.Client(), 1024, 4096)
+	artifact := artifacts.Artifact{URL: srv.URL, Digest: digest(tarball)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+
+	go func() {
+		_, err := cache.Get(ctx, artifact)
+		first <- err
+	}()
+
+	<-requested
+
+	second := make(chan *artifacts.Archive)
+
+	go func() {
+		archive, _ := cache.Get(context.Background(), artifact)
+		second <- archive
+	}()
+
+	// Cancelling the caller that started the download doesn't cancel it
+	// for the others
+	cancel()
+	g.Expect(<-first).To(MatchError(context.Canceled))
+
+	close(release)
+
+	archive := <-second
+	g.Expect(archive).NotTo(BeNil())
+
+	content, ok := archive.ReadFile("a.yaml")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(string(content)).To(Equal("a"))
+}
+
+func digest(tarball []byte) string {
+	sum := sha256.Sum256(tarball)
+
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func makeTarball(g *WithT, files map[string]string) []byte {
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for name, content := range files {
+		g.Expect(tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o600, Size: int64(len(content))})).To(Succeed())
+		_, err := tw.Write([]byte(content))
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+
+	g.Expect(tw.Close()).To(Succeed())
+	g.Expect(gz.Close()).To(Succeed())
+
+	return buf.Bytes()
+}
@@ -0,0 +1,127 @@
+// Package audit records who made which changes through the dashboard, and
+// writes the records to sinks such as files or Kubernetes Events.
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
+)
+
+const (
+	ActionSync           = "sync"
+	ActionSuspend        = "suspend"
+	ActionResume         = "resume"
+	ActionScheduleFreeze = "schedule-freeze"
+	ActionRollback       = "rollback"
+	ActionApprovePlan    = "approve-plan"
+	ActionReplan         = "replan"
+
+	OutcomeSucceeded = "succeeded"
+	OutcomeFailed    = "failed"
+
+	// DefaultMaxEvents is how many events are kept to be listed by default.
+	DefaultMaxEvents = 1000
+)
+
+// Event is an action a user took on an object.
+type Event struct {
+	Time       time.Time `json:"time"`
+	RequestID  string    `json:"requestId,omitempty"`
+	Principal  string    `json:"principal"`
+	Groups     []string  `json:"groups,omitempty"`
+	Cluster    string    `json:"cluster"`
+	APIVersion string    `json:"apiVersion,omitempty"`
+	Kind       string    `json:"kind"`
+	Namespace  string    `json:"namespace,omitempty"`
+	Name       string    `json:"name"`
+	Action     string    `json:"action"`
+	Comment    string    `json:"comment,omitempty"`
+	Outcome    string    `json:"outcome"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// Sink writes audit events somewhere.
+type Sink interface {
+	Write(ctx context.Context, event Event) error
+}
+
+// Log writes events to all its sinks, and keeps the most recent ones so
+// they can be listed.
+type Log struct {
+	log   logr.Logger
+	sinks []Sink
+	max   int
+
+	mu     sync.RWMutex
+	events []Event
+}
+
+// NewLog returns a Log that keeps max events.
+func NewLog(log logr.Logger, max int, sinks ...Sink) *Log {
+	return &Log{
+		log:   log.WithName("audit"),
+		sinks: sinks,
+		max:   max,
+	}
+}
+
+// Record writes the event to the sinks. Failing to write to a sink is
+// logged, and doesn't stop the event being written to the others.
+func (l *Log) Record(ctx context.Context, event Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+
+	l.mu.Lock()
+	l.events = append(l.events, event)
+	if len(l.events) > l.max {
+		l.events = l.events[len(l.events)-l.max:]
+	}
+	l.mu.Unlock()
+
+	for _, sink := range l.sinks {
+		if err := sink.Write(ctx, event); err != nil {
+			l.log.Error(err, "unable to write audit event", "action", event.Action, "principal", event.Principal, "kind", event.Kind, "name", event.Name, "namespace", event.Namespace)
+		}
+	}
+}
+
+// List returns the most recent events that match, newest first, up to limit
+// events if it's positive.
+func (l *Log) List(match func(Event) bool, limit int) []Event {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	events := []Event{}
+
+	for i := len(l.events) - 1; i >= 0; i-- {
+		if limit > 0 && len(events) == limit {
+			break
+		}
+
+		if match(l.events[i]) {
+			events = append(events, l.events[i])
+		}
+	}
+
+	return events
+}
+
+// RequestID returns the ID of the request in the context, or a new one if
+// it has none.
+func RequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(middleware.RequestIDHeader); len(ids) > 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+
+	return uuid.NewString()
+}
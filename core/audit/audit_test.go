@@ -0,0 +1,142 @@
+package audit_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/audit"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/clustersmngrfakes"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
+)
+
+type failingSink struct{}
+
+func (failingSink) Write(context.Context, audit.Event) error {
+	return errors.New("unavailable")
+}
+
+func TestLog(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	fileSink, err := audit.NewFileSink(path)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// A failing sink doesn't stop the events being written to the others
+	log := audit.NewLog(logr.Discard(), 2, failingSink{}, fileSink)
+
+	for _, name := range []string{"a", "b", "c"} {
+		log.Record(ctx, audit.Event{Principal: "alice", Name: name, Action: audit.ActionSync, Outcome: audit.OutcomeSucceeded})
+	}
+
+	all := func(audit.Event) bool { return true }
+
+	events := log.List(all, 0)
+	g.Expect(events).To(HaveLen(2))
+	g.Expect(events[0].Name).To(Equal("c"))
+	g.Expect(events[1].Name).To(Equal("b"))
+	g.Expect(events[0].Time.IsZero()).To(BeFalse())
+
+	g.Expect(log.List(all, 1)).To(HaveLen(1))
+	g.Expect(log.List(func(e audit.Event) bool { return e.Name == "b" }, 0)).To(HaveLen(1))
+
+	data, err := os.ReadFile(path)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	g.Expect(lines).To(HaveLen(3))
+
+	var event audit.Event
+	g.Expect(json.Unmarshal([]byte(lines[0]), &event)).To(Succeed())
+	g.Expect(event.Name).To(Equal("a"))
+	g.Expect(event.Principal).To(Equal("alice"))
+}
+
+func TestEventSink(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	cluster := &clusterfakes.FakeCluster{}
+	cluster.GetNameReturns("Default")
+
+	pool := clustersmngr.NewClustersClientsPool()
+	g.Expect(pool.Add(fakeClient, cluster)).To(Succeed())
+
+	clustersManager := &clustersmngrfakes.FakeClustersManager{}
+	clustersManager.GetServerClientReturns(clustersmngr.NewClient(pool, nil, logr.Discard()), nil)
+
+	sink := audit.NewEventSink(clustersManager, "weave-gitops")
+
+	g.Expect(sink.Write(ctx, audit.Event{
+		RequestID:  "request-1",
+		Principal:  "alice",
+		Cluster:    "Default",
+		APIVersion: kustomizev1.GroupVersion.String(),
+		Kind:       kustomizev1.KustomizationKind,
+		Namespace:  "flux-system",
+		Name:       "apps",
+		Action:     audit.ActionScheduleFreeze,
+		Comment:    "release freeze",
+		Outcome:    audit.OutcomeFailed,
+		Error:      "forbidden",
+	})).To(Succeed())
+
+	events := &corev1.EventList{}
+	g.Expect(fakeClient.List(ctx, events)).To(Succeed())
+	g.Expect(events.Items).To(HaveLen(1))
+
+	event := events.Items[0]
+	g.Expect(event.Namespace).To(Equal("flux-system"))
+	g.Expect(event.InvolvedObject.Name).To(Equal("apps"))
+	g.Expect(event.InvolvedObject.Kind).To(Equal(kustomizev1.KustomizationKind))
+	g.Expect(event.Type).To(Equal(corev1.EventTypeWarning))
+	g.Expect(event.Reason).To(Equal("AuditScheduleFreeze"))
+	g.Expect(event.Message).To(Equal("schedule-freeze failed by alice: release freeze: forbidden"))
+
+	// The Events of cluster-scoped objects are in the server's namespace
+	g.Expect(sink.Write(ctx, audit.Event{
+		Principal: "alice",
+		Cluster:   "Default",
+		Kind:      "Namespace",
+		Name:      "apps",
+		Action:    audit.ActionSync,
+		Outcome:   audit.OutcomeSucceeded,
+	})).To(Succeed())
+
+	events = &corev1.EventList{}
+	g.Expect(fakeClient.List(ctx, events, client.InNamespace("weave-gitops"))).To(Succeed())
+	g.Expect(events.Items).To(HaveLen(1))
+	g.Expect(events.Items[0].InvolvedObject.Name).To(Equal("apps"))
+	g.Expect(events.Items[0].InvolvedObject.Namespace).To(BeEmpty())
+}
+
+func TestRequestID(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(middleware.RequestIDHeader, "request-1"))
+	g.Expect(audit.RequestID(ctx)).To(Equal("request-1"))
+
+	g.Expect(audit.RequestID(context.Background())).NotTo(BeEmpty())
+}
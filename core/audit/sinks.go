@@ -0,0 +1,132 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+)
+
+const eventSourceComponent = "weave-gitops"
+
+type jsonLinesSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJSONLinesSink returns a Sink writing each event as a line of JSON.
+func NewJSONLinesSink(w io.Writer) Sink {
+	return &jsonLinesSink{w: w}
+}
+
+// NewFileSink returns a Sink appending JSON lines to the file at path,
+// creating it if it doesn't exist.
+func NewFileSink(path string) (Sink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log file: %w", err)
+	}
+
+	return NewJSONLinesSink(f), nil
+}
+
+func (s *jsonLinesSink) Write(_ context.Context, event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err = s.w.Write(append(line, '\n'))
+
+	return err
+}
+
+type eventSink struct {
+	clustersManager clustersmngr.ClustersManager
+	namespace       string
+}
+
+// NewEventSink returns a Sink creating a Kubernetes Event for the object
+// each action was taken on, in the object's cluster. The Events of
+// cluster-scoped objects are created in namespace, the server's.
+func NewEventSink(clustersManager clustersmngr.ClustersManager, namespace string) Sink {
+	return &eventSink{clustersManager: clustersManager, namespace: namespace}
+}
+
+func (s *eventSink) Write(ctx context.Context, event Event) error {
+	c, err := s.clustersManager.GetServerClient(ctx)
+	if err != nil {
+		return fmt.Errorf("getting server client: %w", err)
+	}
+
+	eventType := corev1.EventTypeNormal
+	if event.Outcome != OutcomeSucceeded {
+		eventType = corev1.EventTypeWarning
+	}
+
+	message := fmt.Sprintf("%s %s by %s", event.Action, event.Outcome, event.Principal)
+	if event.Comment != "" {
+		message += ": " + event.Comment
+	}
+
+	if event.Error != "" {
+		message += ": " + event.Error
+	}
+
+	now := metav1.NewTime(event.Time)
+
+	namespace := event.Namespace
+	if namespace == "" {
+		namespace = s.namespace
+	}
+
+	k8sEvent := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: event.Name + ".",
+			Namespace:    namespace,
+			Annotations: map[string]string{
+				"audit.weave.works/principal":  event.Principal,
+				"audit.weave.works/request-id": event.RequestID,
+			},
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: event.APIVersion,
+			Kind:       event.Kind,
+			Namespace:  event.Namespace,
+			Name:       event.Name,
+		},
+		Reason:              "Audit" + camelCase(event.Action),
+		Message:             message,
+		Type:                eventType,
+		Source:              corev1.EventSource{Component: eventSourceComponent},
+		ReportingController: eventSourceComponent,
+		FirstTimestamp:      now,
+		LastTimestamp:       now,
+		Count:               1,
+	}
+
+	return c.Create(ctx, event.Cluster, k8sEvent)
+}
+
+// camelCase turns an action such as schedule-freeze into ScheduleFreeze.
+func camelCase(action string) string {
+	words := strings.Split(action, "-")
+	for i, w := range words {
+		if w != "" {
+			words[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+	}
+
+	return strings.Join(words, "")
+}
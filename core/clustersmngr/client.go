@@ -13,6 +13,10 @@ import (
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -41,6 +45,12 @@ type Client interface {
 	// that would be required to make sure the number of items returned match the limit passed.
 	ClusteredList(ctx context.Context, clist ClusteredObjectList, namespaced bool, opts ...client.ListOption) error
 
+	// ClusteredWatch loops through the list of clusters and namespaces the client has access and
+	// watches the objects of the list type returned by listFactory in each of them, merging all
+	// the events in the returned channel. If an InNamespace option is passed only that namespace is watched.
+	// The channel is closed once the context is done or every watch has failed.
+	ClusteredWatch(ctx context.Context, listFactory func() client.ObjectList, namespaced bool, opts ...client.ListOption) <-chan ClusteredWatchEvent
+
 	// ClientsPool returns the clients pool.
 	ClientsPool() ClientsPool
 
@@ -52,7 +62,8 @@ type Client interface {
 }
 
 const (
-	clientTimeout = 30 * time.Second
+	clientTimeout     = 30 * time.Second
+	watchRestartDelay = time.Second
 )
 
 type clustersClient struct {
@@ -110,10 +121,15 @@ func (c *clustersClient) Get(ctx context.Context, cluster string, key client.Obj
 		return err
 	}
 
+	ctx, span := startSpan(ctx, "Get", cluster, key, obj, client.Scheme())
+
 	ctx, cancel := context.WithTimeout(ctx, clientTimeout)
 	defer cancel()
 
-	return client.Get(ctx, key, obj)
+	err = client.Get(ctx, key, obj)
+	endSpan(span, err)
+
+	return err
 }
 
 func (c *clustersClient) List(ctx context.Context, cluster string, list client.ObjectList, opts ...client.ListOption) error {
@@ -122,6 +138,8 @@ func (c *clustersClient) List(ctx context.Context, cluster string, list client.O
 		return err
 	}
 
+	ctx, span := startSpan(ctx, "List", cluster, types.NamespacedName{Namespace: extractNamespace(opts...)}, list, client.Scheme())
+
 	// Due to how DelegatingClients work, calls that fail never return,
 	// because it waits the cache to sync before returning it https://github.com/kubernetes-sigs/controller-runtime/blob/master/pkg/cache/internal/informers_map.go#L206
 	// so we are forced to use a timeout so it doesn't keep the informer up.
@@ -129,7 +147,10 @@ func (c *clustersClient) List(ctx context.Context, cluster string, list client.O
 	ctx, cancel := context.WithTimeout(ctx, clientTimeout)
 	defer cancel()
 
-	if err := client.List(ctx, list, opts...); err != nil {
+	err = client.List(ctx, list, opts...)
+	endSpan(span, err)
+
+	if err != nil {
 		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 			c.log.Error(err, "listing resources context issue", "cluster", cluster)
 
@@ -157,6 +178,9 @@ func (c *clustersClient) ClusteredList(ctx context.Context, clist ClusteredObjec
 		wg   = sync.WaitGroup{}
 	)
 
+	ctx, span := tracer.Start(ctx, "clustersmngr.ClusteredList")
+	defer span.End()
+
 	for clusterName, cc := range c.pool.Clients() {
 		namespaces := c.namespaces[clusterName]
 		if !namespaced {
@@ -182,10 +206,15 @@ func (c *clustersClient) ClusteredList(ctx context.Context, clist ClusteredObjec
 
 				list := clist.NewList()
 
+				ctx, span := startSpan(ctx, "List", clusterName, client.ObjectKey{Namespace: nsName}, list, c.Scheme())
+
 				ctx, cancel := context.WithTimeout(ctx, clientTimeout)
 				defer cancel()
 
-				if err := c.List(ctx, list, optsWithNamespace...); err != nil {
+				err := c.List(ctx, list, optsWithNamespace...)
+				endSpan(span, err)
+
+				if err != nil {
 					errs.Add(ListError{Cluster: clusterName, Namespace: nsName, Err: err})
 				}
 
@@ -225,13 +254,166 @@ func extractContinueToken(opts ...client.ListOption) string {
 	return ""
 }
 
+// ClusteredWatchEvent is an event received by one of the watches started by ClusteredWatch.
+type ClusteredWatchEvent struct {
+	Cluster   string
+	Namespace string
+	Type      watch.EventType
+	Object    client.Object
+	// Err is set when the watch on Cluster and Namespace failed and has been stopped.
+	Err error
+}
+
+func (c *clustersClient) ClusteredWatch(ctx context.Context, listFactory func() client.ObjectList, namespaced bool, opts ...client.ListOption) <-chan ClusteredWatchEvent {
+	events := make(chan ClusteredWatchEvent)
+	onlyNamespace := extractNamespace(opts...)
+	wg := sync.WaitGroup{}
+
+	for clusterName, cc := range c.pool.Clients() {
+		namespaces := c.namespaces[clusterName]
+		if !namespaced {
+			namespaces = []v1.Namespace{{}}
+		}
+
+		for _, ns := range namespaces {
+			if namespaced && onlyNamespace != "" && ns.Name != onlyNamespace {
+				continue
+			}
+
+			wg.Add(1)
+
+			go func(clusterName, nsName string, cc client.Client) {
+				defer wg.Done()
+
+				if err := c.watch(ctx, clusterName, nsName, cc, listFactory, events, opts...); err != nil {
+					select {
+					case events <- ClusteredWatchEvent{Cluster: clusterName, Namespace: nsName, Err: err}:
+					case <-ctx.Done():
+					}
+				}
+			}(clusterName, ns.Name, cc)
+		}
+	}
+
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+
+	return events
+}
+
+// watch sends the events for a single cluster and namespace until the context is done.
+// Watches closed by the API server are resumed from the last seen resource version.
+func (c *clustersClient) watch(ctx context.Context, clusterName, nsName string, cc client.Client, listFactory func() client.ObjectList, events chan<- ClusteredWatchEvent, opts ...client.ListOption) error {
+	wc, ok := cc.(client.WithWatch)
+	if !ok {
+		return errors.New("cluster client does not support watching")
+	}
+
+	resourceVersion := ""
+
+	for {
+		watchOpts := append([]client.ListOption{&client.ListOptions{Raw: &metav1.ListOptions{ResourceVersion: resourceVersion}}}, opts...)
+		watchOpts = append(watchOpts, client.InNamespace(nsName))
+
+		w, err := wc.Watch(ctx, listFactory(), watchOpts...)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		resourceVersion, err = forwardWatchEvents(ctx, w, clusterName, nsName, resourceVersion, events)
+
+		w.Stop()
+
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		c.log.V(1).Info("watch closed, restarting", "cluster", clusterName, "namespace", nsName, "resourceVersion", resourceVersion)
+
+		select {
+		case <-time.After(watchRestartDelay):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// forwardWatchEvents sends the events of w until it's closed or the context is done,
+// returning the resource version to resume watching from.
+func forwardWatchEvents(ctx context.Context, w watch.Interface, clusterName, nsName, resourceVersion string, events chan<- ClusteredWatchEvent) (string, error) {
+	for {
+		var (
+			ev watch.Event
+			ok bool
+		)
+
+		select {
+		case ev, ok = <-w.ResultChan():
+			if !ok {
+				return resourceVersion, nil
+			}
+		case <-ctx.Done():
+			return resourceVersion, nil
+		}
+
+		if ev.Type == watch.Error {
+			err := apierrors.FromObject(ev.Object)
+			if !apierrors.IsResourceExpired(err) && !apierrors.IsGone(err) {
+				return resourceVersion, err
+			}
+
+			// Our resource version is too old, start over and get a fresh set of objects
+			return "", nil
+		}
+
+		obj, ok := ev.Object.(client.Object)
+		if !ok {
+			continue
+		}
+
+		resourceVersion = obj.GetResourceVersion()
+
+		if ev.Type == watch.Bookmark {
+			continue
+		}
+
+		select {
+		case events <- ClusteredWatchEvent{Cluster: clusterName, Namespace: nsName, Type: ev.Type, Object: obj}:
+		case <-ctx.Done():
+			return resourceVersion, nil
+		}
+	}
+}
+
+func extractNamespace(opts ...client.ListOption) string {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	return listOpts.Namespace
+}
+
 func (c *clustersClient) Create(ctx context.Context, cluster string, obj client.Object, opts ...client.CreateOption) error {
 	client, err := c.pool.Client(cluster)
 	if err != nil {
 		return err
 	}
 
-	return client.Create(ctx, obj, opts...)
+	ctx, span := startSpan(ctx, "Create", cluster, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, obj, client.Scheme())
+
+	err = client.Create(ctx, obj, opts...)
+	endSpan(span, err)
+
+	return err
 }
 
 func (c *clustersClient) Delete(ctx context.Context, cluster string, obj client.Object, opts ...client.DeleteOption) error {
@@ -240,7 +422,12 @@ func (c *clustersClient) Delete(ctx context.Context, cluster string, obj client.
 		return err
 	}
 
-	return client.Delete(ctx, obj, opts...)
+	ctx, span := startSpan(ctx, "Delete", cluster, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, obj, client.Scheme())
+
+	err = client.Delete(ctx, obj, opts...)
+	endSpan(span, err)
+
+	return err
 }
 
 func (c *clustersClient) Update(ctx context.Context, cluster string, obj client.Object, opts ...client.UpdateOption) error {
@@ -249,7 +436,12 @@ func (c *clustersClient) Update(ctx context.Context, cluster string, obj client.
 		return err
 	}
 
-	return client.Update(ctx, obj, opts...)
+	ctx, span := startSpan(ctx, "Update", cluster, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, obj, client.Scheme())
+
+	err = client.Update(ctx, obj, opts...)
+	endSpan(span, err)
+
+	return err
 }
 
 func (c *clustersClient) Patch(ctx context.Context, cluster string, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
@@ -258,7 +450,12 @@ func (c *clustersClient) Patch(ctx context.Context, cluster string, obj client.O
 		return err
 	}
 
-	return client.Patch(ctx, obj, patch, opts...)
+	ctx, span := startSpan(ctx, "Patch", cluster, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, obj, client.Scheme())
+
+	err = client.Patch(ctx, obj, patch, opts...)
+	endSpan(span, err)
+
+	return err
 }
 
 func (c clustersClient) Scoped(cluster string) (client.Client, error) {
@@ -12,6 +12,12 @@ import (
 	sourcev1 "github.com/fluxcd/source-controller/api/v1"
 	"github.com/go-logr/logr"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -472,6 +478,61 @@ func TestClientPatch(t *testing.T) {
 	g.Expect(k.Spec.Path).To(Equal("/foo"))
 }
 
+func TestClientSpans(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ns := createNamespace(g)
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(tracenoop.NewTracerProvider())
+
+	clusterName := "mycluster"
+
+	clientsPool := createClusterClientsPool(g, clusterName)
+
+	nsMap := map[string][]corev1.Namespace{
+		clusterName: {*ns},
+	}
+
+	clustersClient := clustersmngr.NewClient(clientsPool, nsMap, logr.Discard())
+
+	ctx := context.Background()
+
+	k := &kustomizev1.Kustomization{}
+	g.Expect(clustersClient.Get(ctx, clusterName, types.NamespacedName{Name: "missing", Namespace: ns.Name}, k)).NotTo(Succeed())
+
+	cklist := clustersmngr.NewClusteredList(func() client.ObjectList {
+		return &kustomizev1.KustomizationList{}
+	})
+	g.Expect(clustersClient.ClusteredList(ctx, cklist, true)).To(Succeed())
+
+	spans := recorder.Ended()
+	g.Expect(spans).To(HaveLen(3))
+
+	get := spans[0]
+	g.Expect(get.Name()).To(Equal("clustersmngr.Get"))
+	g.Expect(get.Status().Code).To(Equal(codes.Error))
+	g.Expect(get.Attributes()).To(ContainElements(
+		attribute.String("k8s.cluster.name", clusterName),
+		attribute.String("k8s.namespace.name", ns.Name),
+		clustersmngr.NameKey.String("missing"),
+		clustersmngr.KindKey.String(kustomizev1.KustomizationKind),
+	))
+
+	// The list of each namespace is a child of the span of the clustered
+	// list
+	list, clusteredList := spans[1], spans[2]
+	g.Expect(clusteredList.Name()).To(Equal("clustersmngr.ClusteredList"))
+	g.Expect(list.Name()).To(Equal("clustersmngr.List"))
+	g.Expect(list.Parent().SpanID()).To(Equal(clusteredList.SpanContext().SpanID()))
+	g.Expect(list.Attributes()).To(ContainElements(
+		attribute.String("k8s.namespace.name", ns.Name),
+		clustersmngr.KindKey.String("KustomizationList"),
+	))
+}
+
 func createNamespace(g *GomegaWithT) *corev1.Namespace {
 	ns := &corev1.Namespace{}
 	ns.Name = "kube-test-" + rand.String(5)
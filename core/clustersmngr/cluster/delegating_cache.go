@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -86,9 +87,24 @@ func (c *delegatingCacheCluster) makeCachingClient(leafClient client.Client) (cl
 		return nil, errors.New("failed syncing client cache")
 	}
 
+	// Watches always go to the API server, the cache only serves reads.
+	if watchingClient, ok := leafClient.(client.WithWatch); ok {
+		return &delegatingWatchClient{Client: delegatingClient, watcher: watchingClient}, nil
+	}
+
 	return delegatingClient, nil
 }
 
+type delegatingWatchClient struct {
+	client.Client
+
+	watcher client.WithWatch
+}
+
+func (c *delegatingWatchClient) Watch(ctx context.Context, obj client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+	return c.watcher.Watch(ctx, obj, opts...)
+}
+
 func (c *delegatingCacheCluster) GetUserClient(user *auth.UserPrincipal) (client.Client, error) {
 	client, err := c.cluster.GetUserClient(user)
 	if err != nil {
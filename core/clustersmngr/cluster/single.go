@@ -69,7 +69,8 @@ func getClientFromConfig(config *rest.Config, scheme *apiruntime.Scheme) (client
 		return nil, fmt.Errorf("could not create RESTMapper from config: %w", err)
 	}
 
-	client, err := client.New(config, client.Options{
+	// Use a watching client so that callers can stream changes, see clustersmngr.Client.ClusteredWatch
+	client, err := client.NewWithWatch(config, client.Options{
 		Scheme: scheme,
 		Mapper: mapper,
 	})
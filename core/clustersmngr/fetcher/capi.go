@@ -0,0 +1,99 @@
+package fetcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mngr "github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+// CAPIClusterGVK is the Cluster API Cluster kind read by the CAPI fetcher.
+// We use unstructured objects so we don't need to depend on the CAPI module.
+var CAPIClusterGVK = schema.GroupVersionKind{
+	Group:   "cluster.x-k8s.io",
+	Version: "v1beta1",
+	Kind:    "Cluster",
+}
+
+type capiClusterFetcher struct {
+	log       logr.Logger
+	client    client.Client
+	namespace string
+	builder   *clusterBuilder
+}
+
+// NewCAPIClusterFetcher returns a ClusterFetcher that discovers leaf clusters
+// from Cluster API Cluster objects. Only clusters whose control plane is ready
+// are returned, using the kubeconfig from the <cluster>-kubeconfig Secret that
+// CAPI creates alongside the Cluster. If namespace is empty, Clusters are looked
+// up in all namespaces.
+func NewCAPIClusterFetcher(log logr.Logger, cl client.Client, namespace string, scheme *apiruntime.Scheme, userPrefixes kube.UserPrefixes, kubeConfigOptions ...cluster.KubeConfigOption) mngr.ClusterFetcher {
+	return &capiClusterFetcher{
+		log:       log.WithName("capi-cluster-fetcher"),
+		client:    cl,
+		namespace: namespace,
+		builder:   newClusterBuilder(scheme, userPrefixes, kubeConfigOptions),
+	}
+}
+
+func (f *capiClusterFetcher) Fetch(ctx context.Context) ([]cluster.Cluster, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(CAPIClusterGVK.GroupVersion().WithKind(CAPIClusterGVK.Kind + "List"))
+
+	if err := f.client.List(ctx, list, client.InNamespace(f.namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			f.log.V(1).Info("Cluster API is not installed, no clusters to fetch")
+			return []cluster.Cluster{}, nil
+		}
+
+		return nil, fmt.Errorf("listing CAPI clusters: %w", err)
+	}
+
+	clusters := []cluster.Cluster{}
+
+	for _, capiCluster := range list.Items {
+		key := client.ObjectKeyFromObject(&capiCluster)
+
+		ready, _, _ := unstructured.NestedBool(capiCluster.Object, "status", "controlPlaneReady")
+		if !ready {
+			f.log.V(1).Info("skipping cluster, control plane not ready", "cluster", key)
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		secretKey := client.ObjectKey{Namespace: key.Namespace, Name: key.Name + "-kubeconfig"}
+
+		if err := f.client.Get(ctx, secretKey, secret); err != nil {
+			f.log.Error(err, "skipping cluster, failed to get kubeconfig secret", "cluster", key, "secret", secretKey)
+			continue
+		}
+
+		kubeconfig, ok := secret.Data[KubeconfigSecretKey]
+		if !ok {
+			f.log.Error(nil, "skipping cluster, kubeconfig secret is missing kubeconfig", "cluster", key, "secret", secretKey, "key", KubeconfigSecretKey)
+			continue
+		}
+
+		cl, err := f.builder.build(key.String(), secret.ResourceVersion, kubeconfig)
+		if err != nil {
+			f.log.Error(err, "skipping cluster", "cluster", key)
+			continue
+		}
+
+		clusters = append(clusters, cl)
+	}
+
+	f.builder.prune(clusters)
+
+	return clusters, nil
+}
@@ -0,0 +1,51 @@
+package fetcher_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/fetcher"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestCAPIFetcher(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	scheme.AddKnownTypeWithName(fetcher.CAPIClusterGVK, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(fetcher.CAPIClusterGVK.GroupVersion().WithKind("ClusterList"), &unstructured.UnstructuredList{})
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newCAPICluster("ready", "clusters", true),
+		newCAPICluster("provisioning", "clusters", false),
+		newCAPICluster("no-secret", "clusters", true),
+		newClusterSecret("ready-kubeconfig", "clusters", nil, testKubeconfig),
+		newClusterSecret("provisioning-kubeconfig", "clusters", nil, testKubeconfig),
+	).Build()
+
+	f := fetcher.NewCAPIClusterFetcher(logr.Discard(), k8sClient, "", scheme, kube.UserPrefixes{})
+
+	clusters, err := f.Fetch(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(clusters).To(HaveLen(1))
+	g.Expect(clusters[0].GetName()).To(Equal("clusters/ready"))
+	g.Expect(clusters[0].GetHost()).To(Equal("https://leaf.example.com:6443"))
+}
+
+func newCAPICluster(name, namespace string, controlPlaneReady bool) client.Object {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(fetcher.CAPIClusterGVK)
+	u.SetName(name)
+	u.SetNamespace(namespace)
+	_ = unstructured.SetNestedField(u.Object, controlPlaneReady, "status", "controlPlaneReady")
+
+	return u
+}
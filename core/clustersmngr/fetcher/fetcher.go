@@ -0,0 +1,37 @@
+package fetcher
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Names of the cluster fetchers that can be enabled in the gitops-server.
+const (
+	// DefaultFetcherName fetches the cluster the server is running in.
+	DefaultFetcherName = "default"
+	// SecretsFetcherName fetches clusters from labelled kubeconfig Secrets.
+	SecretsFetcherName = "secrets"
+	// CAPIFetcherName fetches clusters from Cluster API Cluster objects.
+	CAPIFetcherName = "capi"
+)
+
+// AllFetcherNames returns the names of all the available cluster fetchers.
+func AllFetcherNames() []string {
+	return []string{DefaultFetcherName, SecretsFetcherName, CAPIFetcherName}
+}
+
+// ValidateFetcherNames returns an error if any of the names isn't a known fetcher.
+func ValidateFetcherNames(names []string) error {
+	if len(names) == 0 {
+		return fmt.Errorf("at least one cluster fetcher must be enabled")
+	}
+
+	for _, name := range names {
+		if !slices.Contains(AllFetcherNames(), name) {
+			return fmt.Errorf("unknown cluster fetcher %q, valid values are %s", name, strings.Join(AllFetcherNames(), ","))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package fetcher
+
+import (
+	"fmt"
+	"sync"
+
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+const (
+	// KubeconfigSecretKey is the key holding the kubeconfig in a cluster secret.
+	// This matches the convention used by Cluster API.
+	KubeconfigSecretKey = "value"
+)
+
+// clusterBuilder creates clusters from kubeconfig data, reusing clusters
+// whose kubeconfig hasn't changed since the previous fetch. Building a
+// cluster may probe the remote API server (e.g. for flowcontrol), so we
+// avoid doing it on every poll.
+type clusterBuilder struct {
+	scheme            *apiruntime.Scheme
+	userPrefixes      kube.UserPrefixes
+	kubeConfigOptions []cluster.KubeConfigOption
+
+	mu       sync.Mutex
+	clusters map[string]cachedCluster
+}
+
+type cachedCluster struct {
+	version string
+	cluster cluster.Cluster
+}
+
+func newClusterBuilder(scheme *apiruntime.Scheme, userPrefixes kube.UserPrefixes, kubeConfigOptions []cluster.KubeConfigOption) *clusterBuilder {
+	return &clusterBuilder{
+		scheme:            scheme,
+		userPrefixes:      userPrefixes,
+		kubeConfigOptions: kubeConfigOptions,
+		clusters:          map[string]cachedCluster{},
+	}
+}
+
+// build returns the cluster for the given name, creating it from the kubeconfig
+// if it hasn't been seen before or if its version has changed.
+func (b *clusterBuilder) build(name, version string, kubeconfig []byte) (cluster.Cluster, error) {
+	b.mu.Lock()
+	cached, ok := b.clusters[name]
+	b.mu.Unlock()
+
+	if ok && cached.version == version {
+		return cached.cluster, nil
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("parsing kubeconfig for cluster %s: %w", name, err)
+	}
+
+	cl, err := cluster.NewSingleCluster(name, restConfig, b.scheme, b.userPrefixes, b.kubeConfigOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("creating cluster %s: %w", name, err)
+	}
+
+	b.mu.Lock()
+	b.clusters[name] = cachedCluster{version: version, cluster: cl}
+	b.mu.Unlock()
+
+	return cl, nil
+}
+
+// prune forgets every cluster that isn't in the given list.
+func (b *clusterBuilder) prune(clusters []cluster.Cluster) {
+	keep := map[string]bool{}
+	for _, cl := range clusters {
+		keep[cl.GetName()] = true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for name := range b.clusters {
+		if !keep[name] {
+			delete(b.clusters, name)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package fetcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mngr "github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+const (
+	// SecretClusterLabel marks a Secret as holding the kubeconfig of a leaf cluster.
+	SecretClusterLabel = "weave.works/cluster-kubeconfig"
+	// SecretClusterNameAnnotation overrides the name given to the cluster
+	// discovered from a Secret. Defaults to <namespace>/<name> of the Secret.
+	SecretClusterNameAnnotation = "weave.works/cluster-name"
+)
+
+type secretsClusterFetcher struct {
+	log       logr.Logger
+	client    client.Client
+	namespace string
+	builder   *clusterBuilder
+}
+
+// NewSecretsClusterFetcher returns a ClusterFetcher that discovers leaf clusters
+// from Secrets labelled with SecretClusterLabel=true. The kubeconfig is read from
+// the KubeconfigSecretKey key. If namespace is empty, Secrets are looked up in all
+// namespaces.
+func NewSecretsClusterFetcher(log logr.Logger, cl client.Client, namespace string, scheme *apiruntime.Scheme, userPrefixes kube.UserPrefixes, kubeConfigOptions ...cluster.KubeConfigOption) mngr.ClusterFetcher {
+	return &secretsClusterFetcher{
+		log:       log.WithName("secrets-cluster-fetcher"),
+		client:    cl,
+		namespace: namespace,
+		builder:   newClusterBuilder(scheme, userPrefixes, kubeConfigOptions),
+	}
+}
+
+func (f *secretsClusterFetcher) Fetch(ctx context.Context) ([]cluster.Cluster, error) {
+	secrets := &corev1.SecretList{}
+
+	if err := f.client.List(ctx, secrets, client.InNamespace(f.namespace), client.MatchingLabels{SecretClusterLabel: "true"}); err != nil {
+		return nil, fmt.Errorf("listing cluster secrets: %w", err)
+	}
+
+	clusters := []cluster.Cluster{}
+
+	for _, secret := range secrets.Items {
+		name := secret.GetAnnotations()[SecretClusterNameAnnotation]
+		if name == "" {
+			name = secret.Namespace + "/" + secret.Name
+		}
+
+		kubeconfig, ok := secret.Data[KubeconfigSecretKey]
+		if !ok {
+			f.log.Error(nil, "cluster secret is missing kubeconfig", "secret", client.ObjectKeyFromObject(&secret), "key", KubeconfigSecretKey)
+			continue
+		}
+
+		cl, err := f.builder.build(name, secret.ResourceVersion, kubeconfig)
+		if err != nil {
+			// One broken secret shouldn't hide all the other clusters
+			f.log.Error(err, "skipping cluster", "secret", client.ObjectKeyFromObject(&secret))
+			continue
+		}
+
+		clusters = append(clusters, cl)
+	}
+
+	f.builder.prune(clusters)
+
+	return clusters, nil
+}
@@ -0,0 +1,109 @@
+package fetcher_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/fetcher"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: leaf
+  cluster:
+    server: https://leaf.example.com:6443
+contexts:
+- name: leaf
+  context:
+    cluster: leaf
+    user: leaf
+current-context: leaf
+users:
+- name: leaf
+  user:
+    token: my-token
+`
+
+func TestSecretsFetcher(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newClusterSecret("leaf-1", "clusters", nil, testKubeconfig),
+		newClusterSecret("leaf-2", "clusters", map[string]string{fetcher.SecretClusterNameAnnotation: "production"}, testKubeconfig),
+		newClusterSecret("broken", "clusters", nil, "not a kubeconfig"),
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "unlabelled", Namespace: "clusters"},
+			Data:       map[string][]byte{fetcher.KubeconfigSecretKey: []byte(testKubeconfig)},
+		},
+	).Build()
+
+	f := fetcher.NewSecretsClusterFetcher(logr.Discard(), k8sClient, "", scheme, kube.UserPrefixes{})
+
+	clusters, err := f.Fetch(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	names := []string{}
+	for _, c := range clusters {
+		names = append(names, c.GetName())
+		g.Expect(c.GetHost()).To(Equal("https://leaf.example.com:6443"))
+	}
+
+	g.Expect(names).To(ConsistOf("clusters/leaf-1", "production"))
+
+	// Unchanged secrets reuse the same cluster
+	again, err := f.Fetch(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(again).To(HaveLen(2))
+	g.Expect(again[0]).To(BeIdenticalTo(clusters[0]))
+}
+
+func TestSecretsFetcherNamespace(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newClusterSecret("leaf-1", "clusters", nil, testKubeconfig),
+		newClusterSecret("leaf-2", "other", nil, testKubeconfig),
+	).Build()
+
+	f := fetcher.NewSecretsClusterFetcher(logr.Discard(), k8sClient, "clusters", scheme, kube.UserPrefixes{})
+
+	clusters, err := f.Fetch(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(clusters).To(HaveLen(1))
+	g.Expect(clusters[0].GetName()).To(Equal("clusters/leaf-1"))
+}
+
+func TestValidateFetcherNames(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(fetcher.ValidateFetcherNames([]string{"default", "secrets", "capi"})).To(Succeed())
+	g.Expect(fetcher.ValidateFetcherNames([]string{})).NotTo(Succeed())
+	g.Expect(fetcher.ValidateFetcherNames([]string{"default", "foo"})).To(MatchError(ContainSubstring(`unknown cluster fetcher "foo"`)))
+}
+
+func newClusterSecret(name, namespace string, annotations map[string]string, kubeconfig string) client.Object {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      map[string]string{fetcher.SecretClusterLabel: "true"},
+			Annotations: annotations,
+		},
+		Data: map[string][]byte{fetcher.KubeconfigSecretKey: []byte(kubeconfig)},
+	}
+}
@@ -0,0 +1,64 @@
+package clustersmngr
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// Attributes of the spans of calls to the clusters
+const (
+	// KindKey holds the kind of the object or list.
+	KindKey = attribute.Key("k8s.object.kind")
+	// NameKey holds the name of the object.
+	NameKey = attribute.Key("k8s.object.name")
+)
+
+var tracer = otel.Tracer("github.com/weaveworks/weave-gitops/core/clustersmngr")
+
+// startSpan starts the span of a call to a cluster, recording the cluster,
+// and the namespace, name and kind of the object it's about. Lists have no
+// name.
+func startSpan(ctx context.Context, name, cluster string, key client.ObjectKey, obj runtime.Object, scheme *runtime.Scheme) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{semconv.K8SClusterName(cluster)}
+
+	if key.Namespace != "" {
+		attrs = append(attrs, semconv.K8SNamespaceName(key.Namespace))
+	}
+
+	if key.Name != "" {
+		attrs = append(attrs, NameKey.String(key.Name))
+	}
+
+	// Typed objects usually don't have their kind set, it's looked up in
+	// the scheme
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if kind == "" && scheme != nil {
+		if gvk, err := apiutil.GVKForObject(obj, scheme); err == nil {
+			kind = gvk.Kind
+		}
+	}
+
+	if kind != "" {
+		attrs = append(attrs, KindKey.String(kind))
+	}
+
+	return tracer.Start(ctx, "clustersmngr."+name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// endSpan records the error the call returned, if any, and ends its span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
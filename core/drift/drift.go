@@ -0,0 +1,210 @@
+// Package drift compares the objects Flux applied with their live state, to
+// find the changes made to them outside of Flux, e.g. with kubectl edit.
+package drift
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const maskedValue = "***"
+
+// ignoredFields are set by the API server or by controllers, so they're
+// never drift.
+var ignoredFields = map[string]bool{
+	"status":                     true,
+	"metadata.managedFields":     true,
+	"metadata.resourceVersion":   true,
+	"metadata.generation":        true,
+	"metadata.uid":               true,
+	"metadata.creationTimestamp": true,
+	"metadata.selfLink":          true,
+}
+
+// Report is the drift of the objects applied by an automation.
+type Report struct {
+	// Revision is the source revision the objects were last applied from.
+	Revision string
+	// Checked is how many objects were compared.
+	Checked int
+	// Objects holds the objects that drifted, or couldn't be compared.
+	Objects []ObjectDrift
+	// Warnings explain why the report may be inaccurate.
+	Warnings []string
+}
+
+// ObjectDrift is how a live object differs from what was applied.
+type ObjectDrift struct {
+	APIVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+	// Deleted is true if the object no longer exists.
+	Deleted bool
+	Fields  []FieldDrift
+	// Error is why the object couldn't be compared.
+	Error string
+}
+
+// FieldDrift is a field whose live value differs from the applied one.
+type FieldDrift struct {
+	// Path is the field's path, e.g. spec.template.spec.containers[0].image.
+	Path string
+	// Applied is the applied value as JSON, empty if the field wasn't
+	// applied.
+	Applied string
+	// Live is the live value as JSON, empty if the field was removed.
+	Live string
+}
+
+func newObjectDrift(obj *unstructured.Unstructured) ObjectDrift {
+	return ObjectDrift{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// compareFields returns the fields whose live value differs from the
+// applied one. If appliedOnly is set, fields that weren't applied are left
+// out, as the API server and controllers add fields of their own. Secret
+// values are masked.
+func compareFields(applied, live *unstructured.Unstructured, appliedOnly bool) []FieldDrift {
+	c := comparer{appliedOnly: appliedOnly, secret: applied.GetKind() == "Secret" && applied.GroupVersionKind().Group == ""}
+
+	a, l := applied.Object, live.Object
+	if c.secret {
+		a = secretWithData(a)
+	}
+
+	c.compare("", a, l, true)
+
+	sort.SliceStable(c.fields, func(i, j int) bool {
+		return c.fields[i].Path < c.fields[j].Path
+	})
+
+	return c.fields
+}
+
+type comparer struct {
+	appliedOnly bool
+	secret      bool
+	fields      []FieldDrift
+}
+
+func (c *comparer) compare(path string, applied, live any, liveFound bool) {
+	if ignoredFields[path] {
+		return
+	}
+
+	if !liveFound {
+		c.add(path, applied, nil, false)
+		return
+	}
+
+	switch a := applied.(type) {
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			c.add(path, applied, live, true)
+			return
+		}
+
+		for k, v := range a {
+			lv, ok := l[k]
+			c.compare(joinPath(path, k), v, lv, ok)
+		}
+
+		if c.appliedOnly {
+			return
+		}
+
+		for k, v := range l {
+			if _, ok := a[k]; !ok && !ignoredFields[joinPath(path, k)] {
+				c.fields = append(c.fields, FieldDrift{Path: joinPath(path, k), Live: c.format(joinPath(path, k), v)})
+			}
+		}
+	case []any:
+		l, ok := live.([]any)
+		if !ok || len(a) != len(l) {
+			c.add(path, applied, live, true)
+			return
+		}
+
+		for i := range a {
+			c.compare(fmt.Sprintf("%s[%d]", path, i), a[i], l[i], true)
+		}
+	default:
+		// The API server normalizes values, e.g. quantities to strings
+		if fmt.Sprint(applied) != fmt.Sprint(live) {
+			c.add(path, applied, live, true)
+		}
+	}
+}
+
+func (c *comparer) add(path string, applied, live any, liveFound bool) {
+	f := FieldDrift{Path: path, Applied: c.format(path, applied)}
+	if liveFound {
+		f.Live = c.format(path, live)
+	}
+
+	c.fields = append(c.fields, f)
+}
+
+func (c *comparer) format(path string, v any) string {
+	if c.secret && (path == "data" || strings.HasPrefix(path, "data.") || strings.HasPrefix(path, "data[")) {
+		return maskedValue
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(b)
+}
+
+// joinPath appends a key to a path, quoting keys that aren't identifiers,
+// like labels.
+func joinPath(path, key string) string {
+	if strings.ContainsAny(key, "./ ") {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// secretWithData moves the stringData of a Secret into its data, as the API
+// server does.
+func secretWithData(obj map[string]any) map[string]any {
+	stringData, ok, _ := unstructured.NestedStringMap(obj, "stringData")
+	if !ok {
+		return obj
+	}
+
+	obj = (&unstructured.Unstructured{Object: obj}).DeepCopy().Object
+	delete(obj, "stringData")
+
+	data, _, _ := unstructured.NestedMap(obj, "data")
+	if data == nil {
+		data = map[string]any{}
+	}
+
+	for k, v := range stringData {
+		data[k] = base64.StdEncoding.EncodeToString([]byte(v))
+	}
+
+	obj["data"] = data
+
+	return obj
+}
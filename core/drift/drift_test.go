@@ -0,0 +1,212 @@
+package drift_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/drift"
+	"github.com/weaveworks/weave-gitops/core/helmstorage"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+const manifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: podinfo
+  labels:
+    app.kubernetes.io/name: podinfo
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app: podinfo
+  template:
+    metadata:
+      labels:
+        app: podinfo
+    spec:
+      containers:
+      - name: podinfo
+        image: ghcr.io/stefanprodan/podinfo:6.0.0
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: podinfo-config
+data:
+  key: value
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: podinfo-token
+stringData:
+  token: applied
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: podinfo
+spec:
+  ports:
+  - port: 9898
+`
+
+func TestHelmRelease(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "flux-system"},
+		Status: helmv2.HelmReleaseStatus{
+			StorageNamespace: "flux-system",
+			History: helmv2.Snapshots{
+				{Name: "podinfo", Version: 3, Namespace: "apps", ChartVersion: "6.0.0"},
+			},
+		},
+	}
+
+	storageData, err := json.Marshal(helmstorage.Release{Name: "podinfo", Manifest: manifest})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	storage := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "sh.helm.release.v1.podinfo.v3", Namespace: "flux-system"},
+		Data: map[string][]byte{
+			"release": []byte(base64.StdEncoding.EncodeToString(storageData)),
+		},
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "podinfo",
+			Namespace:   "apps",
+			Labels:      map[string]string{"app.kubernetes.io/name": "podinfo"},
+			Annotations: map[string]string{"deployment.kubernetes.io/revision": "1"},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](5),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "podinfo"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "podinfo"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "podinfo", Image: "ghcr.io/stefanprodan/podinfo:6.1.0"}},
+				},
+			},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo-token", Namespace: "apps"},
+		Data:       map[string][]byte{"token": []byte("edited")},
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "apps"},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: "10.0.0.1",
+			Ports:     []corev1.ServicePort{{Port: 9898}},
+		},
+	}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme)).
+		WithObjects(storage, deployment, secret, service).
+		Build()
+
+	report, err := drift.HelmRelease(context.Background(), c, hr)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(report.Revision).To(Equal("6.0.0"))
+	g.Expect(report.Checked).To(Equal(4))
+	g.Expect(report.Warnings).To(BeEmpty())
+
+	// Fields set by the API server, like the Service's clusterIP, aren't
+	// drift.
+	g.Expect(report.Objects).To(HaveLen(3))
+
+	g.Expect(report.Objects[0].Kind).To(Equal("Deployment"))
+	g.Expect(report.Objects[0].Namespace).To(Equal("apps"))
+	g.Expect(report.Objects[0].Fields).To(Equal([]drift.FieldDrift{
+		{Path: "spec.replicas", Applied: "2", Live: "5"},
+		{Path: "spec.template.spec.containers[0].image", Applied: `"ghcr.io/stefanprodan/podinfo:6.0.0"`, Live: `"ghcr.io/stefanprodan/podinfo:6.1.0"`},
+	}))
+
+	g.Expect(report.Objects[1].Kind).To(Equal("ConfigMap"))
+	g.Expect(report.Objects[1].Deleted).To(BeTrue())
+
+	g.Expect(report.Objects[2].Kind).To(Equal("Secret"))
+	g.Expect(report.Objects[2].Fields).To(Equal([]drift.FieldDrift{
+		{Path: "data.token", Applied: "***", Live: "***"},
+	}))
+}
+
+func TestHelmReleaseNeverReleased(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "flux-system"},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	report, err := drift.HelmRelease(context.Background(), c, hr)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(report.Checked).To(BeZero())
+	g.Expect(report.Objects).To(BeEmpty())
+	g.Expect(report.Warnings).To(ConsistOf("The HelmRelease was never released"))
+}
+
+func TestKustomizationOutsideArtifact(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: "flux-system"},
+		Status: sourcev1.GitRepositoryStatus{
+			Artifact: &sourcev1.Artifact{URL: "http://source-controller/apps.tar.gz", Revision: "main@sha1:abc"},
+		},
+	}
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: "flux-system"},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: "apps"},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(repo, ks).Build()
+
+	// A file the artifact must not be able to read
+	secret := filepath.Join(t.TempDir(), "token")
+	g.Expect(os.WriteFile(secret, []byte("secret"), 0o600)).To(Succeed())
+
+	for _, kustomization := range []string{
+		"configMapGenerator:\n- name: token\n  files:\n  - " + secret + "\n",
+		"resources:\n- ../../../../../../../../.." + secret + "\n",
+	} {
+		_, err := drift.Kustomization(context.Background(), c, ks, func(ctx context.Context, artifactURL, dir string) error {
+			return os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte(kustomization), 0o600)
+		})
+		g.Expect(err).To(MatchError(ContainSubstring("kustomize build failed")), kustomization)
+	}
+}
@@ -0,0 +1,68 @@
+package drift
+
+import (
+	"context"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/helmstorage"
+)
+
+// HelmRelease compares the objects of the latest release of the HelmRelease,
+// as kept in the Helm storage, with the live ones. Only the fields in the
+// release's manifests are compared.
+func HelmRelease(ctx context.Context, c client.Client, hr *helmv2.HelmRelease) (*Report, error) {
+	objects, err := helmstorage.LatestObjects(ctx, c, hr)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Objects: []ObjectDrift{}}
+
+	if latest := hr.Status.History.Latest(); latest != nil {
+		report.Revision = latest.ChartVersion
+	} else {
+		report.Warnings = append(report.Warnings, "The HelmRelease was never released")
+	}
+
+	if hr.Spec.KubeConfig != nil {
+		report.Warnings = append(report.Warnings, "The HelmRelease is released on another cluster, its objects can't be compared")
+	}
+
+	for _, obj := range objects {
+		if obj.GetNamespace() == "" {
+			if namespaced, err := c.IsObjectNamespaced(obj); err == nil && namespaced {
+				obj.SetNamespace(helmstorage.ReleaseNamespace(hr))
+			}
+		}
+
+		report.Checked++
+
+		d := newObjectDrift(obj)
+
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GroupVersionKind())
+
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+			if apierrors.IsNotFound(err) {
+				d.Deleted = true
+			} else {
+				d.Error = err.Error()
+			}
+
+			report.Objects = append(report.Objects, d)
+
+			continue
+		}
+
+		d.Fields = compareFields(obj, live, true)
+		if len(d.Fields) > 0 {
+			report.Objects = append(report.Objects, d)
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,138 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/fluxcd/cli-utils/pkg/object"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/ssa"
+	"github.com/fluxcd/pkg/ssa/normalize"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/pkg/kustomizediff"
+)
+
+// ArtifactFetcher downloads the artifact at the URL and extracts it into
+// dir.
+type ArtifactFetcher func(ctx context.Context, artifactURL, dir string) error
+
+// Kustomization builds the manifests of the Kustomization from its source's
+// artifact, and compares the objects in its inventory with what a
+// server-side dry-run apply of the manifests would make them. Fields
+// kustomize-controller doesn't manage are left out.
+func Kustomization(ctx context.Context, c client.Client, ks *kustomizev1.Kustomization, fetch ArtifactFetcher) (*Report, error) {
+	artifact, err := kustomizediff.GetArtifact(ctx, c, ks)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "kustomization-drift-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := fetch(ctx, artifact.URL, dir); err != nil {
+		return nil, err
+	}
+
+	objects, err := kustomizediff.Build(ks, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := compareKustomization(ctx, c, ks, objects)
+	if err != nil {
+		return nil, err
+	}
+
+	report.Revision = ks.Status.LastAppliedRevision
+
+	if artifact.Revision != ks.Status.LastAppliedRevision {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("The source is at revision %s but %s was last applied, changes that weren't applied yet show as drift", artifact.Revision, ks.Status.LastAppliedRevision))
+	}
+
+	if ks.Spec.PostBuild != nil {
+		report.Warnings = append(report.Warnings, "Post build variable substitution isn't supported, fields using variables show as drift")
+	}
+
+	if ks.Spec.Decryption != nil {
+		report.Warnings = append(report.Warnings, "Decryption isn't supported, encrypted objects can't be compared")
+	}
+
+	return report, nil
+}
+
+func compareKustomization(ctx context.Context, c client.Client, ks *kustomizev1.Kustomization, objects []*unstructured.Unstructured) (*Report, error) {
+	if err := normalize.UnstructuredList(objects); err != nil {
+		return nil, fmt.Errorf("failed to normalize objects: %w", err)
+	}
+
+	sort.Sort(ssa.SortableUnstructureds(objects))
+
+	inventory := map[string]bool{}
+	if ks.Status.Inventory != nil {
+		for _, entry := range ks.Status.Inventory.Entries {
+			inventory[entry.ID] = true
+		}
+	}
+
+	manager := ssa.NewResourceManager(c, nil, ssa.Owner{
+		Field: "kustomize-controller",
+		Group: kustomizev1.GroupVersion.Group,
+	})
+
+	report := &Report{Objects: []ObjectDrift{}}
+
+	for _, obj := range objects {
+		// Objects that weren't applied yet can't have drifted
+		if !inventory[object.UnstructuredToObjMetadata(obj).String()] {
+			continue
+		}
+
+		report.Checked++
+
+		d := newObjectDrift(obj)
+
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GroupVersionKind())
+
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+			if apierrors.IsNotFound(err) {
+				d.Deleted = true
+			} else {
+				d.Error = err.Error()
+			}
+
+			report.Objects = append(report.Objects, d)
+
+			continue
+		}
+
+		entry, live, merged, err := manager.Diff(ctx, obj, ssa.DefaultDiffOptions())
+		if err != nil {
+			d.Error = err.Error()
+			report.Objects = append(report.Objects, d)
+
+			continue
+		}
+
+		if entry.Action != ssa.ConfiguredAction {
+			continue
+		}
+
+		// The merged object is what applying would make the live one, so
+		// fields only in either of them drifted too.
+		d.Fields = compareFields(merged, live, false)
+		if len(d.Fields) > 0 {
+			report.Objects = append(report.Objects, d)
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,113 @@
+// Package fluxmetrics exports the readiness of the Flux objects on all the
+// clusters as Prometheus metrics, so that one scrape of the server covers
+// every cluster the dashboard sees.
+package fluxmetrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/weave-gitops/core/search"
+)
+
+var objectLabels = []string{"cluster", "kind", "namespace", "name"}
+
+var (
+	readyDesc = prometheus.NewDesc(
+		"gitops_flux_object_ready",
+		"The status of the Ready condition of the Flux object, True, False or Unknown",
+		append(objectLabels, "status"), nil,
+	)
+	suspendedDesc = prometheus.NewDesc(
+		"gitops_flux_object_suspended",
+		"Whether the Flux object is suspended",
+		objectLabels, nil,
+	)
+	sinceSuccessDesc = prometheus.NewDesc(
+		"gitops_flux_object_seconds_since_last_success",
+		"The number of seconds since the Flux object last reconciled successfully, 0 while it's ready",
+		objectLabels, nil,
+	)
+	revisionDesc = prometheus.NewDesc(
+		"gitops_flux_object_revision_info",
+		"The revision the Flux object last applied or fetched",
+		append(objectLabels, "revision"), nil,
+	)
+)
+
+// Collector collects the metrics of the Kustomizations, HelmReleases and
+// sources on all the clusters when it's scraped. They're read from the
+// search index, which is refreshed periodically, so scrapes don't query the
+// clusters.
+type Collector struct {
+	index *search.Index
+	now   func() time.Time
+}
+
+// NewCollector returns a Collector of the objects in the index. Nothing is
+// collected until the index is first refreshed.
+func NewCollector(index *search.Index) *Collector {
+	return &Collector{
+		index: index,
+		now:   time.Now,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- readyDesc
+	ch <- suspendedDesc
+	ch <- sinceSuccessDesc
+	ch <- revisionDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	now := c.now()
+
+	for _, doc := range c.index.Documents() {
+		collectDocument(ch, now, doc)
+	}
+}
+
+func collectDocument(ch chan<- prometheus.Metric, now time.Time, doc search.Document) {
+	labels := []string{doc.Cluster, doc.Kind, doc.Namespace, doc.Name}
+
+	ch <- prometheus.MustNewConstMetric(readyDesc, prometheus.GaugeValue, 1, append(labels, doc.Ready)...)
+	ch <- prometheus.MustNewConstMetric(suspendedDesc, prometheus.GaugeValue, boolValue(doc.Suspended), labels...)
+
+	if since, ok := sinceLastSuccess(doc, now); ok {
+		ch <- prometheus.MustNewConstMetric(sinceSuccessDesc, prometheus.GaugeValue, since.Seconds(), labels...)
+	}
+
+	if doc.Revision != "" {
+		ch <- prometheus.MustNewConstMetric(revisionDesc, prometheus.GaugeValue, 1, append(labels, doc.Revision)...)
+	}
+}
+
+// sinceLastSuccess returns how long ago the object last reconciled
+// successfully. While it's ready, that's now. Otherwise the Ready condition
+// stopped being True when it last transitioned, as its transition time only
+// changes with its status. Objects that never got a Ready condition have no
+// value.
+func sinceLastSuccess(doc search.Document, now time.Time) (time.Duration, bool) {
+	if doc.ReadyTransitionTime.IsZero() {
+		return 0, false
+	}
+
+	if doc.Ready == string(metav1.ConditionTrue) {
+		return 0, true
+	}
+
+	return now.Sub(doc.ReadyTransitionTime), true
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
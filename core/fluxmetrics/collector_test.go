@@ -0,0 +1,99 @@
+package fluxmetrics_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/prometheus/common/expfmt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/fluxmetrics"
+	"github.com/weaveworks/weave-gitops/core/search"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestCollector(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: "flux-system"},
+		Spec:       kustomizev1.KustomizationSpec{Suspend: true},
+		Status: kustomizev1.KustomizationStatus{
+			LastAppliedRevision: "main@sha1:b8e362c2",
+			Conditions: []metav1.Condition{
+				{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, LastTransitionTime: metav1.Now()},
+			},
+		},
+	}
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "apps"},
+		Status: helmv2.HelmReleaseStatus{
+			History: helmv2.Snapshots{{Name: "podinfo", Version: 2, ChartVersion: "6.1.0"}},
+			Conditions: []metav1.Condition{
+				{Type: meta.ReadyCondition, Status: metav1.ConditionFalse, LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour))},
+			},
+		},
+	}
+
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "flux-system", Namespace: "flux-system"},
+		Status: sourcev1.GitRepositoryStatus{
+			Artifact: &sourcev1.Artifact{Revision: "main@sha1:b8e362c2"},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ks, hr, repo).Build()
+
+	index := search.NewClientIndex(logr.Discard(), "Default", c)
+	collector := fluxmetrics.NewCollector(index)
+
+	// Nothing is collected before the index is refreshed
+	g.Expect(testutil.CollectAndCount(collector)).To(BeZero())
+
+	index.Refresh(context.Background())
+
+	expected := `
+# HELP gitops_flux_object_ready The status of the Ready condition of the Flux object, True, False or Unknown
+# TYPE gitops_flux_object_ready gauge
+gitops_flux_object_ready{cluster="Default",kind="GitRepository",name="flux-system",namespace="flux-system",status="Unknown"} 1
+gitops_flux_object_ready{cluster="Default",kind="HelmRelease",name="podinfo",namespace="apps",status="False"} 1
+gitops_flux_object_ready{cluster="Default",kind="Kustomization",name="apps",namespace="flux-system",status="True"} 1
+# HELP gitops_flux_object_suspended Whether the Flux object is suspended
+# TYPE gitops_flux_object_suspended gauge
+gitops_flux_object_suspended{cluster="Default",kind="GitRepository",name="flux-system",namespace="flux-system"} 0
+gitops_flux_object_suspended{cluster="Default",kind="HelmRelease",name="podinfo",namespace="apps"} 0
+gitops_flux_object_suspended{cluster="Default",kind="Kustomization",name="apps",namespace="flux-system"} 1
+# HELP gitops_flux_object_revision_info The revision the Flux object last applied or fetched
+# TYPE gitops_flux_object_revision_info gauge
+gitops_flux_object_revision_info{cluster="Default",kind="GitRepository",name="flux-system",namespace="flux-system",revision="main@sha1:b8e362c2"} 1
+gitops_flux_object_revision_info{cluster="Default",kind="HelmRelease",name="podinfo",namespace="apps",revision="6.1.0"} 1
+gitops_flux_object_revision_info{cluster="Default",kind="Kustomization",name="apps",namespace="flux-system",revision="main@sha1:b8e362c2"} 1
+`
+
+	g.Expect(testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"gitops_flux_object_ready", "gitops_flux_object_suspended", "gitops_flux_object_revision_info",
+	)).To(Succeed())
+
+	// The source has no Ready condition, so it has no time since its last
+	// success
+	g.Expect(testutil.CollectAndCount(collector, "gitops_flux_object_seconds_since_last_success")).To(Equal(2))
+
+	since, err := testutil.CollectAndFormat(collector, expfmt.TypeTextPlain, "gitops_flux_object_seconds_since_last_success")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(since)).To(ContainSubstring(`gitops_flux_object_seconds_since_last_success{cluster="Default",kind="Kustomization",name="apps",namespace="flux-system"} 0`))
+	g.Expect(string(since)).To(MatchRegexp(`gitops_flux_object_seconds_since_last_success\{cluster="Default",kind="HelmRelease",name="podinfo",namespace="apps"\} 3600`))
+}
@@ -1,6 +1,7 @@
 package fluxsync
 
 import (
+	tfv1alpha2 "github.com/flux-iac/tofu-controller/api/v1alpha2"
 	helmv2 "github.com/fluxcd/helm-controller/api/v2"
 	imgautomationv1 "github.com/fluxcd/image-automation-controller/api/v1beta2"
 	reflectorv1 "github.com/fluxcd/image-reflector-controller/api/v1beta2"
@@ -35,7 +36,7 @@ type SourceRef interface {
 	Namespace() string
 }
 
-// Automation objects are Kustomizations and HelmReleases.
+// Automation objects are Kustomizations, HelmReleases and Terraforms.
 // These are the only object types that can be triggered
 // to be reconciled with their source.
 type Automation interface {
@@ -288,6 +289,40 @@ func (obj ImageUpdateAutomationAdapter) DeepCopyClientObject() client.Object {
 	return obj.DeepCopy()
 }
 
+type TerraformAdapter struct {
+	*tfv1alpha2.Terraform
+}
+
+func (obj TerraformAdapter) GetLastHandledReconcileRequest() string {
+	return obj.Status.GetLastHandledReconcileRequest()
+}
+
+func (obj TerraformAdapter) AsClientObject() client.Object {
+	return obj.Terraform
+}
+
+func (obj TerraformAdapter) SourceRef() SourceRef {
+	return sRef{
+		apiVersion: obj.Spec.SourceRef.APIVersion,
+		name:       obj.Spec.SourceRef.Name,
+		namespace:  obj.Spec.SourceRef.Namespace,
+		kind:       obj.Spec.SourceRef.Kind,
+	}
+}
+
+func (obj TerraformAdapter) GroupVersionKind() schema.GroupVersionKind {
+	return tfv1alpha2.GroupVersion.WithKind(tfv1alpha2.TerraformKind)
+}
+
+func (obj TerraformAdapter) SetSuspended(suspend bool) error {
+	obj.Spec.Suspend = suspend
+	return nil
+}
+
+func (obj TerraformAdapter) DeepCopyClientObject() client.Object {
+	return obj.DeepCopy()
+}
+
 // UnstructuredAdapter implements the Reconcilable interface for unstructured resources.
 // The underlying resource gvk should have the standard flux object sync/suspend fields
 type UnstructuredAdapter struct {
@@ -386,6 +421,8 @@ func ToReconcileable(gvk schema.GroupVersionKind) Reconcilable {
 		return ImageRepositoryAdapter{ImageRepository: &reflectorv1.ImageRepository{}}
 	case imgautomationv1.ImageUpdateAutomationKind:
 		return ImageUpdateAutomationAdapter{ImageUpdateAutomation: &imgautomationv1.ImageUpdateAutomation{}}
+	case tfv1alpha2.TerraformKind:
+		return TerraformAdapter{Terraform: &tfv1alpha2.Terraform{}}
 	}
 
 	// Return the UnstructuredAdapter for flux-like resources
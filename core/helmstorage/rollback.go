@@ -0,0 +1,135 @@
+package helmstorage
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	"github.com/pmezard/go-difflib/difflib"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	RolledBackByAnnotation = "metadata.weave.works/rolled-back-by"
+	RolledBackAtAnnotation = "metadata.weave.works/rolled-back-at"
+	// RolledBackToAnnotation is the version of the release the HelmRelease
+	// was rolled back to, and its chart version.
+	RolledBackToAnnotation    = "metadata.weave.works/rolled-back-to"
+	RollbackCommentAnnotation = "metadata.weave.works/rollback-comment"
+)
+
+// ValuesYAML returns the values of the release as YAML.
+func ValuesYAML(release *Release) (string, error) {
+	if len(release.Config) == 0 {
+		return "", nil
+	}
+
+	data, err := yaml.Marshal(release.Config)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the values of version %d: %w", release.Version, err)
+	}
+
+	return string(data), nil
+}
+
+// ValuesDiff returns a unified diff of the values of the release from those
+// of the previous one, which may be nil.
+func ValuesDiff(previous, release *Release) (string, error) {
+	from, fromFile := "", "none"
+
+	if previous != nil {
+		values, err := ValuesYAML(previous)
+		if err != nil {
+			return "", err
+		}
+
+		from, fromFile = values, "v"+strconv.Itoa(previous.Version)
+	}
+
+	to, err := ValuesYAML(release)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: fromFile,
+		ToFile:   "v" + strconv.Itoa(release.Version),
+		Context:  3,
+	})
+}
+
+// Rollback pins the chart version and values of the HelmRelease to those of
+// the release, so that helm-controller upgrades back to it, and records who
+// rolled it back in its annotations. It returns warnings about what couldn't
+// be rolled back.
+//
+// The values of the release are those of spec.values merged with those from
+// valuesFrom, which can come from Secrets. They'd end up in plain text in the
+// HelmRelease, so when it has valuesFrom only the chart version is pinned.
+func Rollback(helmRelease *helmv2.HelmRelease, release *Release, principal, comment string, now time.Time) ([]string, error) {
+	warnings := []string{}
+	rollsBackValues := len(helmRelease.Spec.ValuesFrom) == 0
+
+	switch {
+	case helmRelease.Spec.Chart == nil && !helmRelease.HasChartRef():
+		return nil, fmt.Errorf("HelmRelease '%s' has no chart", helmRelease.Name)
+	case helmRelease.HasChartRef() || helmRelease.Spec.Chart.Spec.SourceRef.Kind != sourcev1.HelmRepositoryKind:
+		kind, name := chartSource(helmRelease)
+		if !rollsBackValues {
+			return nil, fmt.Errorf("the chart comes from the %s %s, which has no versions, and the values come from valuesFrom, so nothing can be rolled back", kind, name)
+		}
+
+		warnings = append(warnings, fmt.Sprintf("The chart comes from the %s %s, which has no versions, only the values were rolled back", kind, name))
+	default:
+		helmRelease.Spec.Chart.Spec.Version = release.Chart.Metadata.Version
+	}
+
+	if rollsBackValues {
+		helmRelease.Spec.Values = nil
+
+		if len(release.Config) > 0 {
+			values, err := json.Marshal(release.Config)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal the values of version %d: %w", release.Version, err)
+			}
+
+			helmRelease.Spec.Values = &apiextensionsv1.JSON{Raw: values}
+		}
+	} else {
+		warnings = append(warnings, "The values come from valuesFrom, which may hold Secrets, so only the chart version was rolled back")
+	}
+
+	annotations := helmRelease.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[RolledBackByAnnotation] = principal
+	annotations[RolledBackAtAnnotation] = now.UTC().Format(time.RFC3339)
+	annotations[RolledBackToAnnotation] = fmt.Sprintf("%d (chart %s)", release.Version, release.Chart.Metadata.Version)
+
+	delete(annotations, RollbackCommentAnnotation)
+
+	if comment != "" {
+		annotations[RollbackCommentAnnotation] = comment
+	}
+
+	helmRelease.SetAnnotations(annotations)
+
+	return warnings, nil
+}
+
+// chartSource returns the kind and name of the source of the chart.
+func chartSource(helmRelease *helmv2.HelmRelease) (string, string) {
+	if helmRelease.HasChartRef() {
+		return helmRelease.Spec.ChartRef.Kind, helmRelease.Spec.ChartRef.Name
+	}
+
+	return helmRelease.Spec.Chart.Spec.SourceRef.Kind, helmRelease.Spec.Chart.Spec.SourceRef.Name
+}
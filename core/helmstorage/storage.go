@@ -0,0 +1,199 @@
+// Package helmstorage reads the Helm releases helm-controller keeps in
+// storage Secrets, and rolls HelmReleases back to them.
+package helmstorage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	"github.com/fluxcd/pkg/ssa/utils"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const secretNameFmt = "sh.helm.release.v1.%s.v%v" // #nosec G101
+
+// Release is a version of a Helm release, as stored by Helm. Only the
+// fields Weave GitOps uses are decoded.
+type Release struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Version   int    `json:"version,omitempty"`
+	Info      struct {
+		FirstDeployed time.Time `json:"first_deployed,omitempty"`
+		LastDeployed  time.Time `json:"last_deployed,omitempty"`
+		Description   string    `json:"description,omitempty"`
+		Status        string    `json:"status,omitempty"`
+	} `json:"info,omitempty"`
+	Chart struct {
+		Metadata struct {
+			Name       string `json:"name,omitempty"`
+			Version    string `json:"version,omitempty"`
+			AppVersion string `json:"appVersion,omitempty"`
+		} `json:"metadata,omitempty"`
+	} `json:"chart,omitempty"`
+	// Config is the values the release was installed or upgraded with,
+	// on top of the chart's defaults.
+	Config   map[string]any `json:"config,omitempty"`
+	Manifest string         `json:"manifest,omitempty"`
+}
+
+// SecretName is the name of the Secret storing a version of a release.
+func SecretName(release string, version int) string {
+	return fmt.Sprintf(secretNameFmt, release, version)
+}
+
+// StorageNamespace is the namespace the releases of the HelmRelease were
+// stored in, which is the configured one if it was never released.
+func StorageNamespace(helmRelease *helmv2.HelmRelease) string {
+	if helmRelease.Status.StorageNamespace != "" {
+		return helmRelease.Status.StorageNamespace
+	}
+
+	return helmRelease.GetStorageNamespace()
+}
+
+// LatestSecretKey returns the Secret storing the latest release of the
+// HelmRelease, nil if it was never released.
+func LatestSecretKey(helmRelease *helmv2.HelmRelease) *client.ObjectKey {
+	if latest := helmRelease.Status.History.Latest(); latest != nil {
+		return &client.ObjectKey{
+			Name:      SecretName(latest.Name, latest.Version),
+			Namespace: StorageNamespace(helmRelease),
+		}
+	}
+
+	return nil
+}
+
+// ReleaseNamespace is the namespace the objects of the latest release are
+// in by default.
+func ReleaseNamespace(helmRelease *helmv2.HelmRelease) string {
+	if latest := helmRelease.Status.History.Latest(); latest != nil {
+		return latest.Namespace
+	}
+
+	return ""
+}
+
+// Decode returns the release stored in the Secret.
+func Decode(storageSecret *v1.Secret) (*Release, error) {
+	releaseData, releaseFound := storageSecret.Data["release"]
+	if !releaseFound {
+		return nil, fmt.Errorf("failed to decode the Helm storage object %q", storageSecret.Name)
+	}
+
+	byteData, err := base64.StdEncoding.DecodeString(string(releaseData))
+	if err != nil {
+		return nil, err
+	}
+
+	magicGzip := []byte{0x1f, 0x8b, 0x08}
+	if bytes.HasPrefix(byteData, magicGzip) {
+		r, err := gzip.NewReader(bytes.NewReader(byteData))
+		if err != nil {
+			return nil, err
+		}
+
+		defer r.Close()
+
+		uncompressedByteData, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+
+		byteData = uncompressedByteData
+	}
+
+	storage := &Release{}
+	if err := json.Unmarshal(byteData, storage); err != nil {
+		return nil, fmt.Errorf("failed to decode the Helm storage object %q: %w", storageSecret.Name, err)
+	}
+
+	return storage, nil
+}
+
+// LatestObjects returns the objects applied by the latest release of the
+// HelmRelease. It returns nil if it was never released, or if it's released
+// on another cluster, as the storage can't be read then.
+func LatestObjects(ctx context.Context, k8sClient client.Client, helmRelease *helmv2.HelmRelease) ([]*unstructured.Unstructured, error) {
+	secretName := LatestSecretKey(helmRelease)
+	if secretName == nil {
+		// skip release if it failed to install
+		return nil, nil
+	}
+
+	if helmRelease.Spec.KubeConfig != nil {
+		// helmrelease secret is on another cluster so we cannot inspect it to figure out the inventory and version and other things
+		return nil, nil
+	}
+
+	storageSecret := &v1.Secret{}
+	if err := k8sClient.Get(ctx, *secretName, storageSecret); err != nil {
+		return nil, err
+	}
+
+	storage, err := Decode(storageSecret)
+	if err != nil {
+		return nil, fmt.Errorf("HelmRelease '%s': %w", helmRelease.Name, err)
+	}
+
+	objects, err := utils.ReadObjects(strings.NewReader(storage.Manifest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the Helm storage object for HelmRelease '%s': %w", helmRelease.Name, err)
+	}
+
+	return objects, nil
+}
+
+// ReleaseName is the name of the Helm release of the HelmRelease.
+func ReleaseName(helmRelease *helmv2.HelmRelease) string {
+	if latest := helmRelease.Status.History.Latest(); latest != nil {
+		return latest.Name
+	}
+
+	return helmRelease.GetReleaseName()
+}
+
+// Revisions returns all the versions of the release of the HelmRelease that
+// are kept in storage, latest first.
+func Revisions(ctx context.Context, k8sClient client.Client, helmRelease *helmv2.HelmRelease) ([]*Release, error) {
+	if helmRelease.Spec.KubeConfig != nil {
+		return nil, fmt.Errorf("HelmRelease '%s' is released on another cluster, its storage can't be read", helmRelease.Name)
+	}
+
+	secrets := &v1.SecretList{}
+	if err := k8sClient.List(ctx, secrets,
+		client.InNamespace(StorageNamespace(helmRelease)),
+		client.MatchingLabels{"owner": "helm", "name": ReleaseName(helmRelease)},
+	); err != nil {
+		return nil, err
+	}
+
+	releases := []*Release{}
+
+	for i := range secrets.Items {
+		release, err := Decode(&secrets.Items[i])
+		if err != nil {
+			return nil, fmt.Errorf("HelmRelease '%s': %w", helmRelease.Name, err)
+		}
+
+		releases = append(releases, release)
+	}
+
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].Version > releases[j].Version
+	})
+
+	return releases, nil
+}
@@ -0,0 +1,169 @@
+package helmstorage_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/helmstorage"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestRevisions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "flux-system"},
+		Status: helmv2.HelmReleaseStatus{
+			StorageNamespace: "apps",
+			History: helmv2.Snapshots{
+				{Name: "apps-podinfo", Version: 2, Namespace: "apps"},
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		storageSecret(g, "apps-podinfo", 1, "6.0.0", map[string]any{"replicas": 1}, false),
+		storageSecret(g, "apps-podinfo", 2, "6.1.0", map[string]any{"replicas": 2}, true),
+		// Another release in the same namespace
+		storageSecret(g, "other", 1, "1.0.0", nil, false),
+	).Build()
+
+	releases, err := helmstorage.Revisions(context.Background(), c, hr)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(releases).To(HaveLen(2))
+	g.Expect(releases[0].Version).To(Equal(2))
+	g.Expect(releases[0].Chart.Metadata.Version).To(Equal("6.1.0"))
+	g.Expect(releases[0].Info.Status).To(Equal("deployed"))
+	g.Expect(releases[0].Info.LastDeployed.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))).To(BeTrue())
+	g.Expect(releases[1].Version).To(Equal(1))
+
+	diff, err := helmstorage.ValuesDiff(releases[1], releases[0])
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(diff).To(ContainSubstring("-replicas: 1\n+replicas: 2\n"))
+
+	diff, err = helmstorage.ValuesDiff(nil, releases[1])
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(diff).To(ContainSubstring("+replicas: 1\n"))
+}
+
+func TestRollback(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	release := &helmstorage.Release{Version: 1, Config: map[string]any{"replicas": 1}}
+	release.Chart.Metadata.Version = "6.0.0"
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "flux-system"},
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart:     "podinfo",
+					Version:   "6.x",
+					SourceRef: helmv2.CrossNamespaceObjectReference{Kind: sourcev1.HelmRepositoryKind, Name: "podinfo"},
+				},
+			},
+			Values: &apiextensionsv1.JSON{Raw: []byte(`{"replicas":2}`)},
+		},
+	}
+
+	now := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	warnings, err := helmstorage.Rollback(hr, release, "bob@example.com", "broke the ingress", now)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(warnings).To(BeEmpty())
+	g.Expect(hr.Spec.Chart.Spec.Version).To(Equal("6.0.0"))
+	g.Expect(hr.Spec.Values.Raw).To(MatchJSON(`{"replicas":1}`))
+	g.Expect(hr.Annotations).To(Equal(map[string]string{
+		helmstorage.RolledBackByAnnotation:    "bob@example.com",
+		helmstorage.RolledBackAtAnnotation:    "2024-01-03T00:00:00Z",
+		helmstorage.RolledBackToAnnotation:    "1 (chart 6.0.0)",
+		helmstorage.RollbackCommentAnnotation: "broke the ingress",
+	}))
+
+	// The values from valuesFrom may hold Secrets, so only the chart
+	// version is pinned
+	hr.Spec.Values = &apiextensionsv1.JSON{Raw: []byte(`{"replicas":2}`)}
+	hr.Spec.ValuesFrom = []helmv2.ValuesReference{{Kind: "Secret", Name: "values"}}
+	release.Config["password"] = "hunter2"
+
+	warnings, err = helmstorage.Rollback(hr, release, "bob@example.com", "", now)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(warnings).To(HaveLen(1))
+	g.Expect(hr.Spec.Chart.Spec.Version).To(Equal("6.0.0"))
+	g.Expect(hr.Spec.Values.Raw).To(MatchJSON(`{"replicas":2}`))
+	g.Expect(hr.Annotations).NotTo(HaveKey(helmstorage.RollbackCommentAnnotation))
+
+	// Charts from Git have no versions to pin, so there's nothing to roll
+	// back
+	hr.Spec.Chart.Spec.SourceRef.Kind = sourcev1.GitRepositoryKind
+	hr.Spec.Chart.Spec.Version = ""
+
+	_, err = helmstorage.Rollback(hr, release, "bob@example.com", "", now)
+	g.Expect(err).To(MatchError(ContainSubstring("nothing can be rolled back")))
+
+	// but without valuesFrom, the values are
+	hr.Spec.ValuesFrom = nil
+
+	warnings, err = helmstorage.Rollback(hr, release, "bob@example.com", "", now)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(warnings).To(HaveLen(1))
+	g.Expect(hr.Spec.Chart.Spec.Version).To(BeEmpty())
+	g.Expect(hr.Spec.Values.Raw).To(MatchJSON(`{"replicas":1,"password":"hunter2"}`))
+}
+
+// storageSecret stores a release as Helm does.
+func storageSecret(g *WithT, name string, version int, chartVersion string, values map[string]any, deployed bool) *corev1.Secret {
+	status := "superseded"
+	if deployed {
+		status = "deployed"
+	}
+
+	data, err := json.Marshal(map[string]any{
+		"name":      name,
+		"namespace": "apps",
+		"version":   version,
+		"info": map[string]any{
+			"last_deployed": time.Date(2024, 1, version, 0, 0, 0, 0, time.UTC),
+			"status":        status,
+		},
+		"chart": map[string]any{
+			"metadata": map[string]any{"name": "podinfo", "version": chartVersion},
+		},
+		"config": values,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var compressed bytes.Buffer
+
+	w := gzip.NewWriter(&compressed)
+	_, err = w.Write(data)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(w.Close()).To(Succeed())
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      helmstorage.SecretName(name, version),
+			Namespace: "apps",
+			Labels:    map[string]string{"owner": "helm", "name": name, "status": status},
+		},
+		Data: map[string][]byte{
+			"release": []byte(base64.StdEncoding.EncodeToString(compressed.Bytes())),
+		},
+	}
+}
@@ -0,0 +1,200 @@
+package history
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultConfigMapName is the prefix of the names of the ConfigMaps used to
+// persist the history.
+const DefaultConfigMapName = "weave-gitops-reconciliation-history"
+
+// ConfigMapLabel labels the ConfigMaps of the history with their prefix.
+const ConfigMapLabel = "weave.works/reconciliation-history"
+
+// configMapKey is the key of the history in its ConfigMap.
+const configMapKey = "history"
+
+// maxConfigMapBytes caps the size of the history of an object, well under
+// the 1MiB limit of ConfigMaps. The oldest records are dropped to fit.
+const maxConfigMapBytes = 256 * 1024
+
+type configMapEntry struct {
+	Object  ObjectRef `json:"object"`
+	Records []Record  `json:"records"`
+}
+
+type configMapStore struct {
+	mu        sync.Mutex
+	log       logr.Logger
+	client    client.Client
+	namespace string
+	prefix    string
+	memory    *memoryStore
+	loaded    bool
+}
+
+// NewConfigMapStore returns a Store that keeps up to maxRecords records per
+// object, persisted to a ConfigMap per object so they survive restarts of
+// the server. The ConfigMaps are named after prefix, and deleted with the
+// history of their object when it's pruned.
+func NewConfigMapStore(log logr.Logger, c client.Client, namespace, prefix string, maxRecords int) Store {
+	return &configMapStore{
+		log:       log.WithName("history"),
+		client:    c,
+		namespace: namespace,
+		prefix:    prefix,
+		memory:    newMemoryStore(maxRecords),
+	}
+}
+
+func (s *configMapStore) Add(ctx context.Context, ref ObjectRef, record Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(ctx); err != nil {
+		return err
+	}
+
+	records := s.memory.add(ref, record)
+
+	var (
+		value []byte
+		err   error
+	)
+
+	for {
+		value, err = json.Marshal(configMapEntry{Object: ref, Records: records})
+		if err != nil {
+			return fmt.Errorf("failed to marshal history: %w", err)
+		}
+
+		if len(value) <= maxConfigMapBytes || len(records) == 1 {
+			break
+		}
+
+		records = records[1:]
+	}
+
+	if len(value) > maxConfigMapBytes {
+		return fmt.Errorf("history record of %s/%s is larger than %d bytes", ref.Namespace, ref.Name, maxConfigMapBytes)
+	}
+
+	s.memory.set(ref, records)
+
+	cm := &corev1.ConfigMap{}
+	key := client.ObjectKey{Namespace: s.namespace, Name: s.configMapName(ref)}
+
+	err = s.client.Get(ctx, key, cm)
+	if apierrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    map[string]string{ConfigMapLabel: s.prefix},
+			},
+			Data: map[string]string{configMapKey: string(value)},
+		}
+
+		if err := s.client.Create(ctx, cm); err != nil {
+			return fmt.Errorf("failed to create history configmap: %w", err)
+		}
+
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get history configmap: %w", err)
+	}
+
+	cm.Data = map[string]string{configMapKey: string(value)}
+
+	if err := s.client.Update(ctx, cm); err != nil {
+		return fmt.Errorf("failed to update history configmap: %w", err)
+	}
+
+	return nil
+}
+
+func (s *configMapStore) List(ctx context.Context, ref ObjectRef) ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.memory.List(ctx, ref)
+}
+
+func (s *configMapStore) Prune(ctx context.Context, keep func(ObjectRef) bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.load(ctx); err != nil {
+		return err
+	}
+
+	for _, ref := range s.memory.prune(keep) {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.configMapName(ref),
+				Namespace: s.namespace,
+			},
+		}
+
+		if err := s.client.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete history configmap: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// load reads the ConfigMaps into memory the first time the store is used.
+// ConfigMaps that can't be read are skipped, their object's history starts
+// over and replaces them with its next record.
+func (s *configMapStore) load(ctx context.Context) error {
+	if s.loaded {
+		return nil
+	}
+
+	list := &corev1.ConfigMapList{}
+
+	if err := s.client.List(ctx, list, client.InNamespace(s.namespace), client.MatchingLabels{ConfigMapLabel: s.prefix}); err != nil {
+		return fmt.Errorf("failed to list history configmaps: %w", err)
+	}
+
+	for _, cm := range list.Items {
+		var entry configMapEntry
+		if err := json.Unmarshal([]byte(cm.Data[configMapKey]), &entry); err != nil {
+			s.log.Error(err, "skipping unreadable history configmap", "configmap", cm.Name)
+
+			continue
+		}
+
+		s.memory.set(entry.Object, entry.Records)
+	}
+
+	s.loaded = true
+
+	return nil
+}
+
+// configMapName returns the name of the ConfigMap of the object. The
+// original reference is stored in the ConfigMap itself, so the name only
+// needs to be unique.
+func (s *configMapStore) configMapName(ref ObjectRef) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{ref.Cluster, ref.Kind, ref.Namespace, ref.Name}, "/")))
+
+	return s.prefix + "-" + hex.EncodeToString(sum[:])[:16]
+}
@@ -0,0 +1,139 @@
+// Package history records the reconciliation attempts of Flux automations,
+// so that they can be inspected after the Kubernetes events have expired.
+package history
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultMaxRecords is the default number of records kept for each object.
+const DefaultMaxRecords = 20
+
+// ObjectRef identifies the object a record belongs to.
+type ObjectRef struct {
+	Cluster   string `json:"cluster"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// Record is the outcome of a single reconciliation attempt.
+type Record struct {
+	// Revision is the source revision that was attempted.
+	Revision string `json:"revision"`
+	// Status is the status of the Ready condition after the attempt.
+	Status metav1.ConditionStatus `json:"status"`
+	Reason string                 `json:"reason"`
+	// Message is the message of the Ready condition after the attempt.
+	Message string `json:"message"`
+	// Timestamp is when the Ready condition transitioned.
+	Timestamp time.Time `json:"timestamp"`
+	// Duration is how long the reconciliation took, zero if it's unknown.
+	Duration time.Duration `json:"duration,omitempty"`
+}
+
+// Store keeps a bounded list of records for each object.
+type Store interface {
+	// Add appends a record to the history of the object, dropping the oldest
+	// records if the history is full.
+	Add(ctx context.Context, ref ObjectRef, record Record) error
+	// List returns the history of the object, oldest first.
+	List(ctx context.Context, ref ObjectRef) ([]Record, error)
+	// Prune drops the history of the objects keep returns false for, e.g.
+	// because they were deleted.
+	Prune(ctx context.Context, keep func(ObjectRef) bool) error
+}
+
+type memoryStore struct {
+	mu         sync.RWMutex
+	maxRecords int
+	records    map[ObjectRef][]Record
+}
+
+// NewMemoryStore returns a Store that keeps up to maxRecords records per object in memory.
+func NewMemoryStore(maxRecords int) Store {
+	return newMemoryStore(maxRecords)
+}
+
+func newMemoryStore(maxRecords int) *memoryStore {
+	if maxRecords <= 0 {
+		maxRecords = DefaultMaxRecords
+	}
+
+	return &memoryStore{
+		maxRecords: maxRecords,
+		records:    map[ObjectRef][]Record{},
+	}
+}
+
+func (s *memoryStore) Add(ctx context.Context, ref ObjectRef, record Record) error {
+	s.add(ref, record)
+
+	return nil
+}
+
+// add appends the record and returns the resulting history of the object.
+func (s *memoryStore) add(ref ObjectRef, record Record) []Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records := append(s.records[ref], record)
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Timestamp.Before(records[j].Timestamp)
+	})
+
+	if len(records) > s.maxRecords {
+		records = records[len(records)-s.maxRecords:]
+	}
+
+	s.records[ref] = records
+
+	return append([]Record{}, records...)
+}
+
+func (s *memoryStore) set(ref ObjectRef, records []Record) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(records) > s.maxRecords {
+		records = records[len(records)-s.maxRecords:]
+	}
+
+	s.records[ref] = records
+}
+
+func (s *memoryStore) List(ctx context.Context, ref ObjectRef) ([]Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]Record{}, s.records[ref]...), nil
+}
+
+func (s *memoryStore) Prune(ctx context.Context, keep func(ObjectRef) bool) error {
+	s.prune(keep)
+
+	return nil
+}
+
+// prune drops the history of the objects keep returns false for, and
+// returns them.
+func (s *memoryStore) prune(keep func(ObjectRef) bool) []ObjectRef {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var pruned []ObjectRef
+
+	for ref := range s.records {
+		if !keep(ref) {
+			delete(s.records, ref)
+			pruned = append(pruned, ref)
+		}
+	}
+
+	return pruned
+}
@@ -0,0 +1,285 @@
+package history_test
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/clustersmngrfakes"
+	"github.com/weaveworks/weave-gitops/core/history"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+var testRef = history.ObjectRef{
+	Cluster:   "Default",
+	Kind:      kustomizev1.KustomizationKind,
+	Namespace: "flux-system",
+	Name:      "flux-system",
+}
+
+func TestMemoryStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	store := history.NewMemoryStore(2)
+	start := time.Now()
+
+	for i := 0; i < 3; i++ {
+		g.Expect(store.Add(ctx, testRef, history.Record{
+			Revision:  string(rune('a' + i)),
+			Timestamp: start.Add(time.Duration(i) * time.Minute),
+		})).To(Succeed())
+	}
+
+	records, err := store.List(ctx, testRef)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(records).To(HaveLen(2))
+	g.Expect(records[0].Revision).To(Equal("b"))
+	g.Expect(records[1].Revision).To(Equal("c"))
+
+	records, err = store.List(ctx, history.ObjectRef{Name: "other"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(records).To(BeEmpty())
+
+	g.Expect(store.Prune(ctx, func(ref history.ObjectRef) bool { return ref != testRef })).To(Succeed())
+
+	records, err = store.List(ctx, testRef)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(records).To(BeEmpty())
+}
+
+func TestConfigMapStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	store := history.NewConfigMapStore(logr.Discard(), fakeClient, "flux-system", history.DefaultConfigMapName, 5)
+
+	record := history.Record{
+		Revision:  "main@sha1:abc",
+		Status:    metav1.ConditionFalse,
+		Reason:    "BuildFailed",
+		Message:   "kustomize build failed",
+		Timestamp: time.Now().Truncate(time.Second).UTC(),
+		Duration:  3 * time.Second,
+	}
+
+	g.Expect(store.Add(ctx, testRef, record)).To(Succeed())
+
+	otherRef := testRef
+	otherRef.Name = "other"
+	g.Expect(store.Add(ctx, otherRef, record)).To(Succeed())
+
+	// Each object has its own ConfigMap
+	cms := &corev1.ConfigMapList{}
+	g.Expect(fakeClient.List(ctx, cms, client.MatchingLabels{history.ConfigMapLabel: history.DefaultConfigMapName})).To(Succeed())
+	g.Expect(cms.Items).To(HaveLen(2))
+
+	// A new store, e.g. after a restart, reads the history back.
+	reloaded := history.NewConfigMapStore(logr.Discard(), fakeClient, "flux-system", history.DefaultConfigMapName, 5)
+
+	records, err := reloaded.List(ctx, testRef)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(records).To(Equal([]history.Record{record}))
+
+	// Pruning an object deletes its ConfigMap
+	g.Expect(reloaded.Prune(ctx, func(ref history.ObjectRef) bool { return ref != otherRef })).To(Succeed())
+
+	g.Expect(fakeClient.List(ctx, cms, client.MatchingLabels{history.ConfigMapLabel: history.DefaultConfigMapName})).To(Succeed())
+	g.Expect(cms.Items).To(HaveLen(1))
+
+	records, err = reloaded.List(ctx, otherRef)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(records).To(BeEmpty())
+}
+
+func TestConfigMapStoreUnreadable(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	record := history.Record{Revision: "main@sha1:abc", Status: metav1.ConditionTrue, Timestamp: time.Now().Truncate(time.Second).UTC()}
+
+	store := history.NewConfigMapStore(logr.Discard(), fakeClient, "flux-system", history.DefaultConfigMapName, 5)
+	g.Expect(store.Add(ctx, testRef, record)).To(Succeed())
+
+	// A ConfigMap that can't be read doesn't fail the whole store
+	g.Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      history.DefaultConfigMapName + "-broken",
+			Namespace: "flux-system",
+			Labels:    map[string]string{history.ConfigMapLabel: history.DefaultConfigMapName},
+		},
+		Data: map[string]string{"history": "{not json"},
+	})).To(Succeed())
+
+	reloaded := history.NewConfigMapStore(logr.Discard(), fakeClient, "flux-system", history.DefaultConfigMapName, 5)
+
+	records, err := reloaded.List(ctx, testRef)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(records).To(Equal([]history.Record{record}))
+}
+
+func TestConfigMapStoreSizeCap(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	store := history.NewConfigMapStore(logr.Discard(), fakeClient, "flux-system", history.DefaultConfigMapName, 1000)
+	start := time.Now()
+
+	// The records are too large to all fit in a ConfigMap
+	for i := 0; i < 100; i++ {
+		g.Expect(store.Add(ctx, testRef, history.Record{
+			Revision:  strconv.Itoa(i),
+			Message:   strings.Repeat("x", 4096),
+			Timestamp: start.Add(time.Duration(i) * time.Minute),
+		})).To(Succeed())
+	}
+
+	records, err := store.List(ctx, testRef)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(len(records)).To(BeNumerically("<", 100))
+	g.Expect(records[len(records)-1].Revision).To(Equal("99"))
+
+	cms := &corev1.ConfigMapList{}
+	g.Expect(fakeClient.List(ctx, cms)).To(Succeed())
+	g.Expect(cms.Items).To(HaveLen(1))
+	g.Expect(len(cms.Items[0].Data["history"])).To(BeNumerically("<", 1024*1024))
+}
+
+func TestRecorder(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	started := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+	finished := metav1.NewTime(started.Add(10 * time.Second))
+
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testRef.Name,
+			Namespace: testRef.Namespace,
+		},
+		Status: kustomizev1.KustomizationStatus{
+			LastAttemptedRevision: "main@sha1:abc",
+			Conditions: []metav1.Condition{{
+				Type:               meta.ReadyCondition,
+				Status:             metav1.ConditionUnknown,
+				Reason:             "Progressing",
+				LastTransitionTime: started,
+			}},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kust).WithStatusSubresource(kust).Build()
+
+	cluster := &clusterfakes.FakeCluster{}
+	cluster.GetNameReturns(testRef.Cluster)
+
+	pool := clustersmngr.NewClustersClientsPool()
+	g.Expect(pool.Add(fakeClient, cluster)).To(Succeed())
+
+	clustersManager := &clustersmngrfakes.FakeClustersManager{}
+	clustersManager.GetServerClientReturns(clustersmngr.NewClient(pool, nil, logr.Discard()), nil)
+
+	store := history.NewMemoryStore(10)
+	recorder := history.NewRecorder(logr.Discard(), clustersManager, store)
+
+	// Reconciling, nothing to record yet
+	recorder.Record(ctx)
+
+	records, err := store.List(ctx, testRef)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(records).To(BeEmpty())
+
+	kust.Status.Conditions = []metav1.Condition{{
+		Type:               meta.ReadyCondition,
+		Status:             metav1.ConditionFalse,
+		Reason:             "BuildFailed",
+		Message:            "kustomize build failed",
+		LastTransitionTime: finished,
+	}}
+	g.Expect(fakeClient.Status().Update(ctx, kust)).To(Succeed())
+
+	// Recording twice only records the reconciliation once
+	recorder.Record(ctx)
+	recorder.Record(ctx)
+
+	records, err = store.List(ctx, testRef)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(records).To(HaveLen(1))
+	g.Expect(records[0].Revision).To(Equal("main@sha1:abc"))
+	g.Expect(records[0].Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(records[0].Message).To(Equal("kustomize build failed"))
+	g.Expect(records[0].Timestamp).To(BeTemporally("==", finished.Time))
+	g.Expect(records[0].Duration).To(Equal(10 * time.Second))
+
+	// A requested reconciliation of the same revision that started and
+	// finished between two polls is recorded, and its duration is worked
+	// out from when it was requested
+	requested := finished.Add(time.Minute)
+	refinished := metav1.NewTime(requested.Add(5 * time.Second))
+
+	kust.Status.LastHandledReconcileAt = requested.Format(time.RFC3339Nano)
+	kust.Status.Conditions = []metav1.Condition{{
+		Type:               meta.ReadyCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ReconciliationSucceeded",
+		Message:            "Applied revision: main@sha1:abc",
+		LastTransitionTime: refinished,
+	}}
+	g.Expect(fakeClient.Status().Update(ctx, kust)).To(Succeed())
+
+	recorder.Record(ctx)
+
+	kust.Status.Conditions[0].LastTransitionTime = metav1.NewTime(refinished.Add(10 * time.Minute))
+	g.Expect(fakeClient.Status().Update(ctx, kust)).To(Succeed())
+
+	// The next periodic reconciliation only differs by its transition time
+	recorder.Record(ctx)
+
+	records, err = store.List(ctx, testRef)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(records).To(HaveLen(3))
+	g.Expect(records[1].Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(records[1].Duration).To(Equal(5 * time.Second))
+	g.Expect(records[2].Timestamp).To(BeTemporally("==", refinished.Add(10*time.Minute)))
+	g.Expect(records[2].Duration).To(BeZero())
+
+	// The history of deleted objects is pruned
+	g.Expect(fakeClient.Delete(ctx, kust)).To(Succeed())
+
+	recorder.Record(ctx)
+
+	records, err = store.List(ctx, testRef)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(records).To(BeEmpty())
+}
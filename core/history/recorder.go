@@ -0,0 +1,234 @@
+package history
+
+import (
+	"context"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+)
+
+const recordFrequency = 30 * time.Second
+
+// maxMessageLength caps the length of the recorded messages.
+const maxMessageLength = 4096
+
+// RecordedKinds are the kinds whose reconciliations are recorded.
+var RecordedKinds = []schema.GroupVersionKind{
+	kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind),
+	helmv2.GroupVersion.WithKind(helmv2.HelmReleaseKind),
+}
+
+// Recorder periodically inspects the Ready condition of the automations on all
+// clusters and records every finished reconciliation in the store.
+type Recorder struct {
+	log             logr.Logger
+	clustersManager clustersmngr.ClustersManager
+	store           Store
+	now             func() time.Time
+	// started holds when an object was last seen reconciling, to work out
+	// how long the reconciliation took.
+	started map[ObjectRef]time.Time
+}
+
+// listedKind is a kind that was listed on a cluster.
+type listedKind struct {
+	cluster string
+	kind    string
+}
+
+// NewRecorder returns a Recorder that records to store.
+func NewRecorder(log logr.Logger, clustersManager clustersmngr.ClustersManager, store Store) *Recorder {
+	return &Recorder{
+		log:             log.WithName("reconciliation-history"),
+		clustersManager: clustersManager,
+		store:           store,
+		now:             time.Now,
+		started:         map[ObjectRef]time.Time{},
+	}
+}
+
+// Start records reconciliations until the context is cancelled.
+func (r *Recorder) Start(ctx context.Context) {
+	_ = wait.PollUntilContextCancel(ctx, recordFrequency, true, func(ctx context.Context) (bool, error) {
+		r.Record(ctx)
+
+		return false, nil
+	})
+}
+
+// Record inspects all the automations once, records the reconciliations
+// that finished since the last time, and prunes the history of the
+// automations that were deleted.
+func (r *Recorder) Record(ctx context.Context) {
+	c, err := r.clustersManager.GetServerClient(ctx)
+	if err != nil {
+		r.log.Error(err, "unable to get server client")
+
+		return
+	}
+
+	listed := map[listedKind]bool{}
+	seen := map[ObjectRef]bool{}
+
+	for clusterName, cl := range c.ClientsPool().Clients() {
+		for _, gvk := range RecordedKinds {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk)
+
+			if err := cl.List(ctx, list); err != nil {
+				r.log.Error(err, "unable to list objects", "cluster", clusterName, "kind", gvk.Kind)
+
+				continue
+			}
+
+			listed[listedKind{cluster: clusterName, kind: gvk.Kind}] = true
+
+			for i := range list.Items {
+				ref := ObjectRef{
+					Cluster:   clusterName,
+					Kind:      gvk.Kind,
+					Namespace: list.Items[i].GetNamespace(),
+					Name:      list.Items[i].GetName(),
+				}
+				seen[ref] = true
+
+				if err := r.recordObject(ctx, ref, &list.Items[i]); err != nil {
+					r.log.Error(err, "unable to record reconciliation", "object", ref)
+				}
+			}
+		}
+	}
+
+	// Only the objects of the kinds that could be listed are known to be
+	// deleted
+	keep := func(ref ObjectRef) bool {
+		return seen[ref] || !listed[listedKind{cluster: ref.Cluster, kind: ref.Kind}]
+	}
+
+	for ref := range r.started {
+		if !keep(ref) {
+			delete(r.started, ref)
+		}
+	}
+
+	if err := r.store.Prune(ctx, keep); err != nil {
+		r.log.Error(err, "unable to prune the history of deleted objects")
+	}
+}
+
+func (r *Recorder) recordObject(ctx context.Context, ref ObjectRef, u *unstructured.Unstructured) error {
+	ready := readyCondition(u)
+	if ready == nil {
+		return nil
+	}
+
+	if ready.Status == metav1.ConditionUnknown {
+		if _, ok := r.started[ref]; !ok {
+			r.started[ref] = ready.LastTransitionTime.Time
+		}
+
+		return nil
+	}
+
+	finished := ready.LastTransitionTime.Time
+
+	record := Record{
+		Status:    ready.Status,
+		Reason:    ready.Reason,
+		Message:   ready.Message,
+		Timestamp: finished,
+	}
+	record.Revision, _, _ = unstructured.NestedString(u.Object, "status", "lastAttemptedRevision")
+
+	if len(record.Message) > maxMessageLength {
+		record.Message = record.Message[:maxMessageLength] + "..."
+	}
+
+	records, err := r.store.List(ctx, ref)
+	if err != nil {
+		return err
+	}
+
+	var lastRecorded time.Time
+
+	if len(records) > 0 {
+		last := records[len(records)-1]
+		lastRecorded = last.Timestamp
+
+		// The controllers mark the object as reconciling at the start of
+		// every reconciliation, so a new one, even of the same revision,
+		// moves the transition time past the last record.
+		newReconciliation := finished.After(last.Timestamp)
+		if !newReconciliation && last.Status == record.Status && last.Revision == record.Revision &&
+			last.Reason == record.Reason && last.Message == record.Message {
+			return nil
+		}
+
+		if !newReconciliation {
+			record.Timestamp = r.now()
+		}
+	}
+
+	started := r.started[ref]
+	delete(r.started, ref)
+
+	// Reconciliations that started and finished between two polls weren't
+	// seen reconciling, their start is only known when they were requested
+	if started.IsZero() {
+		started = lastHandledReconcileAt(u, lastRecorded)
+	}
+
+	if !started.IsZero() && finished.After(started) {
+		record.Duration = finished.Sub(started)
+	}
+
+	return r.store.Add(ctx, ref, record)
+}
+
+// lastHandledReconcileAt returns when the last reconciliation requested by
+// annotating the object was requested, if that's after the last record.
+func lastHandledReconcileAt(u *unstructured.Unstructured, lastRecorded time.Time) time.Time {
+	value, _, _ := unstructured.NestedString(u.Object, "status", "lastHandledReconcileAt")
+	if value == "" {
+		return time.Time{}
+	}
+
+	requested, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil || !requested.After(lastRecorded) {
+		return time.Time{}
+	}
+
+	return requested
+}
+
+func readyCondition(u *unstructured.Unstructured) *metav1.Condition {
+	conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+
+	for _, c := range conditions {
+		m, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		cond := &metav1.Condition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, cond); err != nil {
+			continue
+		}
+
+		if cond.Type == meta.ReadyCondition {
+			return cond
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+// Package ownership finds the Flux automations that manage an object, from
+// the labels the controllers add to the objects they apply, or from the
+// automations' inventories.
+package ownership
+
+import (
+	"context"
+	"fmt"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/search"
+)
+
+// How an owner was found.
+const (
+	FoundByLabels    = "labels"
+	FoundByInventory = "inventory"
+)
+
+// maxChainLength stops following owners that were misconfigured to form a
+// long chain.
+const maxChainLength = 10
+
+// The labels the controllers add to the objects they apply.
+const (
+	KustomizeNameLabel      = "kustomize.toolkit.fluxcd.io/name"
+	KustomizeNamespaceLabel = "kustomize.toolkit.fluxcd.io/namespace"
+	HelmNameLabel           = "helm.toolkit.fluxcd.io/name"
+	HelmNamespaceLabel      = "helm.toolkit.fluxcd.io/namespace"
+)
+
+// Owner is an automation managing an object.
+type Owner struct {
+	Automation search.ObjectRef
+	// SourceRef is the source the automation reconciles, nil if it's not
+	// known, e.g. because the automation no longer exists.
+	SourceRef *search.ObjectRef
+	// FoundBy is FoundByLabels or FoundByInventory.
+	FoundBy string
+}
+
+// InventoryLookup returns the Kustomizations whose inventory holds the
+// object.
+type InventoryLookup func(kind, namespace, name string) []search.ObjectRef
+
+// Chain returns the automation that applied the object, then the one that
+// applied that automation, and so on, up to the automation nothing applied.
+// The lookup is used when an object has no ownership labels, and can be
+// nil.
+func Chain(ctx context.Context, c client.Client, clusterName string, obj *unstructured.Unstructured, lookup InventoryLookup) ([]Owner, error) {
+	owners := []Owner{}
+	seen := map[search.ObjectRef]bool{}
+	current := obj
+
+	for len(owners) < maxChainLength {
+		owner, ok := findOwner(clusterName, current, lookup)
+		// The Kustomization applying Flux also applies itself
+		if !ok || seen[owner.Automation] {
+			break
+		}
+
+		seen[owner.Automation] = true
+
+		automation := &unstructured.Unstructured{}
+		automation.SetGroupVersionKind(automationGVK(owner.Automation.Kind))
+
+		err := c.Get(ctx, client.ObjectKey{Namespace: owner.Automation.Namespace, Name: owner.Automation.Name}, automation)
+		if apierrors.IsNotFound(err) {
+			owners = append(owners, owner)
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("getting %s %s/%s: %w", owner.Automation.Kind, owner.Automation.Namespace, owner.Automation.Name, err)
+		}
+
+		if kind, name, namespace, ok := search.SourceRef(*automation); ok {
+			owner.SourceRef = &search.ObjectRef{Cluster: clusterName, Kind: kind, Namespace: namespace, Name: name}
+		}
+
+		owners = append(owners, owner)
+		current = automation
+	}
+
+	return owners, nil
+}
+
+// findOwner returns the automation that applied the object. If the object
+// has both sets of labels the HelmRelease is the nearer owner, as a
+// Kustomization's labels can only reach a chart's objects through the
+// HelmRelease.
+func findOwner(clusterName string, obj *unstructured.Unstructured, lookup InventoryLookup) (Owner, bool) {
+	labels := obj.GetLabels()
+
+	for _, l := range []struct {
+		kind, nameLabel, namespaceLabel string
+	}{
+		{helmv2.HelmReleaseKind, HelmNameLabel, HelmNamespaceLabel},
+		{kustomizev1.KustomizationKind, KustomizeNameLabel, KustomizeNamespaceLabel},
+	} {
+		name, namespace := labels[l.nameLabel], labels[l.namespaceLabel]
+		if name == "" || namespace == "" {
+			continue
+		}
+
+		return Owner{
+			Automation: search.ObjectRef{Cluster: clusterName, Kind: l.kind, Namespace: namespace, Name: name},
+			FoundBy:    FoundByLabels,
+		}, true
+	}
+
+	if lookup == nil {
+		return Owner{}, false
+	}
+
+	for _, ref := range lookup(obj.GetKind(), obj.GetNamespace(), obj.GetName()) {
+		// An automation's inventory can't hold itself
+		if ref.Kind == obj.GetKind() && ref.Namespace == obj.GetNamespace() && ref.Name == obj.GetName() {
+			continue
+		}
+
+		return Owner{Automation: ref, FoundBy: FoundByInventory}, true
+	}
+
+	return Owner{}, false
+}
+
+func automationGVK(kind string) schema.GroupVersionKind {
+	if kind == helmv2.HelmReleaseKind {
+		return helmv2.GroupVersion.WithKind(helmv2.HelmReleaseKind)
+	}
+
+	return kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind)
+}
+
+// IndexLookup looks objects up in the inventories in the search index, on
+// the cluster, leaving out the automations the filter doesn't allow.
+func IndexLookup(index *search.Index, clusterName string, allowed func(cluster, namespace string) bool) InventoryLookup {
+	return func(kind, namespace, name string) []search.ObjectRef {
+		refs := []search.ObjectRef{}
+
+		for _, r := range index.Search(search.Query{
+			Kind:            kustomizev1.KustomizationKind,
+			Cluster:         clusterName,
+			InventoryMember: &search.ObjectRef{Kind: kind, Namespace: namespace, Name: name},
+			Allowed:         allowed,
+		}) {
+			refs = append(refs, r.ObjectRef)
+		}
+
+		return refs
+	}
+}
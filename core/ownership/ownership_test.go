@@ -0,0 +1,131 @@
+package ownership_test
+
+import (
+	"context"
+	"testing"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/ownership"
+	"github.com/weaveworks/weave-gitops/core/search"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func kustomizationLabels(name string) map[string]string {
+	return map[string]string{
+		"kustomize.toolkit.fluxcd.io/name":      name,
+		"kustomize.toolkit.fluxcd.io/namespace": "flux-system",
+	}
+}
+
+func TestChain(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	gitRepo := kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: "flux-system"}
+
+	// flux-system applies itself and apps, which applies the HelmRelease
+	fluxSystem := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "flux-system", Namespace: "flux-system", Labels: kustomizationLabels("flux-system")},
+		Spec:       kustomizev1.KustomizationSpec{SourceRef: gitRepo},
+	}
+	apps := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: "flux-system", Labels: kustomizationLabels("flux-system")},
+		Spec:       kustomizev1.KustomizationSpec{SourceRef: gitRepo},
+		Status: kustomizev1.KustomizationStatus{
+			Inventory: &kustomizev1.ResourceInventory{
+				Entries: []kustomizev1.ResourceRef{
+					{ID: "apps_unlabelled_apps_Deployment", Version: "v1"},
+				},
+			},
+		},
+	}
+	release := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "apps", Labels: kustomizationLabels("apps")},
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart:     "podinfo",
+					SourceRef: helmv2.CrossNamespaceObjectReference{Kind: sourcev1.HelmRepositoryKind, Name: "podinfo"},
+				},
+			},
+		},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "podinfo",
+			Namespace: "apps",
+			Labels: map[string]string{
+				"helm.toolkit.fluxcd.io/name":      "podinfo",
+				"helm.toolkit.fluxcd.io/namespace": "apps",
+			},
+		},
+	}
+	unlabelled := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "unlabelled", Namespace: "apps"}}
+	orphan := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "orphan", Namespace: "apps", Labels: kustomizationLabels("deleted")}}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(fluxSystem, apps, release, deployment, unlabelled, orphan).Build()
+
+	get := func(name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("Deployment"))
+		g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "apps", Name: name}, obj)).To(Succeed())
+
+		return obj
+	}
+
+	owners, err := ownership.Chain(ctx, fakeClient, "Default", get("podinfo"), nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(owners).To(Equal([]ownership.Owner{
+		{
+			Automation: search.ObjectRef{Cluster: "Default", Kind: helmv2.HelmReleaseKind, Namespace: "apps", Name: "podinfo"},
+			SourceRef:  &search.ObjectRef{Cluster: "Default", Kind: sourcev1.HelmRepositoryKind, Namespace: "apps", Name: "podinfo"},
+			FoundBy:    ownership.FoundByLabels,
+		},
+		{
+			Automation: search.ObjectRef{Cluster: "Default", Kind: kustomizev1.KustomizationKind, Namespace: "flux-system", Name: "apps"},
+			SourceRef:  &search.ObjectRef{Cluster: "Default", Kind: sourcev1.GitRepositoryKind, Namespace: "flux-system", Name: "flux-system"},
+			FoundBy:    ownership.FoundByLabels,
+		},
+		{
+			Automation: search.ObjectRef{Cluster: "Default", Kind: kustomizev1.KustomizationKind, Namespace: "flux-system", Name: "flux-system"},
+			SourceRef:  &search.ObjectRef{Cluster: "Default", Kind: sourcev1.GitRepositoryKind, Namespace: "flux-system", Name: "flux-system"},
+			FoundBy:    ownership.FoundByLabels,
+		},
+	}))
+
+	// Without labels nor an index, nothing is found
+	owners, err = ownership.Chain(ctx, fakeClient, "Default", get("unlabelled"), nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(owners).To(BeEmpty())
+
+	index := search.NewClientIndex(logr.Discard(), "Default", fakeClient)
+	index.Refresh(ctx)
+
+	owners, err = ownership.Chain(ctx, fakeClient, "Default", get("unlabelled"), ownership.IndexLookup(index, "Default", nil))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(owners).To(HaveLen(2))
+	g.Expect(owners[0].Automation.Name).To(Equal("apps"))
+	g.Expect(owners[0].FoundBy).To(Equal(ownership.FoundByInventory))
+	g.Expect(owners[1].Automation.Name).To(Equal("flux-system"))
+
+	// Owners that no longer exist end the chain
+	owners, err = ownership.Chain(ctx, fakeClient, "Default", get("orphan"), nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(owners).To(Equal([]ownership.Owner{{
+		Automation: search.ObjectRef{Cluster: "Default", Kind: kustomizev1.KustomizationKind, Namespace: "flux-system", Name: "deleted"},
+		FoundBy:    ownership.FoundByLabels,
+	}}))
+}
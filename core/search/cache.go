@@ -0,0 +1,130 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+)
+
+// errNotSynced is returned when listing a kind whose informer hasn't synced
+// yet. The kind is left out of the index until it has.
+var errNotSynced = errors.New("the objects haven't been cached yet")
+
+// clusterCaches holds the informers watching the indexed kinds on each
+// cluster, so the index is refreshed from memory rather than by listing the
+// objects again.
+type clusterCaches struct {
+	mu     sync.Mutex
+	caches map[string]*clusterCache
+}
+
+type clusterCache struct {
+	cache  cache.Cache
+	cancel context.CancelFunc
+}
+
+// readers returns a reader of the cached objects of each cluster. The
+// informers of clusters seen for the first time are started with ctx, and
+// the ones of clusters that are gone are stopped.
+func (c *clusterCaches) readers(ctx context.Context, clusters []cluster.Cluster) (map[string]client.Reader, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.caches == nil {
+		c.caches = map[string]*clusterCache{}
+	}
+
+	readers := map[string]client.Reader{}
+
+	var errs []error
+
+	for _, cl := range clusters {
+		cc, ok := c.caches[cl.GetName()]
+		if !ok {
+			var err error
+
+			cc, err = startClusterCache(ctx, cl)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("cluster %s: %w", cl.GetName(), err))
+				continue
+			}
+
+			c.caches[cl.GetName()] = cc
+		}
+
+		readers[cl.GetName()] = syncedReader{cache: cc.cache}
+	}
+
+	for name, cc := range c.caches {
+		if _, ok := readers[name]; !ok {
+			cc.cancel()
+			delete(c.caches, name)
+		}
+	}
+
+	return readers, errors.Join(errs...)
+}
+
+func startClusterCache(ctx context.Context, cl cluster.Cluster) (*clusterCache, error) {
+	cfg, err := cl.GetServerConfig()
+	if err != nil {
+		return nil, fmt.Errorf("getting server config: %w", err)
+	}
+
+	informers, err := cache.New(cfg, cache.Options{ReaderFailOnMissingInformer: true})
+	if err != nil {
+		return nil, fmt.Errorf("creating cache: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		_ = informers.Start(ctx)
+	}()
+
+	return &clusterCache{cache: informers, cancel: cancel}, nil
+}
+
+// syncedReader lists the objects from the informers, starting the informer
+// of a kind the first time it's listed. It doesn't wait for the informers
+// to sync, so kinds that can't be watched, e.g. because the server isn't
+// allowed to, don't hold up the other kinds.
+type syncedReader struct {
+	cache cache.Cache
+}
+
+func (r syncedReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return r.cache.Get(ctx, key, obj, opts...)
+}
+
+func (r syncedReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	ul, ok := list.(*unstructured.UnstructuredList)
+	if !ok {
+		return r.cache.List(ctx, list, opts...)
+	}
+
+	gvk := ul.GroupVersionKind()
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+
+	informer, err := r.cache.GetInformer(ctx, obj, cache.BlockUntilSynced(false))
+	if err != nil {
+		return err
+	}
+
+	if !informer.HasSynced() {
+		return errNotSynced
+	}
+
+	return r.cache.List(ctx, list, opts...)
+}
@@ -0,0 +1,318 @@
+// Package search keeps an in-memory index of the Flux objects on all
+// clusters, so they can be found by their metadata, sources, status and
+// inventories without querying every cluster.
+package search
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1b2 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/go-logr/logr"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/cli-utils/pkg/object"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+)
+
+const indexFrequency = 30 * time.Second
+
+// IndexedKinds are the kinds of objects in the index.
+var IndexedKinds = []schema.GroupVersionKind{
+	kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind),
+	helmv2.GroupVersion.WithKind(helmv2.HelmReleaseKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.GitRepositoryKind),
+	sourcev1b2.GroupVersion.WithKind(sourcev1b2.OCIRepositoryKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.BucketKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.HelmRepositoryKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.HelmChartKind),
+}
+
+// ObjectRef identifies an object on a cluster.
+type ObjectRef struct {
+	Cluster   string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// Document is what the index knows about an object.
+type Document struct {
+	ObjectRef
+	Labels      map[string]string
+	Annotations map[string]string
+	// SourceRef is the source the object reconciles, nil if it has none.
+	SourceRef *ObjectRef
+	// Ready is the status of the Ready condition, Unknown if it has none.
+	Ready string
+	// ReadyTransitionTime is when the Ready condition last changed status,
+	// zero if it has none.
+	ReadyTransitionTime time.Time
+	// Suspended is whether the object is suspended.
+	Suspended bool
+	// Revision is the revision the object last applied or fetched.
+	Revision string
+	// Message is the message of the Ready condition.
+	Message string
+	// Messages are the messages of all the object's conditions.
+	Messages []string
+	// Inventory holds the objects a Kustomization applied, in the same
+	// cluster. The inventories of HelmReleases, kept in Helm's storage, aren't
+	// indexed.
+	Inventory []ObjectRef
+}
+
+// Index holds the documents of the objects on all clusters, refreshing
+// them periodically.
+type Index struct {
+	log     logr.Logger
+	readers func(ctx context.Context) (map[string]client.Reader, error)
+
+	mu        sync.RWMutex
+	documents []Document
+	updatedAt time.Time
+}
+
+// NewIndex returns an Index of the objects on the clusters of the
+// ClustersManager. They're watched with the server's permissions, and the
+// index is refreshed from the informers' caches, so refreshing it doesn't
+// query the clusters. A kind is left out of the index on a cluster until
+// its informer has synced.
+func NewIndex(log logr.Logger, clustersManager clustersmngr.ClustersManager) *Index {
+	caches := &clusterCaches{}
+
+	return &Index{
+		log: log.WithName("search-index"),
+		readers: func(ctx context.Context) (map[string]client.Reader, error) {
+			return caches.readers(ctx, clustersManager.GetClusters())
+		},
+	}
+}
+
+// NewClientIndex returns an Index of the objects a single client can list,
+// recorded as being on the named cluster.
+func NewClientIndex(log logr.Logger, clusterName string, cl client.Reader) *Index {
+	return &Index{
+		log: log.WithName("search-index"),
+		readers: func(ctx context.Context) (map[string]client.Reader, error) {
+			return map[string]client.Reader{clusterName: cl}, nil
+		},
+	}
+}
+
+// Start refreshes the index until the context is cancelled.
+func (i *Index) Start(ctx context.Context) {
+	_ = wait.PollUntilContextCancel(ctx, indexFrequency, true, func(ctx context.Context) (bool, error) {
+		i.Refresh(ctx)
+
+		return false, nil
+	})
+}
+
+// Refresh lists the objects on all the clusters and replaces the documents
+// in the index. Kinds that can't be listed on a cluster are left out. The
+// informers of the clusters' caches are started with ctx.
+func (i *Index) Refresh(ctx context.Context) {
+	readers, err := i.readers(ctx)
+	if err != nil {
+		// The clusters that can be read are still indexed
+		i.log.Error(err, "unable to read some clusters")
+	}
+
+	documents := []Document{}
+
+	for clusterName, cl := range readers {
+		for _, gvk := range IndexedKinds {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk)
+
+			if err := cl.List(ctx, list); err != nil {
+				// Flux may not have all its controllers installed
+				if !apimeta.IsNoMatchError(err) && !errors.Is(err, errNotSynced) {
+					i.log.Error(err, "unable to list objects", "cluster", clusterName, "kind", gvk.Kind)
+				}
+
+				continue
+			}
+
+			for _, obj := range list.Items {
+				documents = append(documents, newDocument(clusterName, gvk.Kind, obj))
+			}
+		}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.documents = documents
+	i.updatedAt = time.Now()
+}
+
+// Documents returns the documents of all the objects in the index.
+func (i *Index) Documents() []Document {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return append([]Document{}, i.documents...)
+}
+
+// UpdatedAt is when the index was last refreshed, zero if it hasn't been
+// yet.
+func (i *Index) UpdatedAt() time.Time {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.updatedAt
+}
+
+func newDocument(clusterName, kind string, obj unstructured.Unstructured) Document {
+	doc := Document{
+		ObjectRef: ObjectRef{
+			Cluster:   clusterName,
+			Kind:      kind,
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+		},
+		Labels:      obj.GetLabels(),
+		Annotations: obj.GetAnnotations(),
+		Ready:       ReadyStatus(obj),
+		Revision:    Revision(obj),
+	}
+
+	doc.Suspended, _, _ = unstructured.NestedBool(obj.Object, "spec", "suspend")
+
+	if kind, name, namespace, ok := SourceRef(obj); ok {
+		doc.SourceRef = &ObjectRef{Cluster: clusterName, Kind: kind, Namespace: namespace, Name: name}
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if condition["type"] == "Ready" {
+			transition, _ := condition["lastTransitionTime"].(string)
+			if t, err := time.Parse(time.RFC3339, transition); err == nil {
+				doc.ReadyTransitionTime = t
+			}
+		}
+
+		message, _ := condition["message"].(string)
+		if message == "" {
+			continue
+		}
+
+		doc.Messages = append(doc.Messages, message)
+
+		if condition["type"] == "Ready" {
+			doc.Message = message
+		}
+	}
+
+	entries, _, _ := unstructured.NestedSlice(obj.Object, "status", "inventory", "entries")
+
+	for _, e := range entries {
+		entry, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id, _ := entry["id"].(string)
+
+		meta, err := object.ParseObjMetadata(id)
+		if err != nil {
+			continue
+		}
+
+		doc.Inventory = append(doc.Inventory, ObjectRef{
+			Cluster:   clusterName,
+			Kind:      meta.GroupKind.Kind,
+			Namespace: meta.Namespace,
+			Name:      meta.Name,
+		})
+	}
+
+	return doc
+}
+
+// ReadyStatus is the status of the object's Ready condition, Unknown if it
+// has none.
+func ReadyStatus(obj unstructured.Unstructured) string {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if ok && condition["type"] == "Ready" {
+			if s, ok := condition["status"].(string); ok {
+				return s
+			}
+		}
+	}
+
+	return string(metav1.ConditionUnknown)
+}
+
+// Revision returns the revision the object last applied, or for sources,
+// the revision of their artifact. HelmReleases have the version of the chart
+// they last released.
+func Revision(obj unstructured.Unstructured) string {
+	for _, path := range [][]string{
+		{"status", "lastAppliedRevision"},
+		{"status", "artifact", "revision"},
+	} {
+		if rev, _, _ := unstructured.NestedString(obj.Object, path...); rev != "" {
+			return rev
+		}
+	}
+
+	history, _, _ := unstructured.NestedSlice(obj.Object, "status", "history")
+	if len(history) > 0 {
+		if latest, ok := history[0].(map[string]interface{}); ok {
+			if version, _ := latest["chartVersion"].(string); version != "" {
+				return version
+			}
+		}
+	}
+
+	rev, _, _ := unstructured.NestedString(obj.Object, "status", "lastAttemptedRevision")
+
+	return rev
+}
+
+// SourceRef returns the source the object reconciles: the sourceRef of
+// Kustomizations, Terraforms and HelmCharts, or the chart's source of
+// HelmReleases. The namespace defaults to the object's.
+func SourceRef(obj unstructured.Unstructured) (string, string, string, bool) {
+	for _, path := range [][]string{
+		{"spec", "sourceRef"},
+		{"spec", "chart", "spec", "sourceRef"},
+		{"spec", "chartRef"},
+	} {
+		ref, ok, _ := unstructured.NestedStringMap(obj.Object, path...)
+		if !ok || ref["name"] == "" {
+			continue
+		}
+
+		namespace := ref["namespace"]
+		if namespace == "" {
+			namespace = obj.GetNamespace()
+		}
+
+		return ref["kind"], ref["name"], namespace, true
+	}
+
+	return "", "", "", false
+}
@@ -0,0 +1,215 @@
+package search
+
+import (
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// The scores of the ways a term of the query's text can match a document.
+// A document's score is the sum of the best score of each term.
+const (
+	scoreExactName    = 100
+	scoreNamePrefix   = 50
+	scoreNameContains = 25
+	scoreKind         = 10
+	scoreNamespace    = 10
+	scoreLabel        = 5
+	scoreMessage      = 3
+)
+
+// Query selects documents. Empty fields match every document.
+type Query struct {
+	// Text is split into terms that must all match a document's name, kind,
+	// namespace, label values or condition messages, ignoring case. The
+	// results are ranked by how well they match.
+	Text string
+	// Kind must be the document's kind, ignoring case.
+	Kind string
+	// Name must be contained in the document's name.
+	Name string
+	// Labels must match the document's labels.
+	Labels labels.Selector
+	// Annotations are `key` or `key=value`, and the document must have all
+	// of them.
+	Annotations []string
+	// SourceRef must match the document's source, its empty fields match
+	// any source.
+	SourceRef *ObjectRef
+	// Message must be contained in one of the document's condition
+	// messages, ignoring case.
+	Message string
+	// InventoryMember must be in the document's inventory, its empty fields
+	// match any object.
+	InventoryMember *ObjectRef
+	// Cluster must be the document's cluster.
+	Cluster string
+	// Allowed filters documents out, e.g. those in namespaces the user can't
+	// access, if it's set.
+	Allowed func(cluster, namespace string) bool
+}
+
+// Result is a document matching a query, and how well it matches.
+type Result struct {
+	Document
+	Score int
+}
+
+// Search returns the documents matching the query, best matches first. Equal
+// matches are ordered by name, namespace, cluster and kind, so objects with
+// the same name on different clusters are next to each other.
+func (i *Index) Search(q Query) []Result {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	terms := strings.Fields(strings.ToLower(q.Text))
+	results := []Result{}
+
+	for _, doc := range i.documents {
+		if !q.matches(doc) {
+			continue
+		}
+
+		score, ok := scoreTerms(doc, terms)
+		if !ok {
+			continue
+		}
+
+		results = append(results, Result{Document: doc, Score: score})
+	}
+
+	sort.SliceStable(results, func(a, b int) bool {
+		ra, rb := results[a], results[b]
+		if ra.Score != rb.Score {
+			return ra.Score > rb.Score
+		}
+
+		ka := []string{ra.Name, ra.Namespace, ra.Cluster, ra.Kind}
+		kb := []string{rb.Name, rb.Namespace, rb.Cluster, rb.Kind}
+
+		for i := range ka {
+			if c := strings.Compare(ka[i], kb[i]); c != 0 {
+				return c < 0
+			}
+		}
+
+		return false
+	})
+
+	return results
+}
+
+func (q Query) matches(doc Document) bool {
+	if q.Allowed != nil && !q.Allowed(doc.Cluster, doc.Namespace) {
+		return false
+	}
+
+	if (q.Cluster != "" && q.Cluster != doc.Cluster) ||
+		(q.Kind != "" && !strings.EqualFold(q.Kind, doc.Kind)) ||
+		(q.Name != "" && !strings.Contains(doc.Name, q.Name)) {
+		return false
+	}
+
+	if q.Labels != nil && !q.Labels.Matches(labels.Set(doc.Labels)) {
+		return false
+	}
+
+	for _, a := range q.Annotations {
+		key, value, hasValue := strings.Cut(a, "=")
+
+		v, ok := doc.Annotations[key]
+		if !ok || (hasValue && v != value) {
+			return false
+		}
+	}
+
+	if q.SourceRef != nil && (doc.SourceRef == nil || !matchesRef(*q.SourceRef, *doc.SourceRef)) {
+		return false
+	}
+
+	if q.Message != "" && !containsMessage(doc.Messages, strings.ToLower(q.Message)) {
+		return false
+	}
+
+	if q.InventoryMember != nil {
+		found := false
+
+		for _, ref := range doc.Inventory {
+			if matchesRef(*q.InventoryMember, ref) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesRef is true if the ref matches the query's non-empty fields.
+func matchesRef(query, ref ObjectRef) bool {
+	return (query.Cluster == "" || query.Cluster == ref.Cluster) &&
+		(query.Kind == "" || strings.EqualFold(query.Kind, ref.Kind)) &&
+		(query.Namespace == "" || query.Namespace == ref.Namespace) &&
+		(query.Name == "" || query.Name == ref.Name)
+}
+
+func containsMessage(messages []string, text string) bool {
+	for _, m := range messages {
+		if strings.Contains(strings.ToLower(m), text) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// scoreTerms returns how well the document matches the terms, and false if
+// any of them doesn't match.
+func scoreTerms(doc Document, terms []string) (int, bool) {
+	total := 0
+
+	for _, term := range terms {
+		score := scoreTerm(doc, term)
+		if score == 0 {
+			return 0, false
+		}
+
+		total += score
+	}
+
+	return total, true
+}
+
+func scoreTerm(doc Document, term string) int {
+	name := strings.ToLower(doc.Name)
+
+	switch {
+	case name == term:
+		return scoreExactName
+	case strings.HasPrefix(name, term):
+		return scoreNamePrefix
+	case strings.Contains(name, term):
+		return scoreNameContains
+	case strings.EqualFold(doc.Kind, term):
+		return scoreKind
+	case strings.EqualFold(doc.Namespace, term):
+		return scoreNamespace
+	}
+
+	for _, v := range doc.Labels {
+		if strings.EqualFold(v, term) {
+			return scoreLabel
+		}
+	}
+
+	if containsMessage(doc.Messages, term) {
+		return scoreMessage
+	}
+
+	return 0
+}
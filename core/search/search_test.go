@@ -0,0 +1,130 @@
+package search_test
+
+import (
+	"context"
+	"testing"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/search"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestIndexSearch(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	podinfo := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "podinfo",
+			Namespace:   "apps",
+			Labels:      map[string]string{"team": "platform"},
+			Annotations: map[string]string{"owner": "alice"},
+		},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{
+				Kind:      sourcev1.GitRepositoryKind,
+				Name:      "flux-system",
+				Namespace: "flux-system",
+			},
+		},
+		Status: kustomizev1.KustomizationStatus{
+			Conditions: []metav1.Condition{{
+				Type:    meta.ReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Message: "kustomize build failed: accumulating resources",
+			}},
+			Inventory: &kustomizev1.ResourceInventory{
+				Entries: []kustomizev1.ResourceRef{
+					{ID: "apps_podinfo_apps_Deployment", Version: "v1"},
+				},
+			},
+		},
+	}
+
+	podinfoCache := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo-cache", Namespace: "apps"},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: "OCIRepository", Name: "podinfo"},
+		},
+	}
+
+	release := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-podinfo", Namespace: "flux-system"},
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart:     "podinfo",
+					SourceRef: helmv2.CrossNamespaceObjectReference{Kind: sourcev1.HelmRepositoryKind, Name: "podinfo"},
+				},
+			},
+		},
+	}
+
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "flux-system", Namespace: "flux-system"},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(podinfo, podinfoCache, release, repo).Build()
+
+	index := search.NewClientIndex(logr.Discard(), "Default", fakeClient)
+	g.Expect(index.UpdatedAt().IsZero()).To(BeTrue())
+	g.Expect(index.Search(search.Query{})).To(BeEmpty())
+
+	index.Refresh(ctx)
+	g.Expect(index.UpdatedAt().IsZero()).To(BeFalse())
+
+	names := func(results []search.Result) []string {
+		n := []string{}
+		for _, r := range results {
+			n = append(n, r.Kind+"/"+r.Name)
+		}
+
+		return n
+	}
+
+	// The exact match comes first, then prefixes, then other matches
+	results := index.Search(search.Query{Text: "podinfo"})
+	g.Expect(names(results)).To(Equal([]string{"Kustomization/podinfo", "Kustomization/podinfo-cache", "HelmRelease/my-podinfo"}))
+	g.Expect(results[0].Score).To(BeNumerically(">", results[1].Score))
+	g.Expect(results[0].Ready).To(Equal("False"))
+	g.Expect(results[0].Message).To(Equal("kustomize build failed: accumulating resources"))
+	g.Expect(results[0].SourceRef).To(Equal(&search.ObjectRef{Cluster: "Default", Kind: sourcev1.GitRepositoryKind, Namespace: "flux-system", Name: "flux-system"}))
+
+	// All the terms must match
+	g.Expect(names(index.Search(search.Query{Text: "podinfo build"}))).To(Equal([]string{"Kustomization/podinfo"}))
+	g.Expect(index.Search(search.Query{Text: "podinfo nothing"})).To(BeEmpty())
+
+	g.Expect(names(index.Search(search.Query{Kind: "gitrepository"}))).To(Equal([]string{"GitRepository/flux-system"}))
+	g.Expect(names(index.Search(search.Query{Name: "cache"}))).To(Equal([]string{"Kustomization/podinfo-cache"}))
+	g.Expect(names(index.Search(search.Query{Labels: labels.SelectorFromSet(labels.Set{"team": "platform"})}))).To(Equal([]string{"Kustomization/podinfo"}))
+	g.Expect(names(index.Search(search.Query{Annotations: []string{"owner"}}))).To(Equal([]string{"Kustomization/podinfo"}))
+	g.Expect(index.Search(search.Query{Annotations: []string{"owner=bob"}})).To(BeEmpty())
+	g.Expect(names(index.Search(search.Query{Message: "BUILD FAILED"}))).To(Equal([]string{"Kustomization/podinfo"}))
+
+	// Source namespaces default to the object's
+	g.Expect(names(index.Search(search.Query{SourceRef: &search.ObjectRef{Kind: "OCIRepository", Namespace: "apps"}}))).To(Equal([]string{"Kustomization/podinfo-cache"}))
+	g.Expect(names(index.Search(search.Query{SourceRef: &search.ObjectRef{Kind: "HelmRepository", Name: "podinfo"}}))).To(Equal([]string{"HelmRelease/my-podinfo"}))
+
+	g.Expect(names(index.Search(search.Query{InventoryMember: &search.ObjectRef{Kind: "Deployment", Namespace: "apps", Name: "podinfo"}}))).To(Equal([]string{"Kustomization/podinfo"}))
+	g.Expect(index.Search(search.Query{InventoryMember: &search.ObjectRef{Kind: "Service", Name: "podinfo"}})).To(BeEmpty())
+
+	g.Expect(index.Search(search.Query{Cluster: "other"})).To(BeEmpty())
+	g.Expect(names(index.Search(search.Query{
+		Text: "podinfo",
+		Allowed: func(cluster, namespace string) bool {
+			return namespace == "flux-system"
+		},
+	}))).To(Equal([]string{"HelmRelease/my-podinfo"}))
+}
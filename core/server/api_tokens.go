@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func (cs *coreServer) CreateAPIToken(ctx context.Context, msg *pb.CreateAPITokenRequest) (*pb.CreateAPITokenResponse, error) {
+	principal, err := cs.apiTokenPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens can't be used to mint more tokens, which would outlive them
+	if principal.APITokenID != "" {
+		return nil, status.Error(codes.PermissionDenied, "API tokens can't be created with an API token")
+	}
+
+	var ttl time.Duration
+	if msg.Ttl != "" {
+		ttl, err = time.ParseDuration(msg.Ttl)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid ttl: %v", err)
+		}
+	}
+
+	if ttl < 0 || ttl > auth.MaxAPITokenTTL {
+		return nil, status.Errorf(codes.InvalidArgument, "ttl must be up to %s", auth.MaxAPITokenTTL)
+	}
+
+	if err := auth.ValidateAPITokenScopes(msg.Scopes); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// The token acts as the user only. It doesn't get the user's groups,
+	// which would outlive their membership of them, so it's refused what
+	// the user is only allowed through a group
+	owner := &auth.UserPrincipal{ID: principal.ID}
+
+	raw, token, err := cs.apiTokens.Create(ctx, owner, msg.Name, msg.Scopes, ttl)
+	if err != nil {
+		return nil, fmt.Errorf("creating API token: %w", err)
+	}
+
+	cs.logger.Info("Created API token", "user", principal.ID, "id", token.ID, "scopes", token.Scopes, "expires", token.ExpiresAt)
+
+	return &pb.CreateAPITokenResponse{
+		Token:   raw,
+		Details: apiTokenToProto(*token),
+	}, nil
+}
+
+func (cs *coreServer) ListAPITokens(ctx context.Context, msg *pb.ListAPITokensRequest) (*pb.ListAPITokensResponse, error) {
+	principal, err := cs.apiTokenPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := cs.apiTokens.List(ctx, principal.ID)
+	if err != nil {
+		return nil, fmt.Errorf("listing API tokens: %w", err)
+	}
+
+	res := &pb.ListAPITokensResponse{Tokens: []*pb.APIToken{}}
+	for _, t := range tokens {
+		res.Tokens = append(res.Tokens, apiTokenToProto(t))
+	}
+
+	return res, nil
+}
+
+func (cs *coreServer) RevokeAPIToken(ctx context.Context, msg *pb.RevokeAPITokenRequest) (*pb.RevokeAPITokenResponse, error) {
+	principal, err := cs.apiTokenPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cs.apiTokens.Revoke(ctx, principal.ID, msg.Id); err != nil {
+		if errors.Is(err, auth.ErrAPITokenNotFound) {
+			return nil, status.Errorf(codes.NotFound, "API token %q not found", msg.Id)
+		}
+
+		return nil, fmt.Errorf("revoking API token: %w", err)
+	}
+
+	cs.logger.Info("Revoked API token", "user", principal.ID, "id", msg.Id)
+
+	return &pb.RevokeAPITokenResponse{}, nil
+}
+
+// apiTokenPrincipal returns the user whose API tokens are managed, failing
+// if API tokens are disabled or the user is only known by their Kubernetes
+// token.
+func (cs *coreServer) apiTokenPrincipal(ctx context.Context) (*auth.UserPrincipal, error) {
+	if cs.apiTokens == nil {
+		return nil, status.Error(codes.FailedPrecondition, "API tokens are not enabled")
+	}
+
+	principal := auth.Principal(ctx)
+	if principal == nil || principal.ID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "API tokens need a user ID")
+	}
+
+	return principal, nil
+}
+
+func apiTokenToProto(t auth.APIToken) *pb.APIToken {
+	token := &pb.APIToken{
+		Id:        t.ID,
+		Name:      t.Name,
+		Owner:     t.Owner,
+		Groups:    t.Groups,
+		Scopes:    t.Scopes,
+		CreatedAt: t.CreatedAt.Format(time.RFC3339),
+		ExpiresAt: t.ExpiresAt.Format(time.RFC3339),
+	}
+
+	if t.LastUsedAt != nil {
+		token.LastUsedAt = t.LastUsedAt.Format(time.RFC3339)
+	}
+
+	return token
+}
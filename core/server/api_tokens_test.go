@@ -0,0 +1,95 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func TestAPITokens(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	cfg.APITokens = auth.NewAPITokenStore(logr.Discard(), fakeClient, "flux-system")
+	c := makeServer(ctx, t, cfg)
+
+	aliceCtx := metadata.AppendToOutgoingContext(ctx, MetadataUserKey, "alice@example.com", MetadataGroupsKey, "ops")
+	bobCtx := metadata.AppendToOutgoingContext(ctx, MetadataUserKey, "bob@example.com")
+
+	created, err := c.CreateAPIToken(aliceCtx, &api.CreateAPITokenRequest{
+		Name:   "ci",
+		Scopes: []string{auth.APITokenScopeRead, auth.APITokenScopeWrite},
+		Ttl:    "24h",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(created.Token).NotTo(BeEmpty())
+	g.Expect(created.Details.Owner).To(Equal("alice@example.com"))
+	// The user's groups may change, so they're not kept in the token
+	g.Expect(created.Details.Groups).To(BeEmpty())
+
+	// The token authenticates as its owner
+	token, err := cfg.APITokens.Authenticate(ctx, created.Token)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(token.Owner).To(Equal("alice@example.com"))
+
+	list, err := c.ListAPITokens(aliceCtx, &api.ListAPITokensRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(list.Tokens).To(HaveLen(1))
+	g.Expect(list.Tokens[0].Id).To(Equal(created.Details.Id))
+	g.Expect(list.Tokens[0].LastUsedAt).NotTo(BeEmpty())
+
+	list, err = c.ListAPITokens(bobCtx, &api.ListAPITokensRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(list.Tokens).To(BeEmpty())
+
+	_, err = c.RevokeAPIToken(bobCtx, &api.RevokeAPITokenRequest{Id: created.Details.Id})
+	g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+	_, err = c.RevokeAPIToken(aliceCtx, &api.RevokeAPITokenRequest{Id: created.Details.Id})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = cfg.APITokens.Authenticate(ctx, created.Token)
+	g.Expect(err).To(MatchError(auth.ErrAPITokenNotFound))
+
+	for _, req := range []*api.CreateAPITokenRequest{
+		{Name: "no-scopes"},
+		{Name: "bad-ttl", Scopes: []string{auth.APITokenScopeRead}, Ttl: "forever"},
+		{Name: "too-long", Scopes: []string{auth.APITokenScopeRead}, Ttl: "10000h"},
+	} {
+		_, err = c.CreateAPIToken(aliceCtx, req)
+		g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument), req.Name)
+	}
+}
+
+func TestAPITokens_Disabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	c := makeServer(ctx, t, makeServerConfig(t, fake.NewClientBuilder().WithScheme(scheme).Build(), ""))
+
+	_, err = c.ListAPITokens(metadata.AppendToOutgoingContext(ctx, MetadataUserKey, "alice@example.com"), &api.ListAPITokensRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+}
@@ -0,0 +1,245 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/fluxsync"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/health"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+// maxHealthOffenders is how many of the least healthy objects a health
+// summary lists.
+const maxHealthOffenders = 10
+
+// healthSeverity orders the health statuses from best to worst.
+var healthSeverity = map[string]int{
+	string(health.HealthStatusHealthy):     0,
+	string(health.HealthStatusProgressing): 1,
+	string(health.HealthStatusUnknown):     2,
+	string(health.HealthStatusUnhealthy):   3,
+}
+
+func severity(h *pb.HealthStatus) int {
+	if s, ok := healthSeverity[h.GetStatus()]; ok {
+		return s
+	}
+
+	return healthSeverity[string(health.HealthStatusUnknown)]
+}
+
+func (cs *coreServer) GetApplicationHealth(ctx context.Context, msg *pb.GetApplicationHealthRequest) (*pb.GetApplicationHealthResponse, error) {
+	if msg.ClusterName == "" {
+		msg.ClusterName = DefaultCluster
+	}
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	client, err := clustersClient.Scoped(msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scoped client for cluster=%s: %w", msg.ClusterName, err)
+	}
+
+	// The automation is only fetched once, for its own health and its
+	// inventory
+	automation, err := cs.getAutomation(ctx, client, msg.Kind, msg.Name, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := newHealthSummary()
+	if err := cs.addAutomationHealth(summary, msg.ClusterName, automation); err != nil {
+		return nil, err
+	}
+
+	inventoryRefs, defaultNS, err := cs.inventoryRefs(ctx, client, automation)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, oc := range getObjectsWithChildren(ctx, defaultNS, inventoryRefs, client, msg.WithChildren, cs.logger) {
+		if err := addObjectHealth(summary, msg.ClusterName, oc, cs.healthChecker); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.GetApplicationHealthResponse{
+		Summary: finishHealthSummary(summary),
+	}, nil
+}
+
+// rollupHealth returns the worst of the entry's health and its children's
+// rolled up health. When a child is the worst, the message says which.
+func rollupHealth(own *pb.HealthStatus, objects []*ObjectWithChildren, children []*pb.InventoryEntry) *pb.HealthStatus {
+	worst := own
+
+	for i, child := range children {
+		if severity(child.RollupHealth) <= severity(worst) {
+			continue
+		}
+
+		obj := objects[i].Object
+		worst = &pb.HealthStatus{
+			Status:  child.RollupHealth.Status,
+			Message: fmt.Sprintf("%s %s: %s", obj.GetKind(), objectName(obj), child.RollupHealth.Message),
+		}
+	}
+
+	return worst
+}
+
+func objectName(obj client.Object) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+func newHealthSummary() *pb.HealthSummary {
+	return &pb.HealthSummary{
+		Status: string(health.HealthStatusHealthy),
+		Counts: map[string]int32{},
+	}
+}
+
+func addHealth(summary *pb.HealthSummary, clusterName string, obj client.Object, h *pb.HealthStatus) {
+	summary.Counts[h.Status]++
+
+	if severity(h) > severity(&pb.HealthStatus{Status: summary.Status}) {
+		summary.Status = h.Status
+	}
+
+	if severity(h) == 0 {
+		return
+	}
+
+	summary.Offenders = append(summary.Offenders, &pb.HealthOffender{
+		Object: &pb.ObjectRef{
+			Kind:        obj.GetObjectKind().GroupVersionKind().Kind,
+			Name:        obj.GetName(),
+			Namespace:   obj.GetNamespace(),
+			ClusterName: clusterName,
+		},
+		Health: h,
+	})
+}
+
+// addEntryHealth adds the inventory entry, and its children, that were made
+// from the object to the summary.
+func addEntryHealth(summary *pb.HealthSummary, clusterName string, oc *ObjectWithChildren, entry *pb.InventoryEntry) {
+	addHealth(summary, clusterName, oc.Object, entry.Health)
+
+	for i, child := range oc.Children {
+		addEntryHealth(summary, clusterName, child, entry.Children[i])
+	}
+}
+
+// addObjectHealth checks the object, and its children, and adds them to the
+// summary.
+func addObjectHealth(summary *pb.HealthSummary, clusterName string, oc *ObjectWithChildren, healthChecker health.HealthChecker) error {
+	h, err := healthChecker.Check(*oc.Object)
+	if err != nil {
+		return fmt.Errorf("failed to check health: %w", err)
+	}
+
+	addHealth(summary, clusterName, oc.Object, &pb.HealthStatus{Status: string(h.Status), Message: h.Message})
+
+	for _, child := range oc.Children {
+		if err := addObjectHealth(summary, clusterName, child, healthChecker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getAutomation gets the Flux object whose inventory is summarized.
+func (cs *coreServer) getAutomation(ctx context.Context, c client.Client, kind, name, namespace string) (*unstructured.Unstructured, error) {
+	gvk, err := cs.primaryKinds.Lookup(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(*gvk)
+
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+		return nil, wrapK8sAPIError(fmt.Sprintf("failed to get %s", kind), err)
+	}
+
+	return obj, nil
+}
+
+// addAutomationHealth adds the Flux object whose inventory is summarized. Its
+// health comes from its Ready condition, so failing to apply the inventory
+// makes the application unhealthy.
+func (cs *coreServer) addAutomationHealth(summary *pb.HealthSummary, clusterName string, obj *unstructured.Unstructured) error {
+	kind := obj.GetKind()
+	h := &pb.HealthStatus{Status: string(health.HealthStatusUnknown)}
+
+	ready := apimeta.FindStatusCondition(fluxsync.UnstructuredAdapter{Unstructured: obj}.GetConditions(), meta.ReadyCondition)
+
+	switch {
+	case ready == nil && kind != kustomizev1.KustomizationKind && kind != helmv2.HelmReleaseKind:
+		checked, err := cs.healthChecker.Check(*obj)
+		if err != nil {
+			return fmt.Errorf("failed to check health: %w", err)
+		}
+
+		h = &pb.HealthStatus{Status: string(checked.Status), Message: checked.Message}
+	case ready == nil:
+		h.Message = "not reconciled yet"
+	case ready.Status == metav1.ConditionTrue:
+		h = &pb.HealthStatus{Status: string(health.HealthStatusHealthy), Message: ready.Message}
+	case ready.Status == metav1.ConditionFalse:
+		h = &pb.HealthStatus{Status: string(health.HealthStatusUnhealthy), Message: ready.Message}
+	default:
+		h = &pb.HealthStatus{Status: string(health.HealthStatusProgressing), Message: ready.Message}
+	}
+
+	addHealth(summary, clusterName, obj, h)
+
+	return nil
+}
+
+// finishHealthSummary sorts the offenders worst first and keeps the
+// maxHealthOffenders worst.
+func finishHealthSummary(summary *pb.HealthSummary) *pb.HealthSummary {
+	sort.SliceStable(summary.Offenders, func(i, j int) bool {
+		a, b := summary.Offenders[i], summary.Offenders[j]
+		if severity(a.Health) != severity(b.Health) {
+			return severity(a.Health) > severity(b.Health)
+		}
+
+		if a.Object.Kind != b.Object.Kind {
+			return a.Object.Kind < b.Object.Kind
+		}
+
+		if a.Object.Namespace != b.Object.Namespace {
+			return a.Object.Namespace < b.Object.Namespace
+		}
+
+		return a.Object.Name < b.Object.Name
+	})
+
+	if len(summary.Offenders) > maxHealthOffenders {
+		summary.Offenders = summary.Offenders[:maxHealthOffenders]
+	}
+
+	return summary
+}
@@ -0,0 +1,122 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestGetApplicationHealth(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "apps"}}
+
+	newDeployment := func(name string, conditions ...appsv1.DeploymentCondition) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns.Name},
+			Status:     appsv1.DeploymentStatus{Conditions: conditions},
+		}
+	}
+
+	healthy := newDeployment("healthy")
+	healthy.UID = "healthy-uid"
+
+	// A ReplicaSet of the healthy Deployment that failed to create its Pods
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "healthy-123abcd",
+			Namespace: ns.Name,
+			OwnerReferences: []metav1.OwnerReference{{
+				UID:        healthy.UID,
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       healthy.Name,
+			}},
+		},
+		Status: appsv1.ReplicaSetStatus{
+			Conditions: []appsv1.ReplicaSetCondition{{
+				Type:    appsv1.ReplicaSetReplicaFailure,
+				Status:  corev1.ConditionTrue,
+				Message: "pods are forbidden",
+			}},
+		},
+	}
+	stuck := newDeployment("stuck", appsv1.DeploymentCondition{
+		Type:   appsv1.DeploymentProgressing,
+		Status: corev1.ConditionFalse,
+		Reason: "ProgressDeadlineExceeded",
+	})
+
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: ns.Name},
+		Status: kustomizev1.KustomizationStatus{
+			Conditions: []metav1.Condition{{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, Reason: "ReconciliationSucceeded"}},
+			Inventory: &kustomizev1.ResourceInventory{
+				Entries: []kustomizev1.ResourceRef{
+					{ID: fmt.Sprintf("%s_%s_apps_Deployment", ns.Name, healthy.Name), Version: "v1"},
+					{ID: fmt.Sprintf("%s_%s_apps_Deployment", ns.Name, stuck.Name), Version: "v1"},
+				},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, kust, healthy, stuck, rs).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	c := makeServer(ctx, t, cfg)
+
+	res, err := c.GetApplicationHealth(ctx, &pb.GetApplicationHealthRequest{
+		Kind:      kustomizev1.KustomizationKind,
+		Name:      kust.Name,
+		Namespace: kust.Namespace,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(res.Summary.Status).To(Equal("Unhealthy"))
+	g.Expect(res.Summary.Counts).To(Equal(map[string]int32{"Healthy": 2, "Unhealthy": 1}))
+	g.Expect(res.Summary.Offenders).To(HaveLen(1))
+	g.Expect(res.Summary.Offenders[0].Object.Name).To(Equal(stuck.Name))
+	g.Expect(res.Summary.Offenders[0].Object.Kind).To(Equal("Deployment"))
+	g.Expect(res.Summary.Offenders[0].Health.Message).To(ContainSubstring("progress deadline"))
+
+	inventory, err := c.GetInventory(ctx, &pb.GetInventoryRequest{
+		Kind:         kustomizev1.KustomizationKind,
+		Name:         kust.Name,
+		Namespace:    kust.Namespace,
+		ClusterName:  "Default",
+		WithChildren: true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// The ReplicaSet is included with the children
+	g.Expect(inventory.HealthSummary.Status).To(Equal("Unhealthy"))
+	g.Expect(inventory.HealthSummary.Counts).To(Equal(map[string]int32{"Healthy": 2, "Unhealthy": 2}))
+	g.Expect(inventory.HealthSummary.Offenders).To(HaveLen(2))
+
+	for _, e := range inventory.Entries {
+		if len(e.Children) == 0 {
+			continue
+		}
+
+		g.Expect(e.Health.Status).To(Equal("Healthy"))
+		g.Expect(e.RollupHealth.Status).To(Equal("Unhealthy"))
+		g.Expect(e.RollupHealth.Message).To(Equal("ReplicaSet apps/healthy-123abcd: pods are forbidden"))
+	}
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/artifacts"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+// maxArtifactFileSize is how much of a file in an artifact is returned.
+const maxArtifactFileSize = 1 << 20
+
+// artifactKinds are the sources whose artifacts are tarballs of files.
+var artifactKinds = map[string]bool{
+	sourcev1.GitRepositoryKind: true,
+	"OCIRepository":            true,
+	sourcev1.BucketKind:        true,
+}
+
+func (cs *coreServer) ListArtifactFiles(ctx context.Context, msg *pb.ListArtifactFilesRequest) (*pb.ListArtifactFilesResponse, error) {
+	archive, err := cs.sourceArchive(ctx, msg.ClusterName, msg.Kind, msg.Namespace, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	directory := strings.Trim(msg.Directory, "/")
+
+	res := &pb.ListArtifactFilesResponse{
+		Revision: archive.Revision,
+		Digest:   archive.Digest,
+		Files:    []*pb.ArtifactFile{},
+	}
+
+	for _, f := range archive.Files {
+		if directory != "" && !strings.HasPrefix(f.Path, directory+"/") {
+			continue
+		}
+
+		res.Files = append(res.Files, &pb.ArtifactFile{Path: f.Path, Size: f.Size})
+	}
+
+	return res, nil
+}
+
+func (cs *coreServer) GetArtifactFile(ctx context.Context, msg *pb.GetArtifactFileRequest) (*pb.GetArtifactFileResponse, error) {
+	if msg.Path == "" {
+		return nil, status.Error(codes.InvalidArgument, "path is required")
+	}
+
+	archive, err := cs.sourceArchive(ctx, msg.ClusterName, msg.Kind, msg.Namespace, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	content, ok := archive.ReadFile(msg.Path)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s is not in the artifact of revision %s", msg.Path, archive.Revision)
+	}
+
+	res := &pb.GetArtifactFileResponse{
+		Revision: archive.Revision,
+		Digest:   archive.Digest,
+		Path:     msg.Path,
+		Size:     int64(len(content)),
+		Content:  content,
+	}
+
+	if len(content) > maxArtifactFileSize {
+		res.Content = content[:maxArtifactFileSize]
+		res.Truncated = true
+	}
+
+	return res, nil
+}
+
+// checkArtifactCluster refuses to fetch the artifacts of the sources on
+// other clusters than the one the server runs in. Their URLs are the
+// in-cluster address of that cluster's source-controller, which would
+// resolve to the server's own cluster.
+func checkArtifactCluster(clusterName string) error {
+	if clusterName != DefaultCluster {
+		return status.Errorf(codes.FailedPrecondition, "artifacts can only be fetched from the %s cluster, not %s", DefaultCluster, clusterName)
+	}
+
+	return nil
+}
+
+// sourceArchive returns the files of the latest artifact of the source. The
+// source is read as the user, so only those who can read it can see them.
+func (cs *coreServer) sourceArchive(ctx context.Context, clusterName, kind, namespace, name string) (*artifacts.Archive, error) {
+	if !artifactKinds[kind] {
+		return nil, status.Errorf(codes.InvalidArgument, "only the artifacts of a GitRepository, OCIRepository or Bucket can be browsed, not a %q", kind)
+	}
+
+	if clusterName == "" {
+		clusterName = DefaultCluster
+	}
+
+	if err := checkArtifactCluster(clusterName); err != nil {
+		return nil, err
+	}
+
+	gvk, err := cs.primaryKinds.Lookup(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClientForCluster(ctx, auth.Principal(ctx), clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	c, err := clustersClient.Scoped(clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scoped client for cluster=%s: %w", clusterName, err)
+	}
+
+	source := &unstructured.Unstructured{}
+	source.SetGroupVersionKind(*gvk)
+
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, source); err != nil {
+		return nil, wrapK8sAPIError("get source", err)
+	}
+
+	artifact := artifacts.FromSource(source)
+	if artifact == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s %s/%s has no artifact", kind, namespace, name)
+	}
+
+	archive, err := cs.artifacts.Get(ctx, *artifact)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "%s", err.Error())
+	}
+
+	return archive, nil
+}
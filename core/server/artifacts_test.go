@@ -0,0 +1,114 @@
+package server_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestArtifactFiles(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	var artifact bytes.Buffer
+
+	gz := gzip.NewWriter(&artifact)
+	tw := tar.NewWriter(gz)
+
+	for name, content := range map[string]string{
+		"apps/podinfo/deployment.yaml": "kind: Deployment\n",
+		"infra/ingress.yaml":           "kind: Ingress\n",
+	} {
+		g.Expect(tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o600, Size: int64(len(content))})).To(Succeed())
+		_, err := tw.Write([]byte(content))
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+
+	g.Expect(tw.Close()).To(Succeed())
+	g.Expect(gz.Close()).To(Succeed())
+
+	sum := sha256.Sum256(artifact.Bytes())
+	artifactDigest := "sha256:" + hex.EncodeToString(sum[:])
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(artifact.Bytes())
+	}))
+	defer srv.Close()
+
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: "flux-system"},
+		Status: sourcev1.GitRepositoryStatus{
+			Artifact: &sourcev1.Artifact{URL: srv.URL + "/apps.tar.gz", Revision: "main@sha1:abc", Digest: artifactDigest},
+		},
+	}
+	noArtifact := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "flux-system"},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(repo, noArtifact).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	c := makeServer(ctx, t, cfg)
+
+	userCtx := metadata.AppendToOutgoingContext(ctx, MetadataUserKey, "bob@example.com")
+
+	files, err := c.ListArtifactFiles(userCtx, &pb.ListArtifactFilesRequest{
+		ClusterName: "Default",
+		Kind:        sourcev1.GitRepositoryKind,
+		Namespace:   "flux-system",
+		Name:        "apps",
+		Directory:   "apps/",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(files.Revision).To(Equal("main@sha1:abc"))
+	g.Expect(files.Digest).To(Equal(artifactDigest))
+	g.Expect(files.Files).To(HaveLen(1))
+	g.Expect(files.Files[0].Path).To(Equal("apps/podinfo/deployment.yaml"))
+	g.Expect(files.Files[0].Size).To(Equal(int64(17)))
+
+	file, err := c.GetArtifactFile(userCtx, &pb.GetArtifactFileRequest{
+		ClusterName: "Default",
+		Kind:        sourcev1.GitRepositoryKind,
+		Namespace:   "flux-system",
+		Name:        "apps",
+		Path:        "infra/ingress.yaml",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(file.Content)).To(Equal("kind: Ingress\n"))
+	g.Expect(file.Truncated).To(BeFalse())
+
+	_, err = c.GetArtifactFile(userCtx, &pb.GetArtifactFileRequest{ClusterName: "Default", Kind: sourcev1.GitRepositoryKind, Namespace: "flux-system", Name: "apps", Path: "missing.yaml"})
+	g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+	_, err = c.ListArtifactFiles(userCtx, &pb.ListArtifactFilesRequest{ClusterName: "Default", Kind: sourcev1.GitRepositoryKind, Namespace: "flux-system", Name: "new"})
+	g.Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+	_, err = c.ListArtifactFiles(userCtx, &pb.ListArtifactFilesRequest{ClusterName: "Default", Kind: sourcev1.HelmRepositoryKind, Namespace: "flux-system", Name: "apps"})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	// The artifact URLs of other clusters only resolve in those clusters
+	_, err = c.ListArtifactFiles(userCtx, &pb.ListArtifactFilesRequest{ClusterName: "leaf", Kind: sourcev1.GitRepositoryKind, Namespace: "flux-system", Name: "apps"})
+	g.Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+}
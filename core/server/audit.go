@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/weaveworks/weave-gitops/core/audit"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func (cs *coreServer) ListAuditEvents(ctx context.Context, msg *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	if cs.auditLog == nil {
+		return nil, status.Error(codes.FailedPrecondition, "audit log is not enabled")
+	}
+
+	if msg.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	principal := auth.Principal(ctx)
+
+	if err := cs.authorize(principal, auth.ActionListAuditEvents, msg.ClusterName, msg.Namespace); err != nil {
+		return nil, err
+	}
+
+	clusterUserNamespaces := cs.clustersManager.GetUserNamespaces(principal)
+
+	// Cluster-scoped objects aren't in any namespace, their events are only
+	// listed to the admins policies allow to list the events of all the
+	// namespaces of the cluster
+	clusterAdmin := map[string]bool{}
+	isClusterAdmin := func(cluster string) bool {
+		admin, ok := clusterAdmin[cluster]
+		if !ok {
+			admin = cs.authorizer != nil && cs.authorizer.Authorize(principal, auth.ActionListAuditEvents, cluster, "") == nil
+			clusterAdmin[cluster] = admin
+		}
+
+		return admin
+	}
+
+	events := cs.auditLog.List(func(e audit.Event) bool {
+		if (msg.ClusterName != "" && e.Cluster != msg.ClusterName) ||
+			(msg.Namespace != "" && e.Namespace != msg.Namespace) ||
+			(msg.Kind != "" && e.Kind != msg.Kind) ||
+			(msg.Name != "" && e.Name != msg.Name) ||
+			(msg.Principal != "" && e.Principal != msg.Principal) {
+			return false
+		}
+
+		if e.Namespace == "" {
+			return isClusterAdmin(e.Cluster)
+		}
+
+		// Only the events of objects the user can see are listed
+		for _, ns := range clusterUserNamespaces[e.Cluster] {
+			if ns.Name == e.Namespace {
+				return true
+			}
+		}
+
+		return false
+	}, int(msg.Limit))
+
+	res := &pb.ListAuditEventsResponse{Events: []*pb.AuditEvent{}}
+
+	for _, e := range events {
+		res.Events = append(res.Events, &pb.AuditEvent{
+			Timestamp:   e.Time.Format(time.RFC3339),
+			RequestId:   e.RequestID,
+			Principal:   e.Principal,
+			Groups:      e.Groups,
+			ClusterName: e.Cluster,
+			ApiVersion:  e.APIVersion,
+			Kind:        e.Kind,
+			Namespace:   e.Namespace,
+			Name:        e.Name,
+			Action:      e.Action,
+			Comment:     e.Comment,
+			Outcome:     e.Outcome,
+			Error:       e.Error,
+		})
+	}
+
+	return res, nil
+}
+
+// recordAction records that the principal took the action on the object, if
+// actions are audited. err is the reason the action failed.
+func (cs *coreServer) recordAction(ctx context.Context, requestID string, principal *auth.UserPrincipal, ref *pb.ObjectRef, action, comment string, err error) {
+	if cs.auditLog == nil {
+		return
+	}
+
+	event := audit.Event{
+		RequestID: requestID,
+		Principal: principal.ID,
+		Groups:    principal.Groups,
+		Cluster:   ref.ClusterName,
+		Kind:      ref.Kind,
+		Namespace: ref.Namespace,
+		Name:      ref.Name,
+		Action:    action,
+		Comment:   comment,
+		Outcome:   audit.OutcomeSucceeded,
+	}
+
+	if gvk, err := cs.primaryKinds.Lookup(ref.Kind); err == nil {
+		event.APIVersion = gvk.GroupVersion().String()
+	}
+
+	if err != nil {
+		event.Outcome = audit.OutcomeFailed
+		event.Error = err.Error()
+	}
+
+	cs.auditLog.Record(ctx, event)
+}
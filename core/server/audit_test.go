@@ -0,0 +1,141 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/audit"
+	api "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	kust := &kustomizev1.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: ns.Name}}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, kust).Build()
+
+	out := &bytes.Buffer{}
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	cfg.AuditLog = audit.NewLog(logr.Discard(), audit.DefaultMaxEvents, audit.NewJSONLinesSink(out))
+	c := makeServer(ctx, t, cfg)
+
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		MetadataUserKey, "alice",
+		MetadataGroupsKey, "ops",
+		middleware.RequestIDHeader, "request-1",
+	)
+
+	for _, suspend := range []bool{true, false} {
+		_, err := c.ToggleSuspendResource(ctx, &api.ToggleSuspendResourceRequest{
+			Objects: []*api.ObjectRef{
+				{Kind: kustomizev1.KustomizationKind, Name: kust.Name, Namespace: ns.Name, ClusterName: "Default"},
+				{Kind: kustomizev1.KustomizationKind, Name: "missing", Namespace: ns.Name, ClusterName: "Default"},
+			},
+			Suspend: suspend,
+			Comment: "release freeze",
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+
+	res, err := c.ListAuditEvents(ctx, &api.ListAuditEventsRequest{Name: kust.Name})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Events).To(HaveLen(2))
+
+	// Newest first
+	g.Expect(res.Events[0].Action).To(Equal(audit.ActionResume))
+	g.Expect(res.Events[1].Action).To(Equal(audit.ActionSuspend))
+
+	event := res.Events[1]
+	g.Expect(event.Principal).To(Equal("alice"))
+	g.Expect(event.Groups).To(Equal([]string{"ops"}))
+	g.Expect(event.RequestId).To(Equal("request-1"))
+	g.Expect(event.ClusterName).To(Equal("Default"))
+	g.Expect(event.ApiVersion).To(Equal(kustomizev1.GroupVersion.String()))
+	g.Expect(event.Kind).To(Equal(kustomizev1.KustomizationKind))
+	g.Expect(event.Namespace).To(Equal(ns.Name))
+	g.Expect(event.Comment).To(Equal("release freeze"))
+	g.Expect(event.Outcome).To(Equal(audit.OutcomeSucceeded))
+
+	res, err = c.ListAuditEvents(ctx, &api.ListAuditEventsRequest{Name: "missing", Limit: 1})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Events).To(HaveLen(1))
+	g.Expect(res.Events[0].Outcome).To(Equal(audit.OutcomeFailed))
+	g.Expect(res.Events[0].Error).NotTo(BeEmpty())
+
+	// Events in namespaces the user can't access aren't listed
+	res, err = c.ListAuditEvents(ctx, &api.ListAuditEventsRequest{Namespace: "other"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Events).To(BeEmpty())
+
+	// Every action was written to the sink
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	g.Expect(lines).To(HaveLen(4))
+
+	var written audit.Event
+	g.Expect(json.Unmarshal(lines[0], &written)).To(Succeed())
+	g.Expect(written.Principal).To(Equal("alice"))
+	g.Expect(written.Action).To(Equal(audit.ActionSuspend))
+
+	// The events of cluster-scoped objects are only listed to admins
+	cfg.AuditLog.Record(ctx, audit.Event{Principal: "alice", Cluster: "Default", Kind: "Namespace", Name: "apps", Action: audit.ActionSync})
+
+	res, err = c.ListAuditEvents(ctx, &api.ListAuditEventsRequest{Kind: "Namespace"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Events).To(BeEmpty())
+
+	authorizer := auth.NewPolicyAuthorizer()
+	g.Expect(authorizer.SetPolicies([]auth.Policy{
+		{Name: "admins", Groups: []string{"admins"}, Actions: []string{auth.ActionListAuditEvents}},
+	})).To(Succeed())
+
+	cfg.Authorizer = authorizer
+	c = makeServer(ctx, t, cfg)
+
+	adminCtx := metadata.AppendToOutgoingContext(context.Background(), MetadataUserKey, "carol", MetadataGroupsKey, "admins")
+
+	res, err = c.ListAuditEvents(adminCtx, &api.ListAuditEventsRequest{Kind: "Namespace"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Events).To(HaveLen(1))
+	g.Expect(res.Events[0].Name).To(Equal("apps"))
+}
+
+func TestListAuditEvents_Disabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	c := makeServer(ctx, t, makeServerConfig(t, fakeClient, ""))
+
+	_, err = c.ListAuditEvents(ctx, &api.ListAuditEventsRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+}
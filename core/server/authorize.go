@@ -0,0 +1,26 @@
+package server
+
+import (
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+// authorize checks the principal may take the action, if actions are gated
+// by more than impersonation. An empty cluster or namespace is all of them.
+func (cs *coreServer) authorize(principal *auth.UserPrincipal, action, clusterName, namespace string) error {
+	if cs.authorizer == nil {
+		return nil
+	}
+
+	return cs.authorizer.Authorize(principal, action, clusterName, namespace)
+}
+
+// authorizeObject checks the principal may take the action on the object.
+func (cs *coreServer) authorizeObject(principal *auth.UserPrincipal, action string, ref *pb.ObjectRef) error {
+	clusterName := ref.ClusterName
+	if clusterName == "" {
+		clusterName = DefaultCluster
+	}
+
+	return cs.authorize(principal, action, clusterName, ref.Namespace)
+}
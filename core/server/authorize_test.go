@@ -0,0 +1,145 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/audit"
+	api "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func TestPolicies(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	teamNs := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	infraNs := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "infra"}}
+	repo := &sourcev1.GitRepository{ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: teamNs.Name}}
+	apps := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: teamNs.Name},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: repo.Name},
+		},
+	}
+	// A dependent the team isn't allowed to suspend
+	infra := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: infraNs.Name},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: "other"},
+			DependsOn: []meta.NamespacedObjectReference{{Name: apps.Name, Namespace: teamNs.Name}},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(teamNs, infraNs, repo, apps, infra).Build()
+
+	authorizer := auth.NewPolicyAuthorizer()
+	g.Expect(authorizer.SetPolicies([]auth.Policy{{
+		Name:       "team-a",
+		Groups:     []string{"team-a"},
+		Actions:    []string{auth.ActionToggleSuspendResource},
+		Namespaces: []string{teamNs.Name},
+	}})).To(Succeed())
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	cfg.Authorizer = authorizer
+	cfg.AuditLog = audit.NewLog(logr.Discard(), audit.DefaultMaxEvents)
+	c := makeServer(ctx, t, cfg)
+
+	ctx = metadata.AppendToOutgoingContext(ctx, MetadataUserKey, "alice", MetadataGroupsKey, "team-a")
+
+	appsRef := &api.ObjectRef{Kind: kustomizev1.KustomizationKind, Name: apps.Name, Namespace: teamNs.Name, ClusterName: "Default"}
+
+	syncRes, err := c.SyncFluxObject(ctx, &api.SyncFluxObjectRequest{Objects: []*api.ObjectRef{appsRef}})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(syncRes.Results).To(HaveLen(1))
+	g.Expect(syncRes.Results[0].Status).To(Equal("failed"))
+	g.Expect(syncRes.Results[0].Error).To(ContainSubstring("not allowed"))
+
+	// A denied object only fails its own result
+	res, err := c.ToggleSuspendResource(ctx, &api.ToggleSuspendResourceRequest{
+		Objects: []*api.ObjectRef{
+			{Kind: kustomizev1.KustomizationKind, Name: infra.Name, Namespace: infraNs.Name, ClusterName: "Default"},
+			appsRef,
+		},
+		Suspend: true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Results).To(HaveLen(2))
+	g.Expect(res.Results[0].Status).To(Equal("failed"))
+	g.Expect(res.Results[0].Error).To(ContainSubstring("not allowed"))
+	g.Expect(res.Results[1].Status).To(Equal("succeeded"), res.Results[1].Error)
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+	g.Expect(infra.Spec.Suspend).To(BeFalse())
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(apps), apps)).To(Succeed())
+	g.Expect(apps.Spec.Suspend).To(BeTrue())
+
+	res, err = c.ToggleSuspendResource(ctx, &api.ToggleSuspendResourceRequest{
+		Objects: []*api.ObjectRef{appsRef},
+		Suspend: true,
+		Cascade: true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Results).To(HaveLen(2))
+	g.Expect(res.Results[0].Status).To(Equal("succeeded"), res.Results[0].Error)
+	g.Expect(res.Results[1].Object.Name).To(Equal(infra.Name))
+	g.Expect(res.Results[1].Status).To(Equal("failed"))
+	g.Expect(res.Results[1].Error).To(ContainSubstring("not allowed"))
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(infra), infra)).To(Succeed())
+	g.Expect(infra.Spec.Suspend).To(BeFalse())
+
+	_, err = c.GetSessionLogs(ctx, &api.GetSessionLogsRequest{SessionNamespace: teamNs.Name, SessionId: "run-1"})
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+	_, err = c.ListAuditEvents(ctx, &api.ListAuditEventsRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+	// The source synced with an object must be allowed too
+	cross := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "cross", Namespace: teamNs.Name},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: "infra", Namespace: infraNs.Name},
+		},
+	}
+	g.Expect(fakeClient.Create(ctx, cross)).To(Succeed())
+
+	g.Expect(authorizer.SetPolicies([]auth.Policy{{
+		Name:       "team-a",
+		Groups:     []string{"team-a"},
+		Actions:    []string{auth.ActionSyncFluxObject},
+		Namespaces: []string{teamNs.Name},
+	}})).To(Succeed())
+
+	syncRes, err = c.SyncFluxObject(ctx, &api.SyncFluxObjectRequest{
+		Objects:    []*api.ObjectRef{{Kind: kustomizev1.KustomizationKind, Name: cross.Name, Namespace: teamNs.Name, ClusterName: "Default"}},
+		WithSource: true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(syncRes.Results).To(HaveLen(1))
+	g.Expect(syncRes.Results[0].Status).To(Equal("failed"))
+	g.Expect(syncRes.Results[0].Error).To(ContainSubstring("not allowed"))
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cross), cross)).To(Succeed())
+	g.Expect(cross.GetAnnotations()).NotTo(HaveKey(meta.ReconcileRequestAnnotation))
+}
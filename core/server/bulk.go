@@ -0,0 +1,186 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+const (
+	// bulkConcurrency is how many objects a bulk operation works on at once.
+	bulkConcurrency = 5
+
+	objectResultSucceeded = "succeeded"
+	objectResultFailed    = "failed"
+)
+
+// bulkObject is an object of a bulk operation, and whether it was only
+// included because it depends on one of the requested objects.
+type bulkObject struct {
+	ref       *pb.ObjectRef
+	dependent bool
+}
+
+// runBulk calls fn for each wave of objects in turn, working on at most
+// bulkConcurrency objects of a wave at once. An error only fails the result
+// of its object. The results are in the same order as the objects.
+func runBulk(ctx context.Context, waves [][]bulkObject, fn func(ctx context.Context, ref *pb.ObjectRef) (string, error)) []*pb.ObjectResult {
+	results := []*pb.ObjectResult{}
+
+	for _, wave := range waves {
+		waveResults := make([]*pb.ObjectResult, len(wave))
+		sem := make(chan struct{}, bulkConcurrency)
+
+		var wg sync.WaitGroup
+
+		for i, obj := range wave {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				result := &pb.ObjectResult{
+					Object:    obj.ref,
+					Status:    objectResultSucceeded,
+					Dependent: obj.dependent,
+				}
+
+				revision, err := fn(ctx, obj.ref)
+				if err != nil {
+					result.Status = objectResultFailed
+					result.Error = err.Error()
+				}
+
+				result.Revision = revision
+				waveResults[i] = result
+			}()
+		}
+
+		wg.Wait()
+
+		results = append(results, waveResults...)
+	}
+
+	return results
+}
+
+// bulkWaves returns the requested objects, without duplicates, as the
+// first wave. With cascade, each following wave holds the objects that
+// directly depend on, or use as their source, an object of the previous
+// wave, so dependents are only handled once what they depend on is.
+func (cs *coreServer) bulkWaves(ctx context.Context, clustersClient clustersmngr.Client, objects []*pb.ObjectRef, cascade bool) [][]bulkObject {
+	seen := map[string]bool{}
+	selected := []bulkObject{}
+
+	for _, ref := range objects {
+		if ref.ClusterName == "" {
+			ref.ClusterName = DefaultCluster
+		}
+
+		key := bulkKey(ref.ClusterName, dependencyRef{kind: ref.Kind, namespace: ref.Namespace, name: ref.Name}.id())
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		selected = append(selected, bulkObject{ref: ref})
+	}
+
+	waves := [][]bulkObject{selected}
+	if !cascade {
+		return waves
+	}
+
+	dependents := map[string]map[string][]*pb.DependencyNode{}
+
+	for _, obj := range selected {
+		clusterName := obj.ref.ClusterName
+		if _, ok := dependents[clusterName]; ok {
+			continue
+		}
+
+		graph, listErrors, err := cs.listDependencyGraph(ctx, clustersClient, clusterName, "")
+		if err != nil {
+			cs.logger.Error(err, "listing dependents", "cluster", clusterName)
+			dependents[clusterName] = nil
+
+			continue
+		}
+
+		for _, e := range listErrors {
+			cs.logger.Info("listing dependents", "cluster", e.ClusterName, "namespace", e.Namespace, "error", e.Message)
+		}
+
+		dependents[clusterName] = map[string][]*pb.DependencyNode{}
+
+		for _, e := range graph.sortedEdges() {
+			if node, ok := graph.nodes[e.From]; ok {
+				dependents[clusterName][e.To] = append(dependents[clusterName][e.To], node)
+			}
+		}
+	}
+
+	for previous := selected; len(previous) > 0; {
+		next := []bulkObject{}
+
+		for _, obj := range previous {
+			id := dependencyRef{kind: obj.ref.Kind, namespace: obj.ref.Namespace, name: obj.ref.Name}.id()
+
+			for _, node := range dependents[obj.ref.ClusterName][id] {
+				key := bulkKey(node.ClusterName, node.Id)
+				if seen[key] {
+					continue
+				}
+
+				seen[key] = true
+				next = append(next, bulkObject{
+					ref: &pb.ObjectRef{
+						Kind:        node.Kind,
+						Name:        node.Name,
+						Namespace:   node.Namespace,
+						ClusterName: node.ClusterName,
+					},
+					dependent: true,
+				})
+			}
+		}
+
+		if len(next) > 0 {
+			waves = append(waves, next)
+		}
+
+		previous = next
+	}
+
+	return waves
+}
+
+func bulkKey(clusterName, id string) string {
+	return fmt.Sprintf("%s/%s", clusterName, id)
+}
+
+// lastRevision returns the revision an automation last applied, or the
+// revision of a source's artifact.
+func lastRevision(obj runtime.Object) string {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return ""
+	}
+
+	if revision, _, _ := unstructured.NestedString(content, "status", "lastAppliedRevision"); revision != "" {
+		return revision
+	}
+
+	revision, _, _ := unstructured.NestedString(content, "status", "artifact", "revision")
+
+	return revision
+}
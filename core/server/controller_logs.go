@@ -0,0 +1,318 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	coretypes "github.com/weaveworks/weave-gitops/core/server/types"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/compositehash"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+const (
+	// defaultControllerLogsSince is how far back the logs are read when the
+	// request doesn't say.
+	defaultControllerLogsSince = time.Hour
+	// maxControllerLogLineSize is the longest log line that's read, as the
+	// controllers log the errors of kustomize build and Helm on one line.
+	maxControllerLogLineSize = 1 << 20
+)
+
+// GetControllerLogs streams the entries about an object from the logs of the
+// Flux controllers. The user must be able to read the object, but not the
+// controllers: they're found and their logs are read with the server's
+// permissions, on the object's cluster only, and only the entries about the
+// object are sent.
+func (cs *coreServer) GetControllerLogs(msg *pb.GetControllerLogsRequest, stream pb.Core_GetControllerLogsServer) error {
+	ctx := stream.Context()
+	principal := auth.Principal(ctx)
+
+	if !cs.controllerLogs {
+		return status.Error(codes.FailedPrecondition, "controller logs are not enabled")
+	}
+
+	if msg.ClusterName == "" {
+		msg.ClusterName = DefaultCluster
+	}
+
+	if msg.Kind == "" || msg.Name == "" {
+		return status.Error(codes.InvalidArgument, "kind and name are required")
+	}
+
+	gvk, err := cs.primaryKinds.Lookup(msg.Kind)
+	if err != nil {
+		return err
+	}
+
+	ref := &pb.ObjectRef{
+		Kind:        gvk.Kind,
+		Name:        msg.Name,
+		Namespace:   msg.Namespace,
+		ClusterName: msg.ClusterName,
+	}
+
+	if err := cs.authorizeObject(principal, auth.ActionGetControllerLogs, ref); err != nil {
+		return err
+	}
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClientForCluster(ctx, principal, msg.ClusterName)
+	if err != nil {
+		return fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(*gvk)
+
+	if err := clustersClient.Get(ctx, msg.ClusterName, client.ObjectKey{Name: msg.Name, Namespace: msg.Namespace}, obj); err != nil {
+		return wrapK8sAPIError("get object", err)
+	}
+
+	clientset, err := cs.serverClientset(msg.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	var mu sync.Mutex
+
+	send := func(res *pb.GetControllerLogsResponse) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return stream.Send(res)
+	}
+
+	fluxNamespaces := filterFluxNamespace(cs.clustersManager.GetClustersNamespaces()[msg.ClusterName])
+	if len(fluxNamespaces) == 0 {
+		return send(&pb.GetControllerLogsResponse{Error: &pb.ListError{ClusterName: msg.ClusterName, Message: ErrFluxNamespaceNotFound.Error()}})
+	}
+
+	logOptions := &corev1.PodLogOptions{Follow: msg.Follow, Timestamps: true}
+
+	sinceSeconds := int64(defaultControllerLogsSince.Seconds())
+	if msg.SinceSeconds > 0 {
+		sinceSeconds = msg.SinceSeconds
+	}
+
+	logOptions.SinceSeconds = &sinceSeconds
+
+	// Without following, the entries of all the controllers are sorted by
+	// time before they're sent
+	var entries []*pb.LogEntry
+
+	onEntry := func(entry *pb.LogEntry) error {
+		if msg.Follow {
+			return send(&pb.GetControllerLogsResponse{Entry: entry})
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		entries = append(entries, entry)
+
+		return nil
+	}
+
+	var wg sync.WaitGroup
+
+	for _, ns := range fluxNamespaces {
+		deployments, err := clientset.AppsV1().Deployments(ns.Name).List(ctx, metav1.ListOptions{LabelSelector: coretypes.PartOfLabel + "=" + Flux})
+		if err != nil {
+			if err := send(&pb.GetControllerLogsResponse{Error: &pb.ListError{ClusterName: msg.ClusterName, Namespace: ns.Name, Message: fmt.Sprintf("%s, %s", ErrListingDeployments.Error(), err)}}); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		for _, d := range deployments.Items {
+			pods, err := controllerPods(ctx, clientset, d)
+			if err != nil {
+				if err := send(&pb.GetControllerLogsResponse{Error: &pb.ListError{ClusterName: msg.ClusterName, Namespace: d.Namespace, Message: err.Error()}}); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			for _, pod := range pods {
+				wg.Add(1)
+
+				go func(pod corev1.Pod, source string) {
+					defer wg.Done()
+
+					if err := readControllerLogs(ctx, clientset, pod, logOptions, ref, source, msg.LogLevelFilter, onEntry); err != nil && ctx.Err() == nil {
+						_ = send(&pb.GetControllerLogsResponse{Error: &pb.ListError{ClusterName: msg.ClusterName, Namespace: pod.Namespace, Message: err.Error()}})
+					}
+				}(pod, d.Namespace+"/"+d.Name)
+			}
+		}
+	}
+
+	wg.Wait()
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		tsi, _ := strconv.ParseInt(entries[i].SortingKey, 10, 64)
+		tsj, _ := strconv.ParseInt(entries[j].SortingKey, 10, 64)
+
+		return tsi < tsj
+	})
+
+	for _, entry := range entries {
+		if err := send(&pb.GetControllerLogsResponse{Entry: entry}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// serverClientset returns the clientset of the cluster with the server's
+// permissions.
+func (cs *coreServer) serverClientset(clusterName string) (kubernetes.Interface, error) {
+	for _, cl := range cs.clustersManager.GetClusters() {
+		if cl.GetName() == clusterName {
+			clientset, err := cl.GetServerClientset()
+			if err != nil {
+				return nil, fmt.Errorf("error creating clientset for cluster %s: %w", clusterName, err)
+			}
+
+			return clientset, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "cluster not found: %s", clusterName)
+}
+
+// controllerPods returns the running pods of the controller's deployment.
+func controllerPods(ctx context.Context, clientset kubernetes.Interface, d appsv1.Deployment) ([]corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(d.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector of deployment %s: %w", d.Name, err)
+	}
+
+	pods, err := clientset.CoreV1().Pods(d.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the pods of deployment %s: %w", d.Name, err)
+	}
+
+	running := []corev1.Pod{}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodRunning && len(pod.Spec.Containers) > 0 {
+			running = append(running, pod)
+		}
+	}
+
+	return running, nil
+}
+
+// readControllerLogs reads the logs of the controller's pod, and calls
+// onEntry with the entries about the object.
+func readControllerLogs(ctx context.Context, clientset kubernetes.Interface, pod corev1.Pod, opts *corev1.PodLogOptions, ref *pb.ObjectRef, source, levelFilter string, onEntry func(*pb.LogEntry) error) error {
+	podOptions := opts.DeepCopy()
+	// The Flux controllers have a single container, but sidecars may be
+	// injected after it
+	podOptions.Container = pod.Spec.Containers[0].Name
+
+	logs, err := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, podOptions).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read the logs of pod %s: %w", pod.Name, err)
+	}
+	defer logs.Close()
+
+	scanner := bufio.NewScanner(logs)
+	scanner.Buffer(make([]byte, 64*1024), maxControllerLogLineSize)
+
+	for scanner.Scan() {
+		entry, ok := parseControllerLog(scanner.Text(), ref)
+		if !ok {
+			continue
+		}
+
+		if levelFilter != "" && !strings.Contains(entry.Level, levelFilter) {
+			continue
+		}
+
+		entry.Source = source
+
+		if err := onEntry(entry); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// parseControllerLog parses a line of the JSON logs of a Flux controller,
+// prefixed with its timestamp, and returns it if it's about the object.
+func parseControllerLog(line string, ref *pb.ObjectRef) (*pb.LogEntry, bool) {
+	prefix, payload, _ := strings.Cut(line, " ")
+
+	timestamp, err := time.Parse(time.RFC3339Nano, prefix)
+	if err != nil {
+		return nil, false
+	}
+
+	fields := map[string]any{}
+	if err := json.Unmarshal([]byte(payload), &fields); err != nil {
+		return nil, false
+	}
+
+	if !mentionsObject(fields, ref) {
+		return nil, false
+	}
+
+	message, _ := fields["msg"].(string)
+	if errMessage, ok := fields["error"].(string); ok && errMessage != "" {
+		message = message + ": " + errMessage
+	}
+
+	level, _ := fields["level"].(string)
+	if level == "" {
+		level = message
+	}
+
+	hash, err := compositehash.New(message, timestamp)
+	if err != nil {
+		return nil, false
+	}
+
+	return &pb.LogEntry{
+		SortingKey: strconv.FormatInt(hash, 10),
+		Timestamp:  timestamp.Format(time.RFC3339),
+		Level:      detectLogLevel(level),
+		Message:    message,
+	}, true
+}
+
+// mentionsObject returns whether the log entry is about the object.
+// controller-runtime logs the object being reconciled under its kind, with
+// its name and namespace also at the top level.
+func mentionsObject(fields map[string]any, ref *pb.ObjectRef) bool {
+	if obj, ok := fields[ref.Kind].(map[string]any); ok {
+		return obj["name"] == ref.Name && obj["namespace"] == ref.Namespace
+	}
+
+	controllerKind, _ := fields["controllerKind"].(string)
+
+	return strings.EqualFold(controllerKind, ref.Kind) &&
+		fields["name"] == ref.Name &&
+		fields["namespace"] == ref.Namespace
+}
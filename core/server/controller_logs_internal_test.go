@@ -0,0 +1,81 @@
+package server
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+func TestParseControllerLog(t *testing.T) {
+	ref := &pb.ObjectRef{Kind: "Kustomization", Name: "apps", Namespace: "flux-system"}
+
+	tests := []struct {
+		name     string
+		line     string
+		expected *pb.LogEntry
+	}{
+		{
+			name: "object logged under its kind",
+			line: `2024-05-02T10:00:00.123456789Z {"level":"error","ts":"2024-05-02T10:00:00.123Z","msg":"Reconciler error","controller":"kustomization","controllerKind":"Kustomization","Kustomization":{"name":"apps","namespace":"flux-system"},"namespace":"flux-system","name":"apps","error":"kustomize build failed"}`,
+			expected: &pb.LogEntry{
+				Timestamp: "2024-05-02T10:00:00Z",
+				Level:     "error",
+				Message:   "Reconciler error: kustomize build failed",
+			},
+		},
+		{
+			name: "object logged at the top level",
+			line: `2024-05-02T10:00:01Z {"level":"info","msg":"server-side apply completed","controllerKind":"Kustomization","namespace":"flux-system","name":"apps"}`,
+			expected: &pb.LogEntry{
+				Timestamp: "2024-05-02T10:00:01Z",
+				Level:     "info",
+				Message:   "server-side apply completed",
+			},
+		},
+		{
+			name: "level detected from the message",
+			line: `2024-05-02T10:00:02Z {"msg":"health check warning","Kustomization":{"name":"apps","namespace":"flux-system"}}`,
+			expected: &pb.LogEntry{
+				Timestamp: "2024-05-02T10:00:02Z",
+				Level:     "warning",
+				Message:   "health check warning",
+			},
+		},
+		{
+			name: "another object",
+			line: `2024-05-02T10:00:03Z {"level":"info","msg":"Reconciliation finished","Kustomization":{"name":"infra","namespace":"flux-system"}}`,
+		},
+		{
+			name: "another kind with the same name",
+			line: `2024-05-02T10:00:04Z {"level":"info","msg":"stored artifact","controllerKind":"GitRepository","namespace":"flux-system","name":"apps"}`,
+		},
+		{
+			name: "not JSON",
+			line: `2024-05-02T10:00:05Z starting manager`,
+		},
+		{
+			name: "no timestamp",
+			line: `{"level":"info","msg":"Reconciliation finished","Kustomization":{"name":"apps","namespace":"flux-system"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			entry, ok := parseControllerLog(tt.line, ref)
+			if tt.expected == nil {
+				g.Expect(ok).To(BeFalse())
+				return
+			}
+
+			g.Expect(ok).To(BeTrue())
+			g.Expect(entry.Timestamp).To(Equal(tt.expected.Timestamp))
+			g.Expect(entry.Level).To(Equal(tt.expected.Level))
+			g.Expect(entry.Message).To(Equal(tt.expected.Message))
+			g.Expect(entry.SortingKey).NotTo(BeEmpty())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package server_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestGetControllerLogs(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "apps"}}
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: ns.Name},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns, kust).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	disabled := makeServer(ctx, t, cfg)
+
+	cfg.ControllerLogs = true
+	c := makeServer(ctx, t, cfg)
+
+	userCtx := metadata.AppendToOutgoingContext(ctx, MetadataUserKey, "bob@example.com")
+
+	recvAll := func(c pb.CoreClient, req *pb.GetControllerLogsRequest) ([]*pb.GetControllerLogsResponse, error) {
+		stream, err := c.GetControllerLogs(userCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		responses := []*pb.GetControllerLogsResponse{}
+
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				return responses, nil
+			} else if err != nil {
+				return responses, err
+			}
+
+			responses = append(responses, res)
+		}
+	}
+
+	_, err = recvAll(disabled, &pb.GetControllerLogsRequest{Kind: kustomizev1.KustomizationKind, Namespace: ns.Name, Name: kust.Name})
+	g.Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+	// There's no Flux namespace, so no controllers to read the logs of
+	responses, err := recvAll(c, &pb.GetControllerLogsRequest{Kind: kustomizev1.KustomizationKind, Namespace: ns.Name, Name: kust.Name})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(responses).To(HaveLen(1))
+	g.Expect(responses[0].Entry).To(BeNil())
+	g.Expect(responses[0].Error.Message).To(ContainSubstring("could not find flux namespace"))
+
+	_, err = recvAll(c, &pb.GetControllerLogsRequest{Kind: kustomizev1.KustomizationKind, Namespace: ns.Name, Name: "missing"})
+	g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+	_, err = recvAll(c, &pb.GetControllerLogsRequest{Namespace: ns.Name, Name: kust.Name})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
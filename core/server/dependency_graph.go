@@ -0,0 +1,406 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1b2 "github.com/fluxcd/source-controller/api/v1beta2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/fluxsync"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+const (
+	dependencyEdgeSourceRef = "sourceRef"
+	dependencyEdgeDependsOn = "dependsOn"
+)
+
+// dependencyGraphKinds are the kinds listed to build the dependency graph.
+// Other kinds, e.g. HelmCharts, are only included when they are referenced.
+var dependencyGraphKinds = []string{
+	kustomizev1.KustomizationKind,
+	helmv2.HelmReleaseKind,
+	sourcev1.GitRepositoryKind,
+	sourcev1b2.OCIRepositoryKind,
+	sourcev1.BucketKind,
+	sourcev1.HelmRepositoryKind,
+}
+
+func (cs *coreServer) GetDependencyGraph(ctx context.Context, msg *pb.GetDependencyGraphRequest) (*pb.GetDependencyGraphResponse, error) {
+	if msg.ClusterName == "" {
+		msg.ClusterName = DefaultCluster
+	}
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClientForCluster(ctx, auth.Principal(ctx), msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	graph, respErrors, err := cs.listDependencyGraph(ctx, clustersClient, msg.ClusterName, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// The objects that are referenced but weren't listed are either in
+	// another namespace, or don't exist.
+	for _, ref := range graph.unresolved() {
+		node := ref.node(msg.ClusterName)
+
+		gvk, err := cs.primaryKinds.Lookup(ref.kind)
+		if err != nil {
+			node.Missing = true
+			node.Message = fmt.Sprintf("unknown kind %s", ref.kind)
+			graph.nodes[node.Id] = node
+
+			continue
+		}
+
+		obj := unstructured.Unstructured{}
+		obj.SetGroupVersionKind(*gvk)
+
+		err = clustersClient.Get(ctx, msg.ClusterName, client.ObjectKey{Namespace: ref.namespace, Name: ref.name}, &obj)
+
+		switch {
+		case err == nil:
+			graph.addNode(&obj)
+		case apierrors.IsNotFound(err):
+			node.Missing = true
+			node.Message = fmt.Sprintf("%s not found", node.Id)
+			graph.nodes[node.Id] = node
+		default:
+			node.Message = err.Error()
+			graph.nodes[node.Id] = node
+		}
+	}
+
+	return &pb.GetDependencyGraphResponse{
+		Nodes:  graph.sortedNodes(),
+		Edges:  graph.sortedEdges(),
+		Cycles: graph.cycles(),
+		Errors: respErrors,
+	}, nil
+}
+
+// listDependencyGraph builds the graph of the objects in the namespace,
+// all namespaces if empty, that the user can list.
+func (cs *coreServer) listDependencyGraph(ctx context.Context, clustersClient clustersmngr.Client, clusterName, namespace string) (*dependencyGraph, []*pb.ListError, error) {
+	respErrors := []*pb.ListError{}
+	graph := newDependencyGraph(clusterName)
+
+	for _, kind := range dependencyGraphKinds {
+		gvk, err := cs.primaryKinds.Lookup(kind)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		clist := clustersmngr.NewClusteredList(func() client.ObjectList {
+			list := unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(*gvk)
+			return &list
+		})
+
+		if err := clustersClient.ClusteredList(ctx, clist, true, client.InNamespace(namespace)); err != nil {
+			var errs clustersmngr.ClusteredListError
+			if !errors.As(err, &errs) {
+				return nil, nil, err
+			}
+
+			for _, e := range errs.Errors {
+				respErrors = append(respErrors, &pb.ListError{ClusterName: e.Cluster, Namespace: e.Namespace, Message: e.Err.Error()})
+			}
+		}
+
+		for _, lists := range clist.Lists() {
+			for _, l := range lists {
+				list, ok := l.(*unstructured.UnstructuredList)
+				if !ok {
+					continue
+				}
+
+				for i := range list.Items {
+					if err := graph.addObject(&list.Items[i]); err != nil {
+						respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Namespace: list.Items[i].GetNamespace(), Message: err.Error()})
+					}
+				}
+			}
+		}
+	}
+
+	return graph, respErrors, nil
+}
+
+type dependencyRef struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+func (r dependencyRef) id() string {
+	return fmt.Sprintf("%s/%s/%s", r.kind, r.namespace, r.name)
+}
+
+func (r dependencyRef) node(clusterName string) *pb.DependencyNode {
+	return &pb.DependencyNode{
+		Id:          r.id(),
+		Kind:        r.kind,
+		Namespace:   r.namespace,
+		Name:        r.name,
+		ClusterName: clusterName,
+		Ready:       string(metav1.ConditionUnknown),
+	}
+}
+
+type dependencyGraph struct {
+	clusterName string
+	nodes       map[string]*pb.DependencyNode
+	edges       []*pb.DependencyEdge
+	refs        map[string]dependencyRef
+}
+
+func newDependencyGraph(clusterName string) *dependencyGraph {
+	return &dependencyGraph{
+		clusterName: clusterName,
+		nodes:       map[string]*pb.DependencyNode{},
+		refs:        map[string]dependencyRef{},
+	}
+}
+
+// addNode adds the object to the graph, without its edges.
+func (g *dependencyGraph) addNode(obj *unstructured.Unstructured) {
+	ref := dependencyRef{kind: obj.GetKind(), namespace: obj.GetNamespace(), name: obj.GetName()}
+	node := ref.node(g.clusterName)
+
+	if ready := apimeta.FindStatusCondition(fluxsync.UnstructuredAdapter{Unstructured: obj}.GetConditions(), meta.ReadyCondition); ready != nil {
+		node.Ready = string(ready.Status)
+		node.Reason = ready.Reason
+		node.Message = ready.Message
+	}
+
+	node.Suspended, _, _ = unstructured.NestedBool(obj.Object, "spec", "suspend")
+
+	g.nodes[node.Id] = node
+}
+
+// addObject adds the object to the graph with the edges to its source and
+// the objects it depends on.
+func (g *dependencyGraph) addObject(obj *unstructured.Unstructured) error {
+	g.addNode(obj)
+
+	from := dependencyRef{kind: obj.GetKind(), namespace: obj.GetNamespace(), name: obj.GetName()}
+
+	var (
+		source    fluxsync.SourceRef
+		dependsOn []meta.NamespacedObjectReference
+	)
+
+	switch obj.GetKind() {
+	case kustomizev1.KustomizationKind:
+		ks := &kustomizev1.Kustomization{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, ks); err != nil {
+			return fmt.Errorf("converting unstructured to kustomization: %w", err)
+		}
+
+		source = fluxsync.KustomizationAdapter{Kustomization: ks}.SourceRef()
+		dependsOn = ks.Spec.DependsOn
+	case helmv2.HelmReleaseKind:
+		hr := &helmv2.HelmRelease{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, hr); err != nil {
+			return fmt.Errorf("converting unstructured to helmrelease: %w", err)
+		}
+
+		if hr.Spec.ChartRef != nil {
+			source = chartRef{hr.Spec.ChartRef}
+		} else if hr.Spec.Chart != nil {
+			source = fluxsync.HelmReleaseAdapter{HelmRelease: hr}.SourceRef()
+		}
+
+		dependsOn = hr.Spec.DependsOn
+	default:
+		return nil
+	}
+
+	if source != nil {
+		g.addEdge(from, dependencyRef{kind: source.Kind(), namespace: defaultNamespace(source.Namespace(), from.namespace), name: source.Name()}, dependencyEdgeSourceRef)
+	}
+
+	for _, dep := range dependsOn {
+		g.addEdge(from, dependencyRef{kind: from.kind, namespace: defaultNamespace(dep.Namespace, from.namespace), name: dep.Name}, dependencyEdgeDependsOn)
+	}
+
+	return nil
+}
+
+func (g *dependencyGraph) addEdge(from, to dependencyRef, edgeType string) {
+	g.refs[to.id()] = to
+	g.edges = append(g.edges, &pb.DependencyEdge{From: from.id(), To: to.id(), Type: edgeType})
+}
+
+// unresolved returns the references to objects that aren't in the graph.
+func (g *dependencyGraph) unresolved() []dependencyRef {
+	refs := []dependencyRef{}
+
+	for id, ref := range g.refs {
+		if _, ok := g.nodes[id]; !ok {
+			refs = append(refs, ref)
+		}
+	}
+
+	sort.Slice(refs, func(i, j int) bool { return refs[i].id() < refs[j].id() })
+
+	return refs
+}
+
+func (g *dependencyGraph) sortedNodes() []*pb.DependencyNode {
+	nodes := []*pb.DependencyNode{}
+	for _, n := range g.nodes {
+		nodes = append(nodes, n)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Id < nodes[j].Id })
+
+	return nodes
+}
+
+func (g *dependencyGraph) sortedEdges() []*pb.DependencyEdge {
+	for _, e := range g.edges {
+		if n, ok := g.nodes[e.To]; !ok || n.Missing {
+			e.Dangling = true
+		}
+	}
+
+	sort.SliceStable(g.edges, func(i, j int) bool {
+		if g.edges[i].From != g.edges[j].From {
+			return g.edges[i].From < g.edges[j].From
+		}
+
+		return g.edges[i].To < g.edges[j].To
+	})
+
+	return g.edges
+}
+
+// cycles returns the cycles in the dependsOn edges. Each cycle is reported
+// once, starting from its lowest node id.
+func (g *dependencyGraph) cycles() []*pb.DependencyCycle {
+	adjacent := map[string][]string{}
+
+	for _, e := range g.edges {
+		if e.Type == dependencyEdgeDependsOn {
+			adjacent[e.From] = append(adjacent[e.From], e.To)
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := map[string]int{}
+	seen := map[string]bool{}
+	cycles := []*pb.DependencyCycle{}
+	path := []string{}
+
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = visiting
+		path = append(path, id)
+
+		for _, next := range adjacent[id] {
+			switch state[next] {
+			case unvisited:
+				visit(next)
+			case visiting:
+				// Found a back edge, the cycle is the path from next to here
+				start := 0
+				for i, p := range path {
+					if p == next {
+						start = i
+					}
+				}
+
+				cycle := canonicalCycle(path[start:])
+
+				key := fmt.Sprint(cycle)
+				if !seen[key] {
+					seen[key] = true
+					cycles = append(cycles, &pb.DependencyCycle{NodeIds: cycle})
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[id] = visited
+	}
+
+	ids := []string{}
+	for id := range adjacent {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if state[id] == unvisited {
+			visit(id)
+		}
+	}
+
+	return cycles
+}
+
+// canonicalCycle rotates the cycle to start from its lowest id.
+func canonicalCycle(cycle []string) []string {
+	lowest := 0
+
+	for i, id := range cycle {
+		if id < cycle[lowest] {
+			lowest = i
+		}
+	}
+
+	return append(append([]string{}, cycle[lowest:]...), cycle[:lowest]...)
+}
+
+func defaultNamespace(namespace, fallback string) string {
+	if namespace == "" {
+		return fallback
+	}
+
+	return namespace
+}
+
+// chartRef adapts a HelmRelease chartRef to a fluxsync.SourceRef.
+type chartRef struct {
+	*helmv2.CrossNamespaceSourceReference
+}
+
+func (r chartRef) APIVersion() string {
+	return r.CrossNamespaceSourceReference.APIVersion
+}
+
+func (r chartRef) Kind() string {
+	return r.CrossNamespaceSourceReference.Kind
+}
+
+func (r chartRef) Name() string {
+	return r.CrossNamespaceSourceReference.Name
+}
+
+func (r chartRef) Namespace() string {
+	return r.CrossNamespaceSourceReference.Namespace
+}
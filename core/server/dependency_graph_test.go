@@ -0,0 +1,115 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestGetDependencyGraph(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: ns.Name},
+		Status: sourcev1.GitRepositoryStatus{
+			Conditions: []metav1.Condition{{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, Reason: "Succeeded"}},
+		},
+	}
+
+	newKustomization := func(name string, dependsOn ...string) *kustomizev1.Kustomization {
+		ks := &kustomizev1.Kustomization{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns.Name},
+			Spec: kustomizev1.KustomizationSpec{
+				SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: repo.Name},
+			},
+			Status: kustomizev1.KustomizationStatus{
+				Conditions: []metav1.Condition{{Type: meta.ReadyCondition, Status: metav1.ConditionFalse, Reason: "DependencyNotReady"}},
+			},
+		}
+
+		for _, d := range dependsOn {
+			ks.Spec.DependsOn = append(ks.Spec.DependsOn, meta.NamespacedObjectReference{Name: d})
+		}
+
+		return ks
+	}
+
+	// a and b depend on each other, c depends on a Kustomization that doesn't exist
+	a := newKustomization("a", "b")
+	b := newKustomization("b", "a")
+	c := newKustomization("c", "missing")
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: ns.Name},
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart:     "podinfo",
+					SourceRef: helmv2.CrossNamespaceObjectReference{Kind: sourcev1.HelmRepositoryKind, Name: "podinfo", Namespace: "other"},
+				},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, repo, a, b, c, hr).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	client := makeServer(ctx, t, cfg)
+
+	res, err := client.GetDependencyGraph(ctx, &pb.GetDependencyGraphRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	nodes := map[string]*pb.DependencyNode{}
+	for _, n := range res.Nodes {
+		nodes[n.Id] = n
+	}
+
+	g.Expect(nodes).To(HaveLen(7))
+	g.Expect(nodes["GitRepository/flux-system/apps"].Ready).To(Equal("True"))
+	g.Expect(nodes["Kustomization/flux-system/a"].Reason).To(Equal("DependencyNotReady"))
+	g.Expect(nodes["Kustomization/flux-system/missing"].Missing).To(BeTrue())
+	g.Expect(nodes["HelmRepository/other/podinfo"].Missing).To(BeTrue())
+	g.Expect(nodes["HelmRelease/flux-system/podinfo"].Ready).To(Equal("Unknown"))
+
+	g.Expect(res.Edges).To(ContainElements(
+		SatisfyAll(
+			HaveField("From", "Kustomization/flux-system/a"),
+			HaveField("To", "GitRepository/flux-system/apps"),
+			HaveField("Type", "sourceRef"),
+			HaveField("Dangling", false),
+		),
+		SatisfyAll(
+			HaveField("From", "Kustomization/flux-system/c"),
+			HaveField("To", "Kustomization/flux-system/missing"),
+			HaveField("Type", "dependsOn"),
+			HaveField("Dangling", true),
+		),
+		SatisfyAll(
+			HaveField("From", "HelmRelease/flux-system/podinfo"),
+			HaveField("To", "HelmRepository/other/podinfo"),
+			HaveField("Dangling", true),
+		),
+	))
+
+	g.Expect(res.Cycles).To(HaveLen(1))
+	g.Expect(res.Cycles[0].NodeIds).To(Equal([]string{"Kustomization/flux-system/a", "Kustomization/flux-system/b"}))
+}
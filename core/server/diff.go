@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kustomizediff"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+var artifactHTTPClient = &http.Client{Timeout: time.Minute}
+
+func (cs *coreServer) DiffKustomization(ctx context.Context, msg *pb.DiffKustomizationRequest) (*pb.DiffKustomizationResponse, error) {
+	if msg.ClusterName == "" {
+		msg.ClusterName = DefaultCluster
+	}
+
+	if err := checkArtifactCluster(msg.ClusterName); err != nil {
+		return nil, err
+	}
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClientForCluster(ctx, auth.Principal(ctx), msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	c, err := clustersClient.Scoped(msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scoped client for cluster=%s: %w", msg.ClusterName, err)
+	}
+
+	ks := &kustomizev1.Kustomization{}
+	if err := c.Get(ctx, client.ObjectKey{Name: msg.Name, Namespace: msg.Namespace}, ks); err != nil {
+		return nil, wrapK8sAPIError("get kustomization", err)
+	}
+
+	artifact, err := kustomizediff.GetArtifact(ctx, c, ks)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+
+	dir, err := os.MkdirTemp("", "kustomization-diff-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := kustomizediff.FetchArtifact(ctx, artifactHTTPClient, artifact.URL, dir); err != nil {
+		return nil, err
+	}
+
+	objects, err := kustomizediff.Build(ks, dir)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+	}
+
+	diffs, err := kustomizediff.Diff(ctx, c, ks, objects)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.DiffKustomizationResponse{
+		Revision: artifact.Revision,
+		Diffs:    []*pb.ObjectDiff{},
+	}
+
+	for _, d := range diffs {
+		res.Diffs = append(res.Diffs, &pb.ObjectDiff{
+			Action:     string(d.Action),
+			ApiVersion: d.APIVersion,
+			Kind:       d.Kind,
+			Namespace:  d.Namespace,
+			Name:       d.Name,
+			Diff:       d.Diff,
+			Error:      d.Error,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,89 @@
+package server_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestDiffKustomization(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	// An artifact with no manifests, so everything in the inventory is pruned
+	var artifact bytes.Buffer
+
+	gz := gzip.NewWriter(&artifact)
+	tw := tar.NewWriter(gz)
+	g.Expect(tw.WriteHeader(&tar.Header{Name: "apps/", Typeflag: tar.TypeDir, Mode: 0o750})).To(Succeed())
+	g.Expect(tw.Close()).To(Succeed())
+	g.Expect(gz.Close()).To(Succeed())
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(artifact.Bytes())
+	}))
+	defer srv.Close()
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "flux-system"},
+	}
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: ns.Name},
+		Status: sourcev1.GitRepositoryStatus{
+			Artifact: &sourcev1.Artifact{URL: srv.URL + "/apps.tar.gz", Revision: "main@sha1:new"},
+		},
+	}
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: ns.Name},
+		Spec: kustomizev1.KustomizationSpec{
+			Path:      "./apps",
+			Prune:     true,
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: repo.Name},
+		},
+		Status: kustomizev1.KustomizationStatus{
+			Inventory: &kustomizev1.ResourceInventory{
+				Entries: []kustomizev1.ResourceRef{{ID: "flux-system_old-config__ConfigMap", Version: "v1"}},
+			},
+		},
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "old-config", Namespace: ns.Name},
+		Data:       map[string]string{"key": "value"},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, repo, kust, cm).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	c := makeServer(ctx, t, cfg)
+
+	res, err := c.DiffKustomization(ctx, &pb.DiffKustomizationRequest{
+		Name:      kust.Name,
+		Namespace: kust.Namespace,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Revision).To(Equal("main@sha1:new"))
+	g.Expect(res.Diffs).To(HaveLen(1))
+	g.Expect(res.Diffs[0].Action).To(Equal("pruned"))
+	g.Expect(res.Diffs[0].Kind).To(Equal("ConfigMap"))
+	g.Expect(res.Diffs[0].Name).To(Equal("old-config"))
+	g.Expect(res.Diffs[0].Diff).To(ContainSubstring("-  key: value"))
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/drift"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kustomizediff"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func (cs *coreServer) GetDrift(ctx context.Context, msg *pb.GetDriftRequest) (*pb.GetDriftResponse, error) {
+	if msg.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	if msg.Kind != kustomizev1.KustomizationKind && msg.Kind != helmv2.HelmReleaseKind {
+		return nil, status.Errorf(codes.InvalidArgument, "drift can only be detected for a %s or a %s, not a %q", kustomizev1.KustomizationKind, helmv2.HelmReleaseKind, msg.Kind)
+	}
+
+	if msg.ClusterName == "" {
+		msg.ClusterName = DefaultCluster
+	}
+
+	// The manifests of a Kustomization are built from its source's artifact
+	if msg.Kind == kustomizev1.KustomizationKind {
+		if err := checkArtifactCluster(msg.ClusterName); err != nil {
+			return nil, err
+		}
+	}
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClientForCluster(ctx, auth.Principal(ctx), msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	c, err := clustersClient.Scoped(msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scoped client for cluster=%s: %w", msg.ClusterName, err)
+	}
+
+	key := client.ObjectKey{Namespace: msg.Namespace, Name: msg.Name}
+
+	var report *drift.Report
+
+	switch msg.Kind {
+	case kustomizev1.KustomizationKind:
+		ks := &kustomizev1.Kustomization{}
+		if err := c.Get(ctx, key, ks); err != nil {
+			return nil, wrapK8sAPIError("get kustomization", err)
+		}
+
+		report, err = drift.Kustomization(ctx, c, ks, func(ctx context.Context, artifactURL, dir string) error {
+			return kustomizediff.FetchArtifact(ctx, artifactHTTPClient, artifactURL, dir)
+		})
+	case helmv2.HelmReleaseKind:
+		hr := &helmv2.HelmRelease{}
+		if err := c.Get(ctx, key, hr); err != nil {
+			return nil, wrapK8sAPIError("get helmrelease", err)
+		}
+
+		report, err = drift.HelmRelease(ctx, c, hr)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed detecting drift of %s %s/%s: %w", msg.Kind, msg.Namespace, msg.Name, err)
+	}
+
+	return driftReportToProto(report), nil
+}
+
+func driftReportToProto(report *drift.Report) *pb.GetDriftResponse {
+	res := &pb.GetDriftResponse{
+		Revision: report.Revision,
+		Checked:  int32(report.Checked),
+		Objects:  []*pb.ObjectDrift{},
+		Warnings: report.Warnings,
+	}
+
+	for _, o := range report.Objects {
+		d := &pb.ObjectDrift{
+			ApiVersion: o.APIVersion,
+			Kind:       o.Kind,
+			Namespace:  o.Namespace,
+			Name:       o.Name,
+			Deleted:    o.Deleted,
+			Fields:     []*pb.FieldDrift{},
+			Error:      o.Error,
+		}
+
+		for _, f := range o.Fields {
+			d.Fields = append(d.Fields, &pb.FieldDrift{Path: f.Path, Applied: f.Applied, Live: f.Live})
+		}
+
+		res.Objects = append(res.Objects, d)
+	}
+
+	return res
+}
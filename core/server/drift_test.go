@@ -0,0 +1,97 @@
+package server_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/helmstorage"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestGetDrift(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "flux-system"},
+		Status: helmv2.HelmReleaseStatus{
+			StorageNamespace: "flux-system",
+			History: helmv2.Snapshots{
+				{Name: "podinfo", Version: 1, Namespace: "apps", ChartVersion: "6.0.0"},
+			},
+		},
+	}
+
+	manifest := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: podinfo
+data:
+  color: blue
+`
+	storageData, err := json.Marshal(helmstorage.Release{Name: "podinfo", Manifest: manifest})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	storage := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "sh.helm.release.v1.podinfo.v1", Namespace: "flux-system"},
+		Data: map[string][]byte{
+			"release": []byte(base64.StdEncoding.EncodeToString(storageData)),
+		},
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "apps"},
+		Data:       map[string]string{"color": "red"},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme)).
+		WithObjects(hr, storage, cm).
+		Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	c := makeServer(ctx, t, cfg)
+
+	userCtx := metadata.AppendToOutgoingContext(ctx, MetadataUserKey, "bob@example.com")
+
+	res, err := c.GetDrift(userCtx, &pb.GetDriftRequest{
+		ClusterName: "Default",
+		Kind:        helmv2.HelmReleaseKind,
+		Namespace:   "flux-system",
+		Name:        "podinfo",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Revision).To(Equal("6.0.0"))
+	g.Expect(res.Checked).To(Equal(int32(1)))
+	g.Expect(res.Objects).To(HaveLen(1))
+	g.Expect(res.Objects[0].Kind).To(Equal("ConfigMap"))
+	g.Expect(res.Objects[0].Namespace).To(Equal("apps"))
+	g.Expect(res.Objects[0].Fields).To(HaveLen(1))
+	g.Expect(res.Objects[0].Fields[0].Path).To(Equal("data.color"))
+	g.Expect(res.Objects[0].Fields[0].Applied).To(Equal(`"blue"`))
+	g.Expect(res.Objects[0].Fields[0].Live).To(Equal(`"red"`))
+
+	_, err = c.GetDrift(userCtx, &pb.GetDriftRequest{ClusterName: "Default", Kind: "GitRepository", Namespace: "flux-system", Name: "podinfo"})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	_, err = c.GetDrift(userCtx, &pb.GetDriftRequest{ClusterName: "Default", Kind: helmv2.HelmReleaseKind, Namespace: "flux-system", Name: "missing"})
+	g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+}
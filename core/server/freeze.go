@@ -0,0 +1,312 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	imgautomationv1 "github.com/fluxcd/image-automation-controller/api/v1beta2"
+	reflectorv1 "github.com/fluxcd/image-reflector-controller/api/v1beta2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1b2 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/go-logr/logr"
+	"github.com/robfig/cron/v3"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/fluxsync"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+const freezeFrequency = time.Minute
+
+// freezeKinds are the kinds that can be suspended until a given time, or
+// during freeze windows.
+var freezeKinds = []schema.GroupVersionKind{
+	kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind),
+	helmv2.GroupVersion.WithKind(helmv2.HelmReleaseKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.GitRepositoryKind),
+	sourcev1b2.GroupVersion.WithKind(sourcev1b2.OCIRepositoryKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.BucketKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.HelmRepositoryKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.HelmChartKind),
+	reflectorv1.GroupVersion.WithKind(reflectorv1.ImageRepositoryKind),
+	imgautomationv1.GroupVersion.WithKind(imgautomationv1.ImageUpdateAutomationKind),
+}
+
+// suspendOptions are the validated options of a ToggleSuspendResource request.
+type suspendOptions struct {
+	suspend             bool
+	comment             string
+	until               time.Time
+	freezeSchedule      string
+	freezeDuration      string
+	clearFreezeSchedule bool
+}
+
+func newSuspendOptions(msg *pb.ToggleSuspendResourceRequest, now time.Time) (suspendOptions, error) {
+	opts := suspendOptions{
+		suspend:             msg.Suspend,
+		comment:             msg.Comment,
+		freezeSchedule:      msg.FreezeSchedule,
+		freezeDuration:      msg.FreezeDuration,
+		clearFreezeSchedule: msg.ClearFreezeSchedule,
+	}
+
+	if msg.Suspend && msg.ClearFreezeSchedule {
+		return opts, fmt.Errorf("clear_freeze_schedule can only be set when resuming")
+	}
+
+	if msg.SuspendUntil == "" && msg.FreezeSchedule == "" && msg.FreezeDuration == "" {
+		return opts, nil
+	}
+
+	if !msg.Suspend {
+		return opts, fmt.Errorf("suspend_until and freeze windows can only be set when suspending")
+	}
+
+	if msg.SuspendUntil != "" {
+		if msg.FreezeSchedule != "" {
+			return opts, fmt.Errorf("only one of suspend_until and freeze_schedule can be set")
+		}
+
+		until, err := time.Parse(time.RFC3339, msg.SuspendUntil)
+		if err != nil {
+			return opts, fmt.Errorf("invalid suspend_until: %w", err)
+		}
+
+		if !until.After(now) {
+			return opts, fmt.Errorf("suspend_until %s is in the past", msg.SuspendUntil)
+		}
+
+		opts.until = until
+
+		return opts, nil
+	}
+
+	if _, _, err := freezeWindow(msg.FreezeSchedule, msg.FreezeDuration, now); err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
+
+// freezeWindow reports whether a freeze window of the schedule is in
+// progress, and when it ends.
+func freezeWindow(schedule, duration string, now time.Time) (bool, time.Time, error) {
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid freeze schedule %q: %w", schedule, err)
+	}
+
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("invalid freeze duration %q: %w", duration, err)
+	}
+
+	if d <= 0 {
+		return false, time.Time{}, fmt.Errorf("freeze duration %q must be positive", duration)
+	}
+
+	// The first window starting after now-d is in progress if it has
+	// already started.
+	start := sched.Next(now.Add(-d))
+	if start.After(now) {
+		return false, time.Time{}, nil
+	}
+
+	return true, start.Add(d), nil
+}
+
+// applyFreeze resumes obj once the time it was suspended until has passed,
+// and suspends it when one of its freeze windows is in progress. It reports
+// whether obj was changed.
+func applyFreeze(obj fluxsync.Reconcilable, now time.Time) (bool, error) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	defer obj.SetAnnotations(annotations)
+
+	suspended := isSuspended(obj)
+	changed := false
+
+	if until, ok := annotations[SuspendedUntilAnnotation]; ok {
+		t, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return false, fmt.Errorf("invalid %s annotation: %w", SuspendedUntilAnnotation, err)
+		}
+
+		if !now.Before(t) {
+			if suspended {
+				if err := obj.SetSuspended(false); err != nil {
+					return false, err
+				}
+
+				suspended = false
+			}
+
+			delete(annotations, SuspendedUntilAnnotation)
+			delete(annotations, SuspendedByAnnotation)
+			delete(annotations, SuspendedCommentAnnotation)
+
+			changed = true
+		}
+	}
+
+	schedule := annotations[FreezeScheduleAnnotation]
+	if schedule == "" || suspended {
+		return changed, nil
+	}
+
+	active, end, err := freezeWindow(schedule, annotations[FreezeDurationAnnotation], now)
+	if err != nil || !active {
+		return changed, err
+	}
+
+	// The object was resumed during this window
+	if resumedUntil, ok := annotations[FreezeResumedUntilAnnotation]; ok {
+		if t, err := time.Parse(time.RFC3339, resumedUntil); err == nil && !end.After(t) {
+			return changed, nil
+		}
+	}
+
+	if err := obj.SetSuspended(true); err != nil {
+		return false, err
+	}
+
+	delete(annotations, FreezeResumedUntilAnnotation)
+	annotations[SuspendedByAnnotation] = annotations[FreezeScheduledByAnnotation]
+	annotations[SuspendedCommentAnnotation] = fmt.Sprintf("Freeze window until %s", end.Format(time.RFC3339))
+	annotations[SuspendedUntilAnnotation] = end.Format(time.RFC3339)
+
+	return true, nil
+}
+
+// resumeFreeze removes the freeze windows of an object being resumed if
+// clear is set. Otherwise, if one of its windows is in progress, it records
+// when that window ends so the object isn't suspended again until the next.
+func resumeFreeze(obj fluxsync.Reconcilable, clear bool, now time.Time) {
+	annotations := obj.GetAnnotations()
+
+	schedule, ok := annotations[FreezeScheduleAnnotation]
+	if !ok {
+		return
+	}
+
+	defer obj.SetAnnotations(annotations)
+
+	if clear {
+		delete(annotations, FreezeScheduleAnnotation)
+		delete(annotations, FreezeDurationAnnotation)
+		delete(annotations, FreezeScheduledByAnnotation)
+		delete(annotations, FreezeResumedUntilAnnotation)
+
+		return
+	}
+
+	// An invalid schedule doesn't prevent resuming, the scheduler reports it
+	if active, end, err := freezeWindow(schedule, annotations[FreezeDurationAnnotation], now); err == nil && active {
+		annotations[FreezeResumedUntilAnnotation] = end.UTC().Format(time.RFC3339)
+	}
+}
+
+func isSuspended(obj client.Object) bool {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return false
+	}
+
+	suspended, _, _ := unstructured.NestedBool(content, "spec", "suspend")
+
+	return suspended
+}
+
+// FreezeScheduler resumes the objects whose suspension has ended, and
+// suspends the objects whose freeze windows have started, on all clusters.
+type FreezeScheduler struct {
+	log             logr.Logger
+	clustersManager clustersmngr.ClustersManager
+}
+
+// NewFreezeScheduler returns a FreezeScheduler. The server's service account
+// needs to list and patch the freezeKinds.
+func NewFreezeScheduler(log logr.Logger, clustersManager clustersmngr.ClustersManager) *FreezeScheduler {
+	return &FreezeScheduler{
+		log:             log.WithName("freeze-windows"),
+		clustersManager: clustersManager,
+	}
+}
+
+// Start applies the freeze windows until the context is cancelled.
+func (f *FreezeScheduler) Start(ctx context.Context) {
+	_ = wait.PollUntilContextCancel(ctx, freezeFrequency, true, func(ctx context.Context) (bool, error) {
+		f.Apply(ctx, time.Now())
+
+		return false, nil
+	})
+}
+
+// Apply suspends and resumes the objects according to their freeze
+// windows at the given time.
+func (f *FreezeScheduler) Apply(ctx context.Context, now time.Time) {
+	c, err := f.clustersManager.GetServerClient(ctx)
+	if err != nil {
+		f.log.Error(err, "unable to get server client")
+
+		return
+	}
+
+	for clusterName, cl := range c.ClientsPool().Clients() {
+		for _, gvk := range freezeKinds {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk)
+
+			if err := cl.List(ctx, list); err != nil {
+				if !apimeta.IsNoMatchError(err) {
+					f.log.Error(err, "unable to list objects", "cluster", clusterName, "kind", gvk.Kind)
+				}
+
+				continue
+			}
+
+			for i := range list.Items {
+				obj := fluxsync.UnstructuredAdapter{Unstructured: &list.Items[i]}
+				log := f.log.WithValues("cluster", clusterName, "kind", gvk.Kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+				patch := client.MergeFrom(obj.DeepCopyClientObject())
+
+				changed, err := applyFreeze(obj, now)
+				if err != nil {
+					log.Error(err, "unable to apply freeze window")
+
+					continue
+				}
+
+				if !changed {
+					continue
+				}
+
+				if err := cl.Patch(ctx, obj.AsClientObject(), patch); err != nil {
+					log.Error(err, "unable to patch object")
+
+					continue
+				}
+
+				if isSuspended(obj) {
+					log.Info("Suspended resource for freeze window", "scheduledBy", obj.GetAnnotations()[FreezeScheduledByAnnotation])
+				} else {
+					log.Info("Resumed resource at end of suspension")
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/clustersmngrfakes"
+	"github.com/weaveworks/weave-gitops/core/server"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestFreezeScheduler(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	midnight := time.Date(2026, time.December, 24, 0, 0, 0, 0, time.UTC)
+
+	newKustomization := func(name string, suspend bool, annotations map[string]string) *kustomizev1.Kustomization {
+		return &kustomizev1.Kustomization{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "flux-system", Annotations: annotations},
+			Spec:       kustomizev1.KustomizationSpec{Suspend: suspend},
+		}
+	}
+
+	expired := newKustomization("expired", true, map[string]string{
+		server.SuspendedByAnnotation:    "anne",
+		server.SuspendedUntilAnnotation: midnight.Add(-time.Minute).Format(time.RFC3339),
+	})
+	frozen := newKustomization("frozen", false, map[string]string{
+		server.FreezeScheduleAnnotation:    "0 0 * * *",
+		server.FreezeDurationAnnotation:    "2h",
+		server.FreezeScheduledByAnnotation: "bob",
+	})
+	manual := newKustomization("manual", true, map[string]string{
+		server.SuspendedByAnnotation:    "anne",
+		server.FreezeScheduleAnnotation: "0 0 * * *",
+		server.FreezeDurationAnnotation: "2h",
+	})
+
+	resumed := newKustomization("resumed", false, map[string]string{
+		server.FreezeScheduleAnnotation:     "0 0 * * *",
+		server.FreezeDurationAnnotation:     "2h",
+		server.FreezeResumedUntilAnnotation: "2026-12-24T02:00:00Z",
+	})
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(expired, frozen, manual, resumed).Build()
+
+	cluster := &clusterfakes.FakeCluster{}
+	cluster.GetNameReturns("Default")
+
+	pool := clustersmngr.NewClustersClientsPool()
+	g.Expect(pool.Add(fakeClient, cluster)).To(Succeed())
+
+	clustersManager := &clustersmngrfakes.FakeClustersManager{}
+	clustersManager.GetServerClientReturns(clustersmngr.NewClient(pool, nil, logr.Discard()), nil)
+
+	scheduler := server.NewFreezeScheduler(logr.Discard(), clustersManager)
+
+	get := func(name string) *kustomizev1.Kustomization {
+		ks := &kustomizev1.Kustomization{}
+		g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "flux-system", Name: name}, ks)).To(Succeed())
+
+		return ks
+	}
+
+	// During the freeze window
+	scheduler.Apply(ctx, midnight.Add(time.Hour))
+
+	ks := get(expired.Name)
+	g.Expect(ks.Spec.Suspend).To(BeFalse())
+	g.Expect(ks.Annotations).NotTo(HaveKey(server.SuspendedByAnnotation))
+	g.Expect(ks.Annotations).NotTo(HaveKey(server.SuspendedUntilAnnotation))
+
+	ks = get(frozen.Name)
+	g.Expect(ks.Spec.Suspend).To(BeTrue())
+	g.Expect(ks.Annotations).To(HaveKeyWithValue(server.SuspendedByAnnotation, "bob"))
+	g.Expect(ks.Annotations).To(HaveKeyWithValue(server.SuspendedUntilAnnotation, "2026-12-24T02:00:00Z"))
+
+	// Objects resumed during the window stay resumed until its end
+	ks = get(resumed.Name)
+	g.Expect(ks.Spec.Suspend).To(BeFalse())
+
+	// After the freeze window
+	scheduler.Apply(ctx, midnight.Add(3*time.Hour))
+
+	ks = get(frozen.Name)
+	g.Expect(ks.Spec.Suspend).To(BeFalse())
+	g.Expect(ks.Annotations).NotTo(HaveKey(server.SuspendedByAnnotation))
+	g.Expect(ks.Annotations).To(HaveKeyWithValue(server.FreezeScheduleAnnotation, "0 0 * * *"))
+
+	// Manual suspensions aren't ended by freeze windows
+	ks = get(manual.Name)
+	g.Expect(ks.Spec.Suspend).To(BeTrue())
+	g.Expect(ks.Annotations).To(HaveKeyWithValue(server.SuspendedByAnnotation, "anne"))
+
+	// During the next freeze window
+	scheduler.Apply(ctx, midnight.Add(25*time.Hour))
+
+	ks = get(resumed.Name)
+	g.Expect(ks.Spec.Suspend).To(BeTrue())
+	g.Expect(ks.Annotations).NotTo(HaveKey(server.FreezeResumedUntilAnnotation))
+}
+
+func TestToggleSuspendResourceFreeze(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: ns.Name},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, kust).Build()
+
+	objects := []*pb.ObjectRef{{
+		Kind:        kustomizev1.KustomizationKind,
+		Name:        kust.Name,
+		Namespace:   kust.Namespace,
+		ClusterName: "Default",
+	}}
+	until := time.Now().Add(time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	c := makeServer(ctx, t, cfg)
+
+	_, err = c.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{Objects: objects, Suspend: true, SuspendUntil: until})
+	g.Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+	cfg.FreezeWindows = true
+	c = makeServer(ctx, t, cfg)
+
+	_, err = c.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{Objects: objects, Suspend: true, FreezeSchedule: "not a schedule", FreezeDuration: "1h"})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	_, err = c.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{Objects: objects, Suspend: false, SuspendUntil: until})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	res, err := c.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{Objects: objects, Suspend: true, SuspendUntil: until})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Results[0].Status).To(Equal("succeeded"), res.Results[0].Error)
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(kust), kust)).To(Succeed())
+	g.Expect(kust.Spec.Suspend).To(BeTrue())
+	g.Expect(kust.Annotations).To(HaveKeyWithValue(server.SuspendedUntilAnnotation, until))
+
+	// A schedule whose windows aren't in progress doesn't suspend
+	_, err = c.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{Objects: objects, Suspend: false})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	notNow := time.Now().Add(12 * time.Hour)
+	schedule := notNow.Format("4 15 * * *")
+
+	_, err = c.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{Objects: objects, Suspend: true, FreezeSchedule: schedule, FreezeDuration: "1h"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(kust), kust)).To(Succeed())
+	g.Expect(kust.Spec.Suspend).To(BeFalse())
+	g.Expect(kust.Annotations).To(HaveKeyWithValue(server.FreezeScheduleAnnotation, schedule))
+	g.Expect(kust.Annotations).To(HaveKey(server.FreezeScheduledByAnnotation))
+
+	// Resuming keeps the schedule
+	_, err = c.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{Objects: objects, Suspend: false})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(kust), kust)).To(Succeed())
+	g.Expect(kust.Annotations).To(HaveKeyWithValue(server.FreezeScheduleAnnotation, schedule))
+
+	// Resuming during a window records its end, so it isn't suspended again
+	// until the next one
+	_, err = c.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{Objects: objects, Suspend: true, FreezeSchedule: "* * * * *", FreezeDuration: "1h"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(kust), kust)).To(Succeed())
+	g.Expect(kust.Spec.Suspend).To(BeTrue())
+
+	_, err = c.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{Objects: objects, Suspend: false})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(kust), kust)).To(Succeed())
+	g.Expect(kust.Spec.Suspend).To(BeFalse())
+	g.Expect(kust.Annotations).To(HaveKey(server.FreezeResumedUntilAnnotation))
+
+	// The schedule is only removed when asked to
+	_, err = c.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{Objects: objects, Suspend: true, ClearFreezeSchedule: true})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	_, err = c.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{Objects: objects, Suspend: false, ClearFreezeSchedule: true})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(kust), kust)).To(Succeed())
+	g.Expect(kust.Annotations).NotTo(HaveKey(server.FreezeScheduleAnnotation))
+	g.Expect(kust.Annotations).NotTo(HaveKey(server.FreezeResumedUntilAnnotation))
+}
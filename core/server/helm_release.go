@@ -4,11 +4,22 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/weaveworks/weave-gitops/core/audit"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/helmstorage"
+	"github.com/weaveworks/weave-gitops/core/ownership"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 )
 
 func getHelmReleaseInventory(ctx context.Context, helmRelease helmv2.HelmRelease, c clustersmngr.Client, cluster string) ([]*pb.GroupVersionKind, error) {
@@ -17,7 +28,7 @@ func getHelmReleaseInventory(ctx context.Context, helmRelease helmv2.HelmRelease
 		return nil, fmt.Errorf("error getting scoped client for cluster=%s: %w", cluster, err)
 	}
 
-	objects, err := getHelmReleaseObjects(ctx, k8sClient, &helmRelease)
+	objects, err := helmstorage.LatestObjects(ctx, k8sClient, &helmRelease)
 	if err != nil {
 		return nil, err
 	}
@@ -42,3 +53,196 @@ func getHelmReleaseInventory(ctx context.Context, helmRelease helmv2.HelmRelease
 
 	return gvk, nil
 }
+
+func (cs *coreServer) ListHelmReleaseRevisions(ctx context.Context, msg *pb.ListHelmReleaseRevisionsRequest) (*pb.ListHelmReleaseRevisionsResponse, error) {
+	if msg.ClusterName == "" {
+		msg.ClusterName = DefaultCluster
+	}
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClientForCluster(ctx, auth.Principal(ctx), msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	c, err := clustersClient.Scoped(msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scoped client for cluster=%s: %w", msg.ClusterName, err)
+	}
+
+	hr := &helmv2.HelmRelease{}
+	if err := c.Get(ctx, client.ObjectKey{Name: msg.Name, Namespace: msg.Namespace}, hr); err != nil {
+		return nil, wrapK8sAPIError("get helmrelease", err)
+	}
+
+	releases, err := helmstorage.Revisions(ctx, c, hr)
+	if err != nil {
+		return nil, wrapK8sAPIError("list helm release revisions", err)
+	}
+
+	res := &pb.ListHelmReleaseRevisionsResponse{Revisions: []*pb.HelmReleaseRevision{}}
+
+	for i, release := range releases {
+		// Revisions are latest first
+		var previous *helmstorage.Release
+		if i+1 < len(releases) {
+			previous = releases[i+1]
+		}
+
+		revision, err := helmReleaseRevisionToProto(previous, release)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Revisions = append(res.Revisions, revision)
+	}
+
+	return res, nil
+}
+
+func helmReleaseRevisionToProto(previous, release *helmstorage.Release) (*pb.HelmReleaseRevision, error) {
+	values, err := helmstorage.ValuesYAML(release)
+	if err != nil {
+		return nil, err
+	}
+
+	valuesDiff, err := helmstorage.ValuesDiff(previous, release)
+	if err != nil {
+		return nil, err
+	}
+
+	revision := &pb.HelmReleaseRevision{
+		Version:      int32(release.Version),
+		ChartName:    release.Chart.Metadata.Name,
+		ChartVersion: release.Chart.Metadata.Version,
+		AppVersion:   release.Chart.Metadata.AppVersion,
+		Status:       release.Info.Status,
+		Description:  release.Info.Description,
+		Values:       values,
+		ValuesDiff:   valuesDiff,
+	}
+
+	if !release.Info.FirstDeployed.IsZero() {
+		revision.FirstDeployed = release.Info.FirstDeployed.Format(time.RFC3339)
+	}
+
+	if !release.Info.LastDeployed.IsZero() {
+		revision.LastDeployed = release.Info.LastDeployed.Format(time.RFC3339)
+	}
+
+	return revision, nil
+}
+
+func (cs *coreServer) RollbackHelmRelease(ctx context.Context, msg *pb.RollbackHelmReleaseRequest) (*pb.RollbackHelmReleaseResponse, error) {
+	principal := auth.Principal(ctx)
+	requestID := audit.RequestID(ctx)
+
+	if msg.ClusterName == "" {
+		msg.ClusterName = DefaultCluster
+	}
+
+	ref := &pb.ObjectRef{
+		Kind:        helmv2.HelmReleaseKind,
+		Name:        msg.Name,
+		Namespace:   msg.Namespace,
+		ClusterName: msg.ClusterName,
+	}
+
+	if msg.Version <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "version must be positive")
+	}
+
+	if err := cs.authorizeObject(principal, auth.ActionRollbackHelmRelease, ref); err != nil {
+		cs.recordAction(ctx, requestID, principal, ref, audit.ActionRollback, msg.Comment, err)
+		return nil, err
+	}
+
+	res, err := cs.rollbackHelmRelease(ctx, principal, msg)
+	cs.recordAction(ctx, requestID, principal, ref, audit.ActionRollback, msg.Comment, err)
+
+	return res, err
+}
+
+func (cs *coreServer) rollbackHelmRelease(ctx context.Context, principal *auth.UserPrincipal, msg *pb.RollbackHelmReleaseRequest) (*pb.RollbackHelmReleaseResponse, error) {
+	clustersClient, err := cs.clustersManager.GetImpersonatedClientForCluster(ctx, principal, msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	c, err := clustersClient.Scoped(msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scoped client for cluster=%s: %w", msg.ClusterName, err)
+	}
+
+	hr := &helmv2.HelmRelease{}
+	if err := c.Get(ctx, client.ObjectKey{Name: msg.Name, Namespace: msg.Namespace}, hr); err != nil {
+		return nil, wrapK8sAPIError("get helmrelease", err)
+	}
+
+	if hr.Spec.KubeConfig != nil {
+		return nil, status.Error(codes.FailedPrecondition, "the HelmRelease is released on another cluster, its storage can't be read")
+	}
+
+	storageSecret := &v1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{
+		Name:      helmstorage.SecretName(helmstorage.ReleaseName(hr), int(msg.Version)),
+		Namespace: helmstorage.StorageNamespace(hr),
+	}, storageSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "version %d of the release isn't in the Helm storage", msg.Version)
+		}
+
+		return nil, wrapK8sAPIError("get helm release storage", err)
+	}
+
+	release, err := helmstorage.Decode(storageSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := client.MergeFrom(hr.DeepCopy())
+
+	warnings, err := helmstorage.Rollback(hr, release, principal.ID, msg.Comment, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	cs.logger.Info("Rolling back HelmRelease",
+		"principal", principal.ID,
+		"name", msg.Name,
+		"namespace", msg.Namespace,
+		"cluster", msg.ClusterName,
+		"version", release.Version,
+		"chartVersion", release.Chart.Metadata.Version,
+	)
+
+	if err := c.Patch(ctx, hr, patch); err != nil {
+		return nil, wrapK8sAPIError("patch helmrelease", err)
+	}
+
+	if warning, ok := appliedByKustomizationWarning(ctx, c, hr); ok {
+		warnings = append(warnings, warning)
+	}
+
+	return &pb.RollbackHelmReleaseResponse{
+		ChartVersion: release.Chart.Metadata.Version,
+		Warnings:     warnings,
+	}, nil
+}
+
+// appliedByKustomizationWarning warns that the Kustomization that applied
+// the HelmRelease will undo the rollback when it next reconciles, unless
+// it's suspended.
+func appliedByKustomizationWarning(ctx context.Context, c client.Client, hr *helmv2.HelmRelease) (string, bool) {
+	name, namespace := hr.Labels[ownership.KustomizeNameLabel], hr.Labels[ownership.KustomizeNamespaceLabel]
+	if name == "" || namespace == "" {
+		return "", false
+	}
+
+	// If the Kustomization can't be read, it may not be suspended
+	ks := &kustomizev1.Kustomization{}
+	if err := c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, ks); err == nil && ks.Spec.Suspend {
+		return "", false
+	}
+
+	return fmt.Sprintf("The HelmRelease is applied by the Kustomization %s/%s, which will undo the rollback when it next reconciles unless it's suspended", namespace, name), true
+}
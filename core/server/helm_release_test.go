@@ -0,0 +1,136 @@
+package server_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/helmstorage"
+	"github.com/weaveworks/weave-gitops/core/ownership"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestHelmReleaseRevisionsAndRollback(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "flux-system"},
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: &helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart:     "podinfo",
+					Version:   "6.x",
+					SourceRef: helmv2.CrossNamespaceObjectReference{Kind: sourcev1.HelmRepositoryKind, Name: "podinfo"},
+				},
+			},
+		},
+		Status: helmv2.HelmReleaseStatus{
+			StorageNamespace: "flux-system",
+			History: helmv2.Snapshots{
+				{Name: "podinfo", Version: 2, Namespace: "flux-system"},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		hr,
+		helmStorageSecret(g, 1, "6.0.0", "superseded", map[string]any{"replicas": 1}),
+		helmStorageSecret(g, 2, "6.1.0", "deployed", map[string]any{"replicas": 3}),
+	).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	c := makeServer(ctx, t, cfg)
+
+	userCtx := metadata.AppendToOutgoingContext(ctx, MetadataUserKey, "bob@example.com")
+
+	res, err := c.ListHelmReleaseRevisions(userCtx, &pb.ListHelmReleaseRevisionsRequest{
+		ClusterName: "Default",
+		Namespace:   "flux-system",
+		Name:        "podinfo",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Revisions).To(HaveLen(2))
+	g.Expect(res.Revisions[0].Version).To(Equal(int32(2)))
+	g.Expect(res.Revisions[0].ChartVersion).To(Equal("6.1.0"))
+	g.Expect(res.Revisions[0].Status).To(Equal("deployed"))
+	g.Expect(res.Revisions[0].Values).To(Equal("replicas: 3\n"))
+	g.Expect(res.Revisions[0].ValuesDiff).To(ContainSubstring("-replicas: 1\n+replicas: 3\n"))
+	g.Expect(res.Revisions[1].Version).To(Equal(int32(1)))
+
+	rollback, err := c.RollbackHelmRelease(userCtx, &pb.RollbackHelmReleaseRequest{
+		ClusterName: "Default",
+		Namespace:   "flux-system",
+		Name:        "podinfo",
+		Version:     1,
+		Comment:     "too many replicas",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rollback.ChartVersion).To(Equal("6.0.0"))
+	g.Expect(rollback.Warnings).To(BeEmpty())
+
+	rolledBack := &helmv2.HelmRelease{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(hr), rolledBack)).To(Succeed())
+	g.Expect(rolledBack.Spec.Chart.Spec.Version).To(Equal("6.0.0"))
+	g.Expect(rolledBack.Spec.Values.Raw).To(MatchJSON(`{"replicas":1}`))
+	g.Expect(rolledBack.Annotations).To(HaveKeyWithValue(helmstorage.RolledBackByAnnotation, "bob@example.com"))
+	g.Expect(rolledBack.Annotations).To(HaveKeyWithValue(helmstorage.RolledBackToAnnotation, "1 (chart 6.0.0)"))
+	g.Expect(rolledBack.Annotations).To(HaveKeyWithValue(helmstorage.RollbackCommentAnnotation, "too many replicas"))
+
+	// The Kustomization applying the HelmRelease would undo the rollback
+	rolledBack.Labels = map[string]string{
+		ownership.KustomizeNameLabel:      "apps",
+		ownership.KustomizeNamespaceLabel: "flux-system",
+	}
+	g.Expect(fakeClient.Update(ctx, rolledBack)).To(Succeed())
+
+	rollback, err = c.RollbackHelmRelease(userCtx, &pb.RollbackHelmReleaseRequest{ClusterName: "Default", Namespace: "flux-system", Name: "podinfo", Version: 2})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rollback.Warnings).To(ConsistOf(ContainSubstring("Kustomization flux-system/apps")))
+
+	_, err = c.RollbackHelmRelease(userCtx, &pb.RollbackHelmReleaseRequest{ClusterName: "Default", Namespace: "flux-system", Name: "podinfo", Version: 5})
+	g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+	_, err = c.RollbackHelmRelease(userCtx, &pb.RollbackHelmReleaseRequest{ClusterName: "Default", Namespace: "flux-system", Name: "podinfo"})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
+
+func helmStorageSecret(g *WithT, version int, chartVersion, releaseStatus string, values map[string]any) *corev1.Secret {
+	data, err := json.Marshal(map[string]any{
+		"name":    "podinfo",
+		"version": version,
+		"info":    map[string]any{"status": releaseStatus},
+		"chart":   map[string]any{"metadata": map[string]any{"name": "podinfo", "version": chartVersion}},
+		"config":  values,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      helmstorage.SecretName("podinfo", version),
+			Namespace: "flux-system",
+			Labels:    map[string]string{"owner": "helm", "name": "podinfo"},
+		},
+		Data: map[string][]byte{
+			"release": []byte(base64.StdEncoding.EncodeToString(data)),
+		},
+	}
+}
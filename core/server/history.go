@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/history"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func (cs *coreServer) GetReconciliationHistory(ctx context.Context, msg *pb.GetReconciliationHistoryRequest) (*pb.GetReconciliationHistoryResponse, error) {
+	if cs.historyStore == nil {
+		return nil, status.Error(codes.FailedPrecondition, "reconciliation history is not enabled")
+	}
+
+	gvk, err := cs.primaryKinds.Lookup(msg.Kind)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad request: not a recognized object kind")
+	}
+
+	if gvk.Kind != kustomizev1.KustomizationKind && gvk.Kind != helmv2.HelmReleaseKind {
+		return nil, status.Errorf(codes.InvalidArgument, "bad request: history is only recorded for %s and %s", kustomizev1.KustomizationKind, helmv2.HelmReleaseKind)
+	}
+
+	if msg.ClusterName == "" {
+		msg.ClusterName = DefaultCluster
+	}
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClientForCluster(ctx, auth.Principal(ctx), msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	// Only return the history of objects the user can read.
+	obj := unstructured.Unstructured{}
+	obj.SetGroupVersionKind(*gvk)
+
+	if err := clustersClient.Get(ctx, msg.ClusterName, client.ObjectKey{Name: msg.Name, Namespace: msg.Namespace}, &obj); err != nil {
+		return nil, wrapK8sAPIError("get object", err)
+	}
+
+	records, err := cs.historyStore.List(ctx, history.ObjectRef{
+		Cluster:   msg.ClusterName,
+		Kind:      gvk.Kind,
+		Namespace: msg.Namespace,
+		Name:      msg.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get reconciliation history: %w", err)
+	}
+
+	res := &pb.GetReconciliationHistoryResponse{
+		Records: make([]*pb.ReconciliationRecord, 0, len(records)),
+	}
+
+	for i := len(records) - 1; i >= 0; i-- {
+		r := records[i]
+
+		record := &pb.ReconciliationRecord{
+			Revision:  r.Revision,
+			Status:    string(r.Status),
+			Reason:    r.Reason,
+			Message:   r.Message,
+			Timestamp: r.Timestamp.Format(time.RFC3339),
+		}
+
+		if r.Duration > 0 {
+			record.Duration = r.Duration.String()
+		}
+
+		res.Records = append(res.Records, record)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,96 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/history"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestGetReconciliationHistory(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-namespace",
+		},
+	}
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-kustomization",
+			Namespace: ns.Name,
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, kust).Build()
+
+	store := history.NewMemoryStore(10)
+	ref := history.ObjectRef{Cluster: "Default", Kind: kustomizev1.KustomizationKind, Namespace: ns.Name, Name: kust.Name}
+	now := time.Now()
+
+	g.Expect(store.Add(ctx, ref, history.Record{Revision: "main@sha1:1", Status: metav1.ConditionFalse, Timestamp: now.Add(-time.Hour)})).To(Succeed())
+	g.Expect(store.Add(ctx, ref, history.Record{Revision: "main@sha1:2", Status: metav1.ConditionTrue, Timestamp: now, Duration: 5 * time.Second})).To(Succeed())
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	cfg.HistoryStore = store
+	c := makeServer(ctx, t, cfg)
+
+	res, err := c.GetReconciliationHistory(ctx, &pb.GetReconciliationHistoryRequest{
+		Kind:      kustomizev1.KustomizationKind,
+		Name:      kust.Name,
+		Namespace: ns.Name,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Records).To(HaveLen(2))
+	g.Expect(res.Records[0].Revision).To(Equal("main@sha1:2"))
+	g.Expect(res.Records[0].Status).To(Equal("True"))
+	g.Expect(res.Records[0].Duration).To(Equal("5s"))
+	g.Expect(res.Records[1].Revision).To(Equal("main@sha1:1"))
+	g.Expect(res.Records[1].Duration).To(BeEmpty())
+
+	_, err = c.GetReconciliationHistory(ctx, &pb.GetReconciliationHistoryRequest{
+		Kind:      kustomizev1.KustomizationKind,
+		Name:      "missing",
+		Namespace: ns.Name,
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+}
+
+func TestGetReconciliationHistoryDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	c := makeServer(ctx, t, cfg)
+
+	_, err = c.GetReconciliationHistory(ctx, &pb.GetReconciliationHistoryRequest{
+		Kind:      kustomizev1.KustomizationKind,
+		Name:      "my-kustomization",
+		Namespace: "test-namespace",
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+}
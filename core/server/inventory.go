@@ -1,22 +1,17 @@
 package server
 
 import (
-	"bytes"
-	"compress/gzip"
 	"context"
-	"encoding/base64"
-	"encoding/json"
 	"fmt"
-	"io"
-	"strings"
 	"sync"
 
 	helmv2 "github.com/fluxcd/helm-controller/api/v2"
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
-	"github.com/fluxcd/pkg/ssa/utils"
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -24,7 +19,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
-	"github.com/weaveworks/weave-gitops/core/server/types"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/helmstorage"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/health"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
@@ -48,35 +44,14 @@ func (cs *coreServer) GetInventory(ctx context.Context, msg *pb.GetInventoryRequ
 		return nil, fmt.Errorf("error getting scoped client for cluster=%s: %w", msg.ClusterName, err)
 	}
 
-	var inventoryRefs []*unstructured.Unstructured
-
-	defaultNS := msg.Namespace
+	automation, err := cs.getAutomation(ctx, client, msg.Kind, msg.Name, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	switch msg.Kind {
-	case kustomizev1.KustomizationKind:
-		inventoryRefs, err = cs.getKustomizationInventory(ctx, client, msg.Name, msg.Namespace)
-		if err != nil {
-			return nil, fmt.Errorf("failed getting kustomization inventory: %w", err)
-		}
-	case helmv2.HelmReleaseKind:
-		hr, err := cs.getHelmRelease(ctx, client, msg.Name, msg.Namespace)
-		if err != nil {
-			return nil, fmt.Errorf("failed getting Helm Release for inventory: %w", err)
-		}
-		inventoryRefs, err = cs.getHelmReleaseInventory(ctx, client, hr)
-		if err != nil {
-			return nil, fmt.Errorf("failed getting Helm Release inventory: %w", err)
-		}
-		defaultNS = defaultNSFromHelmRelease(hr)
-	default:
-		gvk, err := cs.primaryKinds.Lookup(msg.Kind)
-		if err != nil {
-			return nil, err
-		}
-		inventoryRefs, err = getFluxLikeInventory(ctx, client, msg.Name, msg.Namespace, *gvk)
-		if err != nil {
-			return nil, fmt.Errorf("failed getting flux like inventory: %w", err)
-		}
+	inventoryRefs, defaultNS, err := cs.inventoryRefs(ctx, client, automation)
+	if err != nil {
+		return nil, err
 	}
 
 	objsWithChildren := getObjectsWithChildren(ctx, defaultNS, inventoryRefs, client, msg.WithChildren, cs.logger)
@@ -91,23 +66,60 @@ func (cs *coreServer) GetInventory(ctx context.Context, msg *pb.GetInventoryRequ
 		entries = append(entries, entry)
 	}
 
+	summary := newHealthSummary()
+	if err := cs.addAutomationHealth(summary, msg.ClusterName, automation); err != nil {
+		return nil, err
+	}
+
+	for i, oc := range objsWithChildren {
+		addEntryHealth(summary, msg.ClusterName, oc, entries[i])
+	}
+
 	return &pb.GetInventoryResponse{
-		Entries: entries,
+		Entries:       entries,
+		HealthSummary: finishHealthSummary(summary),
 	}, nil
 }
 
-func (cs *coreServer) getKustomizationInventory(ctx context.Context, k8sClient client.Client, name, namespace string) ([]*unstructured.Unstructured, error) {
-	ks := &kustomizev1.Kustomization{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
-		},
-	}
+// inventoryRefs returns the objects in the inventory of a Flux object, and
+// the namespace of the namespaced objects without one.
+func (cs *coreServer) inventoryRefs(ctx context.Context, client client.Client, automation *unstructured.Unstructured) ([]*unstructured.Unstructured, string, error) {
+	switch automation.GetKind() {
+	case kustomizev1.KustomizationKind:
+		ks := &kustomizev1.Kustomization{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(automation.Object, ks); err != nil {
+			return nil, "", fmt.Errorf("failed converting kustomization: %w", err)
+		}
+
+		inventoryRefs, err := cs.getKustomizationInventory(ks)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed getting kustomization inventory: %w", err)
+		}
+
+		return inventoryRefs, ks.Namespace, nil
+	case helmv2.HelmReleaseKind:
+		hr := &helmv2.HelmRelease{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(automation.Object, hr); err != nil {
+			return nil, "", fmt.Errorf("failed converting Helm Release: %w", err)
+		}
+
+		inventoryRefs, err := cs.getHelmReleaseInventory(ctx, client, hr)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed getting Helm Release inventory: %w", err)
+		}
+
+		return inventoryRefs, helmstorage.ReleaseNamespace(hr), nil
+	default:
+		inventoryRefs, err := parseInventoryFromUnstructured(automation)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed getting flux like inventory: %w", err)
+		}
 
-	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(ks), ks); err != nil {
-		return nil, fmt.Errorf("failed to get kustomization: %w", err)
+		return inventoryRefs, automation.GetNamespace(), nil
 	}
+}
 
+func (cs *coreServer) getKustomizationInventory(ks *kustomizev1.Kustomization) ([]*unstructured.Unstructured, error) {
 	if ks.Status.Inventory == nil {
 		return nil, nil
 	}
@@ -129,23 +141,8 @@ func (cs *coreServer) getKustomizationInventory(ctx context.Context, k8sClient c
 	return objects, nil
 }
 
-func (cs *coreServer) getHelmRelease(ctx context.Context, k8sClient client.Client, name, namespace string) (*helmv2.HelmRelease, error) {
-	release := &helmv2.HelmRelease{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
-		},
-	}
-
-	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(release), release); err != nil {
-		return nil, fmt.Errorf("failed to get helm release: %w", err)
-	}
-
-	return release, nil
-}
-
 func (cs *coreServer) getHelmReleaseInventory(ctx context.Context, k8sClient client.Client, hr *helmv2.HelmRelease) ([]*unstructured.Unstructured, error) {
-	objects, err := getHelmReleaseObjects(ctx, k8sClient, hr)
+	objects, err := helmstorage.LatestObjects(ctx, k8sClient, hr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get helm release objects: %w", err)
 	}
@@ -153,63 +150,6 @@ func (cs *coreServer) getHelmReleaseInventory(ctx context.Context, k8sClient cli
 	return objects, nil
 }
 
-// Returns the list of resources applied in the helm chart.
-func getHelmReleaseObjects(ctx context.Context, k8sClient client.Client, helmRelease *helmv2.HelmRelease) ([]*unstructured.Unstructured, error) {
-	secretName := secretNameFromHelmRelease(helmRelease)
-	if secretName == nil {
-		// skip release if it failed to install
-		return nil, nil
-	}
-	storageSecret := &v1.Secret{}
-	if helmRelease.Spec.KubeConfig != nil {
-		// helmrelease secret is on another cluster so we cannot inspect it to figure out the inventory and version and other things
-		return nil, nil
-	}
-
-	if err := k8sClient.Get(ctx, *secretName, storageSecret); err != nil {
-		return nil, err
-	}
-
-	releaseData, releaseFound := storageSecret.Data["release"]
-	if !releaseFound {
-		return nil, fmt.Errorf("failed to decode the Helm storage object for HelmRelease '%s'", helmRelease.Name)
-	}
-
-	byteData, err := base64.StdEncoding.DecodeString(string(releaseData))
-	if err != nil {
-		return nil, err
-	}
-
-	magicGzip := []byte{0x1f, 0x8b, 0x08}
-	if bytes.Equal(byteData[0:3], magicGzip) {
-		r, err := gzip.NewReader(bytes.NewReader(byteData))
-		if err != nil {
-			return nil, err
-		}
-
-		defer r.Close()
-
-		uncompressedByteData, err := io.ReadAll(r)
-		if err != nil {
-			return nil, err
-		}
-
-		byteData = uncompressedByteData
-	}
-
-	storage := types.HelmReleaseStorage{}
-	if err := json.Unmarshal(byteData, &storage); err != nil {
-		return nil, fmt.Errorf("failed to decode the Helm storage object for HelmRelease '%s': %w", helmRelease.Name, err)
-	}
-
-	objects, err := utils.ReadObjects(strings.NewReader(storage.Manifest))
-	if err != nil {
-		return nil, fmt.Errorf("failed to read the Helm storage object for HelmRelease '%s': %w", helmRelease.Name, err)
-	}
-
-	return objects, nil
-}
-
 func unstructuredToInventoryEntry(clusterName string, objWithChildren ObjectWithChildren, clusterUserNamespaces map[string][]v1.Namespace, healthChecker health.HealthChecker) (*pb.InventoryEntry, error) {
 	unstructuredObj := *objWithChildren.Object
 	if unstructuredObj.GetKind() == "Secret" {
@@ -250,6 +190,7 @@ func unstructuredToInventoryEntry(clusterName string, objWithChildren ObjectWith
 			Message: health.Message,
 		},
 	}
+	entry.RollupHealth = rollupHealth(entry.Health, objWithChildren.Children, children)
 
 	return entry, nil
 }
@@ -260,6 +201,12 @@ func unstructuredToInventoryEntry(clusterName string, objWithChildren ObjectWith
 func getObjectsWithChildren(ctx context.Context, defaultNS string, objects []*unstructured.Unstructured, k8sClient client.Client, withChildren bool, logger logr.Logger) []*ObjectWithChildren {
 	result := []*ObjectWithChildren{}
 
+	ctx, span := tracer.Start(ctx, "getObjectsWithChildren", trace.WithAttributes(
+		attribute.Int("objects", len(objects)),
+		attribute.Bool("with_children", withChildren),
+	))
+	defer span.End()
+
 	var (
 		isNamespacedGVK = map[string]bool{}
 		resultMu        sync.Mutex
@@ -274,6 +221,12 @@ func getObjectsWithChildren(ctx context.Context, defaultNS string, objects []*un
 		go func(obj unstructured.Unstructured) {
 			defer wg.Done()
 
+			ctx, span := tracer.Start(ctx, "getObjectWithChildren", trace.WithAttributes(
+				clustersmngr.KindKey.String(obj.GetKind()),
+				clustersmngr.NameKey.String(obj.GetName()),
+			))
+			defer span.End()
+
 			// Set the namespace of the object if it is not set.
 			if obj.GetNamespace() == "" {
 				// Manifest does not contain the namespace of the release.
@@ -288,6 +241,8 @@ func getObjectsWithChildren(ctx context.Context, defaultNS string, objects []*un
 					namespaced, err = apiutil.IsObjectNamespaced(&obj, k8sClient.Scheme(), k8sClient.RESTMapper())
 					if err != nil {
 						logger.Error(err, "failed to determine if resource is namespace scoped", "kind", obj.GetObjectKind().GroupVersionKind().Kind)
+						recordSpanError(span, err)
+
 						return
 					}
 
@@ -302,8 +257,12 @@ func getObjectsWithChildren(ctx context.Context, defaultNS string, objects []*un
 				}
 			}
 
+			span.SetAttributes(semconv.K8SNamespaceName(obj.GetNamespace()))
+
 			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(&obj), &obj); err != nil {
 				logger.Error(err, "failed to get object", "entry", obj)
+				recordSpanError(span, err)
+
 				return
 			}
 
@@ -313,6 +272,8 @@ func getObjectsWithChildren(ctx context.Context, defaultNS string, objects []*un
 				children, err = getChildren(ctx, k8sClient, obj)
 				if err != nil {
 					logger.Error(err, "failed getting children", "entry", obj)
+					recordSpanError(span, err)
+
 					return
 				}
 			}
@@ -434,21 +395,6 @@ func sanitizeUnstructuredSecret(obj unstructured.Unstructured) (unstructured.Uns
 	return redactedUnstructured, nil
 }
 
-func getFluxLikeInventory(ctx context.Context, k8sClient client.Client, name, namespace string, gvk schema.GroupVersionKind) ([]*unstructured.Unstructured, error) {
-	// Create an unstructured object with the desired GVK (GroupVersionKind)
-	obj := &unstructured.Unstructured{}
-	obj.SetGroupVersionKind(gvk)
-	obj.SetName(name)
-	obj.SetNamespace(namespace)
-
-	// Get the object from the Kubernetes cluster
-	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
-		return nil, fmt.Errorf("failed to get kustomization: %w", err)
-	}
-
-	return parseInventoryFromUnstructured(obj)
-}
-
 func parseInventoryFromUnstructured(obj *unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
 	content := obj.UnstructuredContent()
 
@@ -478,24 +424,3 @@ func parseInventoryFromUnstructured(obj *unstructured.Unstructured) ([]*unstruct
 
 	return objects, nil
 }
-
-const helmSecretNameFmt = "sh.helm.release.v1.%s.v%v" // #nosec G101
-
-func secretNameFromHelmRelease(helmRelease *helmv2.HelmRelease) *client.ObjectKey {
-	if latest := helmRelease.Status.History.Latest(); latest != nil {
-		return &client.ObjectKey{
-			Name:      fmt.Sprintf(helmSecretNameFmt, latest.Name, latest.Version),
-			Namespace: helmRelease.GetStorageNamespace(),
-		}
-	}
-
-	return nil
-}
-
-func defaultNSFromHelmRelease(helmRelease *helmv2.HelmRelease) string {
-	if latest := helmRelease.Status.History.Latest(); latest != nil {
-		return latest.Namespace
-	}
-
-	return ""
-}
@@ -6,15 +6,17 @@ import (
 
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	"github.com/go-logr/logr"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 )
 
-func TestGetFluxLikeInventory(t *testing.T) {
+func TestInventoryRefs(t *testing.T) {
 	g := NewGomegaWithT(t)
 
 	ctx := context.Background()
@@ -46,9 +48,15 @@ func TestGetFluxLikeInventory(t *testing.T) {
 
 	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ks).Build()
 
-	gvk := kustomizev1.GroupVersion.WithKind("Kustomization")
-	entries, err := getFluxLikeInventory(ctx, k8sClient, ks.Name, ks.Namespace, gvk)
+	automation := &unstructured.Unstructured{}
+	automation.SetGroupVersionKind(kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind))
+	g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(ks), automation)).To(Succeed())
+
+	cs := &coreServer{logger: logr.Discard()}
+
+	entries, defaultNS, err := cs.inventoryRefs(ctx, k8sClient, automation)
 	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(defaultNS).To(Equal("my-namespace"))
 	g.Expect(entries).To(HaveLen(1))
 
 	expected := &unstructured.Unstructured{
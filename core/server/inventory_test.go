@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/core/helmstorage"
 	"github.com/weaveworks/weave-gitops/core/server"
 	"github.com/weaveworks/weave-gitops/core/server/types"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
@@ -256,7 +257,7 @@ func TestGetInventoryHelmRelease(t *testing.T) {
 	g.Expect(err).NotTo(HaveOccurred())
 
 	// Create helm storage.
-	storage := types.HelmReleaseStorage{
+	storage := helmstorage.Release{
 		Name:     "",
 		Manifest: string(cmData),
 	}
@@ -343,7 +344,7 @@ func TestGetInventoryHelmReleaseNoNSResources(t *testing.T) {
 	cm.SetNamespace("test-ns")
 
 	// Create helm storage.
-	storage := types.HelmReleaseStorage{
+	storage := helmstorage.Release{
 		Name:     "",
 		Manifest: string(cmData),
 	}
@@ -44,6 +44,10 @@ func getUnstructuredHelmReleaseInventory(ctx context.Context, obj unstructured.U
 func (cs *coreServer) ListObjects(ctx context.Context, msg *pb.ListObjectsRequest) (*pb.ListObjectsResponse, error) {
 	respErrors := []*pb.ListError{}
 
+	if err := validateObjectsQuery(msg); err != nil {
+		return nil, err
+	}
+
 	gvk, err := cs.primaryKinds.Lookup(msg.Kind)
 	if err != nil {
 		return nil, err
@@ -93,9 +97,7 @@ func (cs *coreServer) ListObjects(ctx context.Context, msg *pb.ListObjectsReques
 		}
 	}
 
-	var results []*pb.Object
-
-	queriedNamespaces := clist.Namespaces()
+	var matching []listedObject
 
 	for clusterName, lists := range clist.Lists() {
 		for _, l := range lists {
@@ -105,56 +107,80 @@ func (cs *coreServer) ListObjects(ctx context.Context, msg *pb.ListObjectsReques
 			}
 
 			for _, unstructuredObj := range list.Items {
-				tenant := GetTenant(unstructuredObj.GetNamespace(), clusterName, queriedNamespaces)
-
-				var obj client.Object = &unstructuredObj
-
-				var inventory []*pb.GroupVersionKind = nil
-				var info string
-
-				switch gvk.Kind {
-				case "Secret":
-					obj, err = sanitizeSecret(&unstructuredObj)
-					if err != nil {
-						respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Message: fmt.Sprintf("error sanitizing secrets: %v", err)})
-						continue
-					}
-				case helmv2.HelmReleaseKind:
-					inventory, err = getUnstructuredHelmReleaseInventory(ctx, unstructuredObj, clustersClient, clusterName)
-					if err != nil {
-						respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Message: err.Error()})
-						inventory = nil // We can still display most things without inventory
-
-						cs.logger.V(logger.LogLevelDebug).Info("Couldn't grab inventory for helm release", "error", err)
-					}
-				case "StatefulSet":
-					clusterName, kind, err := parseSessionInfo(unstructuredObj)
-					if err != nil {
-						break
-					}
-
-					created, _ := cs.sessionObjectsCreated(ctx, clusterName, "flux-system", kind)
-
-					if created {
-						info = sessionObjectsInfo
-					}
+				o := listedObject{clusterName: clusterName, obj: unstructuredObj}
+				if matchesObjectsQuery(msg, o) {
+					matching = append(matching, o)
 				}
+			}
+		}
+	}
 
-				o, err := types.K8sObjectToProto(obj, clusterName, tenant, inventory, info)
-				if err != nil {
-					respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Message: "converting items: " + err.Error()})
-					continue
-				}
+	sortObjects(matching, msg.OrderBy, msg.Descending)
+
+	// Only the objects in the page are converted, as getting the inventory
+	// of HelmReleases is expensive
+	page, nextPageToken, err := pageObjects(msg, matching)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*pb.Object
 
-				results = append(results, o)
+	queriedNamespaces := clist.Namespaces()
+
+	for _, listed := range page {
+		clusterName := listed.clusterName
+		unstructuredObj := listed.obj
+		tenant := GetTenant(unstructuredObj.GetNamespace(), clusterName, queriedNamespaces)
+
+		var obj client.Object = &unstructuredObj
+
+		var inventory []*pb.GroupVersionKind = nil
+		var info string
+
+		switch gvk.Kind {
+		case "Secret":
+			obj, err = sanitizeSecret(&unstructuredObj)
+			if err != nil {
+				respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Message: fmt.Sprintf("error sanitizing secrets: %v", err)})
+				continue
+			}
+		case helmv2.HelmReleaseKind:
+			inventory, err = getUnstructuredHelmReleaseInventory(ctx, unstructuredObj, clustersClient, clusterName)
+			if err != nil {
+				respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Message: err.Error()})
+				inventory = nil // We can still display most things without inventory
+
+				cs.logger.V(logger.LogLevelDebug).Info("Couldn't grab inventory for helm release", "error", err)
+			}
+		case "StatefulSet":
+			clusterName, kind, err := parseSessionInfo(unstructuredObj)
+			if err != nil {
+				break
+			}
+
+			created, _ := cs.sessionObjectsCreated(ctx, clusterName, "flux-system", kind)
+
+			if created {
+				info = sessionObjectsInfo
 			}
 		}
+
+		o, err := types.K8sObjectToProto(obj, clusterName, tenant, inventory, info)
+		if err != nil {
+			respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Message: "converting items: " + err.Error()})
+			continue
+		}
+
+		results = append(results, o)
 	}
 
 	return &pb.ListObjectsResponse{
 		Objects:            results,
 		Errors:             respErrors,
 		SearchedNamespaces: GetClusterUserNamespacesNames(queriedNamespaces),
+		NextPageToken:      nextPageToken,
+		Total:              int32(len(matching)),
 	}, nil
 }
 
@@ -0,0 +1,249 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/weaveworks/weave-gitops/core/search"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+// The orders ListObjects can sort objects in.
+const (
+	orderByName              = "name"
+	orderByNamespace         = "namespace"
+	orderByClusterName       = "cluster_name"
+	orderByCreationTimestamp = "creation_timestamp"
+)
+
+// listedObject is an object listed from a cluster, before it's converted
+// for the response.
+type listedObject struct {
+	clusterName string
+	obj         unstructured.Unstructured
+}
+
+// objectsPageToken holds the sort keys of the last object of a page, for
+// the query it was returned for. The next page starts after that object, so
+// objects created or deleted between pages don't shift it.
+type objectsPageToken struct {
+	ClusterName       string      `json:"clusterName"`
+	Namespace         string      `json:"namespace"`
+	Name              string      `json:"name"`
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+	Query             string      `json:"query"`
+}
+
+func (t objectsPageToken) object() listedObject {
+	o := listedObject{clusterName: t.ClusterName}
+	o.obj.SetNamespace(t.Namespace)
+	o.obj.SetName(t.Name)
+	o.obj.SetCreationTimestamp(t.CreationTimestamp)
+
+	return o
+}
+
+func validateObjectsQuery(msg *pb.ListObjectsRequest) error {
+	if msg.PageSize < 0 {
+		return status.Error(codes.InvalidArgument, "page_size can't be negative")
+	}
+
+	switch msg.Ready {
+	case "", string(metav1.ConditionTrue), string(metav1.ConditionFalse), string(metav1.ConditionUnknown):
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid ready %q, valid values are True, False and Unknown", msg.Ready)
+	}
+
+	switch msg.Suspended {
+	case "", "true", "false":
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid suspended %q, valid values are true and false", msg.Suspended)
+	}
+
+	switch msg.OrderBy {
+	case "", orderByName, orderByNamespace, orderByClusterName, orderByCreationTimestamp:
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid order_by %q, valid values are %s, %s, %s and %s", msg.OrderBy, orderByName, orderByNamespace, orderByClusterName, orderByCreationTimestamp)
+	}
+
+	return nil
+}
+
+// matchesObjectsQuery is true if the object matches all the filters of the
+// request.
+func matchesObjectsQuery(msg *pb.ListObjectsRequest, o listedObject) bool {
+	if msg.NameContains != "" && !strings.Contains(o.obj.GetName(), msg.NameContains) {
+		return false
+	}
+
+	if msg.Ready != "" && search.ReadyStatus(o.obj) != msg.Ready {
+		return false
+	}
+
+	if msg.Suspended != "" {
+		suspended, _, _ := unstructured.NestedBool(o.obj.Object, "spec", "suspend")
+		if fmt.Sprint(suspended) != msg.Suspended {
+			return false
+		}
+	}
+
+	if ref := msg.SourceRef; ref != nil {
+		kind, name, namespace, ok := search.SourceRef(o.obj)
+		if !ok ||
+			(ref.Kind != "" && !strings.EqualFold(ref.Kind, kind)) ||
+			(ref.Name != "" && ref.Name != name) ||
+			(ref.Namespace != "" && ref.Namespace != namespace) ||
+			(ref.ClusterName != "" && ref.ClusterName != o.clusterName) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sortObjects orders the objects as requested, breaking ties by cluster,
+// namespace and name so pages are stable.
+func sortObjects(objects []listedObject, orderBy string, descending bool) {
+	compare := compareObjects(orderBy, descending)
+
+	sort.SliceStable(objects, func(i, j int) bool {
+		return compare(objects[i], objects[j]) < 0
+	})
+}
+
+// compareObjects returns the function comparing objects in the requested
+// order.
+func compareObjects(orderBy string, descending bool) func(a, b listedObject) int {
+	keys := func(o listedObject) []string {
+		return []string{o.clusterName, o.obj.GetNamespace(), o.obj.GetName()}
+	}
+
+	compareKeys := func(a, b listedObject) int {
+		ka, kb := keys(a), keys(b)
+		for i := range ka {
+			if c := strings.Compare(ka[i], kb[i]); c != 0 {
+				return c
+			}
+		}
+
+		return 0
+	}
+
+	compare := func(a, b listedObject) int {
+		switch orderBy {
+		case orderByName:
+			if c := strings.Compare(a.obj.GetName(), b.obj.GetName()); c != 0 {
+				return c
+			}
+		case orderByNamespace:
+			if c := strings.Compare(a.obj.GetNamespace(), b.obj.GetNamespace()); c != 0 {
+				return c
+			}
+		case orderByCreationTimestamp:
+			if c := a.obj.GetCreationTimestamp().Compare(b.obj.GetCreationTimestamp().Time); c != 0 {
+				return c
+			}
+		}
+
+		return compareKeys(a, b)
+	}
+
+	if descending {
+		return func(a, b listedObject) int {
+			return compare(b, a)
+		}
+	}
+
+	return compare
+}
+
+// pageObjects returns the page of the ordered objects the request asks
+// for, and the token of the next page if there is one.
+func pageObjects(msg *pb.ListObjectsRequest, objects []listedObject) ([]listedObject, string, error) {
+	query, err := objectsQueryFingerprint(msg)
+	if err != nil {
+		return nil, "", err
+	}
+
+	offset := 0
+
+	if msg.PageToken != "" {
+		var token objectsPageToken
+
+		b, err := base64.URLEncoding.DecodeString(msg.PageToken)
+		if err == nil {
+			err = json.Unmarshal(b, &token)
+		}
+
+		if err != nil {
+			return nil, "", status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+
+		if token.Query != query {
+			return nil, "", status.Error(codes.InvalidArgument, "page_token was returned for different filters or order")
+		}
+
+		compare := compareObjects(msg.OrderBy, msg.Descending)
+		last := token.object()
+
+		offset = sort.Search(len(objects), func(i int) bool {
+			return compare(objects[i], last) > 0
+		})
+	}
+
+	if offset >= len(objects) {
+		return []listedObject{}, "", nil
+	}
+
+	end := len(objects)
+	if msg.PageSize > 0 && offset+int(msg.PageSize) < end {
+		end = offset + int(msg.PageSize)
+	}
+
+	if end == len(objects) {
+		return objects[offset:end], "", nil
+	}
+
+	last := objects[end-1]
+
+	b, err := json.Marshal(objectsPageToken{
+		ClusterName:       last.clusterName,
+		Namespace:         last.obj.GetNamespace(),
+		Name:              last.obj.GetName(),
+		CreationTimestamp: last.obj.GetCreationTimestamp(),
+		Query:             query,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return objects[offset:end], base64.URLEncoding.EncodeToString(b), nil
+}
+
+// objectsQueryFingerprint identifies the filters and order of the request,
+// so a page token can't be used with another query.
+func objectsQueryFingerprint(msg *pb.ListObjectsRequest) (string, error) {
+	query := proto.Clone(msg).(*pb.ListObjectsRequest)
+	query.PageSize = 0
+	query.PageToken = ""
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(query)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(b)
+
+	return hex.EncodeToString(hash[:8]), nil
+}
@@ -16,8 +16,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/weaveworks/weave-gitops/core/helmstorage"
 	"github.com/weaveworks/weave-gitops/core/server/types"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
@@ -177,7 +179,7 @@ func TestGetObject_HelmReleaseWithInventory(t *testing.T) {
 		},
 	}
 	// Create helm storage.
-	storage := types.HelmReleaseStorage{
+	storage := helmstorage.Release{
 		Name:     "",
 		Manifest: helmReleaseInventoryObjects(),
 	}
@@ -260,7 +262,7 @@ func TestGetObject_HelmReleaseWithCompressedInventory(t *testing.T) {
 		},
 	}
 	// Create helm storage.
-	storage := types.HelmReleaseStorage{
+	storage := helmstorage.Release{
 		Name:     "",
 		Manifest: helmReleaseInventoryObjects(),
 	}
@@ -608,7 +610,7 @@ func TestListObject_HelmReleaseWithInventory(t *testing.T) {
 		},
 	}
 	// Create helm storage.
-	storage := types.HelmReleaseStorage{
+	storage := helmstorage.Release{
 		Name:     "",
 		Manifest: helmReleaseInventoryObjects(),
 	}
@@ -670,7 +672,7 @@ func TestListObject_HelmReleaseWithInventoryHistory(t *testing.T) {
 		},
 	}
 	// Create helm storage.
-	storage := types.HelmReleaseStorage{
+	storage := helmstorage.Release{
 		Name:     "",
 		Manifest: helmReleaseInventoryObjects(),
 	}
@@ -993,3 +995,111 @@ func TestGetObjectSessionObjects(t *testing.T) {
 	g.Expect(res.Object.ClusterName).To(ContainSubstring(testCluster))
 	g.Expect(res.Object.Payload).To(ContainSubstring(constants.RunDevBucketName))
 }
+
+func TestListObjectsFiltersAndPages(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+
+	kustomization := func(name, repo string, ready metav1.ConditionStatus, suspend bool) *kustomizev1.Kustomization {
+		k := &kustomizev1.Kustomization{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns.Name},
+			Spec: kustomizev1.KustomizationSpec{
+				SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: repo},
+				Suspend:   suspend,
+			},
+		}
+
+		if ready != "" {
+			k.Status.Conditions = []metav1.Condition{{Type: "Ready", Status: ready, Reason: "Test"}}
+		}
+
+		return k
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		ns,
+		kustomization("apps-a", "apps", metav1.ConditionTrue, false),
+		kustomization("apps-b", "apps", metav1.ConditionFalse, false),
+		kustomization("apps-c", "apps", metav1.ConditionTrue, true),
+		kustomization("infra", "infra", "", false),
+	).Build()
+
+	c := makeServer(ctx, t, makeServerConfig(t, fakeClient, ""))
+
+	names := func(res *pb.ListObjectsResponse) []string {
+		result := []string{}
+
+		for _, o := range res.Objects {
+			var data map[string]interface{}
+			g.Expect(json.Unmarshal([]byte(o.Payload), &data)).To(Succeed())
+
+			result = append(result, data["metadata"].(map[string]interface{})["name"].(string))
+		}
+
+		return result
+	}
+
+	list := func(req *pb.ListObjectsRequest) *pb.ListObjectsResponse {
+		req.Kind = kustomizev1.KustomizationKind
+		req.Namespace = ns.Name
+
+		res, err := c.ListObjects(ctx, req)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Errors).To(BeEmpty())
+
+		return res
+	}
+
+	res := list(&pb.ListObjectsRequest{})
+	g.Expect(names(res)).To(Equal([]string{"apps-a", "apps-b", "apps-c", "infra"}))
+	g.Expect(res.Total).To(Equal(int32(4)))
+	g.Expect(res.NextPageToken).To(BeEmpty())
+
+	g.Expect(names(list(&pb.ListObjectsRequest{OrderBy: "name", Descending: true}))).To(Equal([]string{"infra", "apps-c", "apps-b", "apps-a"}))
+	g.Expect(names(list(&pb.ListObjectsRequest{Ready: "True"}))).To(Equal([]string{"apps-a", "apps-c"}))
+	g.Expect(names(list(&pb.ListObjectsRequest{Ready: "Unknown"}))).To(Equal([]string{"infra"}))
+	g.Expect(names(list(&pb.ListObjectsRequest{Suspended: "false"}))).To(Equal([]string{"apps-a", "apps-b", "infra"}))
+	g.Expect(names(list(&pb.ListObjectsRequest{NameContains: "apps"}))).To(Equal([]string{"apps-a", "apps-b", "apps-c"}))
+	g.Expect(names(list(&pb.ListObjectsRequest{
+		SourceRef: &pb.ObjectRef{Kind: sourcev1.GitRepositoryKind, Name: "infra", Namespace: ns.Name},
+	}))).To(Equal([]string{"infra"}))
+
+	// Pages are taken from the filtered and ordered objects
+	req := &pb.ListObjectsRequest{NameContains: "apps", OrderBy: "name", Descending: true, PageSize: 2}
+	res = list(req)
+	g.Expect(names(res)).To(Equal([]string{"apps-c", "apps-b"}))
+	g.Expect(res.Total).To(Equal(int32(3)))
+	g.Expect(res.NextPageToken).NotTo(BeEmpty())
+
+	// Deleting an object of the previous page doesn't skip any of the next
+	apps := &kustomizev1.Kustomization{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: ns.Name, Name: "apps-c"}, apps)).To(Succeed())
+	g.Expect(fakeClient.Delete(ctx, apps)).To(Succeed())
+
+	req.PageToken = res.NextPageToken
+	res = list(req)
+	g.Expect(names(res)).To(Equal([]string{"apps-a"}))
+	g.Expect(res.NextPageToken).To(BeEmpty())
+
+	// The token can't be used with other filters
+	_, err = c.ListObjects(ctx, &pb.ListObjectsRequest{Kind: kustomizev1.KustomizationKind, PageToken: req.PageToken})
+	g.Expect(err).To(MatchError(ContainSubstring("page_token")))
+
+	for _, req := range []*pb.ListObjectsRequest{
+		{Ready: "yes"},
+		{Suspended: "maybe"},
+		{OrderBy: "size"},
+		{PageSize: -1},
+		{PageToken: "not-a-token"},
+	} {
+		req.Kind = kustomizev1.KustomizationKind
+		_, err := c.ListObjects(ctx, req)
+		g.Expect(err).To(HaveOccurred())
+	}
+}
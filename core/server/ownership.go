@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/ownership"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func (cs *coreServer) GetOwningAutomation(ctx context.Context, msg *pb.GetOwningAutomationRequest) (*pb.GetOwningAutomationResponse, error) {
+	if msg.Kind == "" || msg.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "kind and name are required")
+	}
+
+	gvk, err := cs.owningAutomationGVK(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	c, err := clustersClient.Scoped(msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scoped client for cluster=%s: %w", msg.ClusterName, err)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+
+	if err := c.Get(ctx, client.ObjectKey{Namespace: msg.Namespace, Name: msg.Name}, obj); err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetOwningAutomationResponse{Owners: []*pb.OwningAutomation{}}
+
+	// Objects without ownership labels are looked up in the inventories in
+	// the search index
+	var lookup ownership.InventoryLookup
+	if cs.searchIndex != nil {
+		lookup = ownership.IndexLookup(cs.searchIndex, msg.ClusterName, cs.userNamespacesFilter(ctx, auth.Principal(ctx)))
+	} else {
+		res.Warnings = append(res.Warnings, "the search index isn't enabled, so objects without ownership labels weren't looked up in the inventories of Kustomizations")
+	}
+
+	owners, err := ownership.Chain(ctx, c, msg.ClusterName, obj, lookup)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range owners {
+		res.Owners = append(res.Owners, &pb.OwningAutomation{
+			Automation: searchRefToProto(&o.Automation),
+			SourceRef:  searchRefToProto(o.SourceRef),
+			FoundBy:    o.FoundBy,
+		})
+	}
+
+	return res, nil
+}
+
+// owningAutomationGVK resolves the kind of the object, which needs the
+// api_version if the server doesn't know the kind.
+func (cs *coreServer) owningAutomationGVK(msg *pb.GetOwningAutomationRequest) (schema.GroupVersionKind, error) {
+	if msg.ApiVersion != "" {
+		gv, err := schema.ParseGroupVersion(msg.ApiVersion)
+		if err != nil {
+			return schema.GroupVersionKind{}, status.Errorf(codes.InvalidArgument, "invalid api_version: %s", err)
+		}
+
+		return gv.WithKind(msg.Kind), nil
+	}
+
+	gvk, err := cs.primaryKinds.Lookup(msg.Kind)
+	if err != nil {
+		return schema.GroupVersionKind{}, status.Errorf(codes.InvalidArgument, "%s, set api_version to look it up", err)
+	}
+
+	return *gvk, nil
+}
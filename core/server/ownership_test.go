@@ -0,0 +1,85 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestGetOwningAutomation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: "flux-system"},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: "flux-system"},
+		},
+	}
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "podinfo",
+			Namespace: "apps",
+			Labels: map[string]string{
+				"kustomize.toolkit.fluxcd.io/name":      "apps",
+				"kustomize.toolkit.fluxcd.io/namespace": "flux-system",
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kust, deployment).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	c := makeServer(ctx, t, cfg)
+
+	userCtx := metadata.AppendToOutgoingContext(ctx, MetadataUserKey, "bob@example.com")
+
+	res, err := c.GetOwningAutomation(userCtx, &api.GetOwningAutomationRequest{
+		ClusterName: "Default",
+		Kind:        "Deployment",
+		Namespace:   "apps",
+		Name:        "podinfo",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Owners).To(HaveLen(1))
+	g.Expect(res.Owners[0].Automation.Kind).To(Equal(kustomizev1.KustomizationKind))
+	g.Expect(res.Owners[0].Automation.Name).To(Equal("apps"))
+	g.Expect(res.Owners[0].SourceRef.Kind).To(Equal(sourcev1.GitRepositoryKind))
+	g.Expect(res.Owners[0].SourceRef.Namespace).To(Equal("flux-system"))
+	g.Expect(res.Owners[0].FoundBy).To(Equal("labels"))
+	// Without the search index inventories can't be looked up
+	g.Expect(res.Warnings).To(HaveLen(1))
+
+	// The Kustomization isn't managed by Flux
+	res, err = c.GetOwningAutomation(userCtx, &api.GetOwningAutomationRequest{
+		ClusterName: "Default",
+		Kind:        kustomizev1.KustomizationKind,
+		Namespace:   "flux-system",
+		Name:        "apps",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Owners).To(BeEmpty())
+
+	_, err = c.GetOwningAutomation(userCtx, &api.GetOwningAutomationRequest{ClusterName: "Default", Kind: "Deployment"})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	_, err = c.GetOwningAutomation(userCtx, &api.GetOwningAutomationRequest{ClusterName: "Default", Kind: "Widget", Name: "w"})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
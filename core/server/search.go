@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/weaveworks/weave-gitops/core/search"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+const (
+	defaultSearchLimit = 50
+	maxSearchLimit     = 500
+)
+
+func (cs *coreServer) Search(ctx context.Context, msg *pb.SearchRequest) (*pb.SearchResponse, error) {
+	if cs.searchIndex == nil {
+		return nil, status.Error(codes.FailedPrecondition, "the search index isn't enabled")
+	}
+
+	if msg.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit can't be negative")
+	}
+
+	query := search.Query{
+		Text:            msg.Query,
+		Kind:            msg.Kind,
+		Name:            msg.Name,
+		Annotations:     msg.Annotations,
+		SourceRef:       searchRef(msg.SourceRef),
+		Message:         msg.Message,
+		InventoryMember: searchRef(msg.InventoryMember),
+		Cluster:         msg.ClusterName,
+		Allowed:         cs.userNamespacesFilter(ctx, auth.Principal(ctx)),
+	}
+
+	if msg.LabelSelector != "" {
+		selector, err := labels.Parse(msg.LabelSelector)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid label_selector: %s", err)
+		}
+
+		query.Labels = selector
+	}
+
+	limit := defaultSearchLimit
+	if msg.Limit > 0 {
+		limit = min(int(msg.Limit), maxSearchLimit)
+	}
+
+	results := cs.searchIndex.Search(query)
+
+	res := &pb.SearchResponse{
+		Results: []*pb.SearchResult{},
+		Total:   int32(len(results)),
+	}
+
+	if updatedAt := cs.searchIndex.UpdatedAt(); !updatedAt.IsZero() {
+		res.IndexedAt = updatedAt.Format(time.RFC3339)
+	}
+
+	for _, r := range results[:min(limit, len(results))] {
+		res.Results = append(res.Results, searchResultToProto(r))
+	}
+
+	return res, nil
+}
+
+// userNamespacesFilter is true for the namespaces the user can access, the
+// same ones their objects are listed from.
+func (cs *coreServer) userNamespacesFilter(ctx context.Context, principal *auth.UserPrincipal) func(cluster, namespace string) bool {
+	userNamespaces := cs.clustersManager.GetUserNamespaces(principal)
+	if len(userNamespaces) == 0 {
+		cs.clustersManager.UpdateUserNamespaces(ctx, principal)
+		userNamespaces = cs.clustersManager.GetUserNamespaces(principal)
+	}
+
+	allowed := map[string]map[string]bool{}
+
+	for cluster, namespaces := range userNamespaces {
+		allowed[cluster] = map[string]bool{}
+		for _, ns := range namespaces {
+			allowed[cluster][ns.Name] = true
+		}
+	}
+
+	return func(cluster, namespace string) bool {
+		return allowed[cluster][namespace]
+	}
+}
+
+func searchRef(ref *pb.ObjectRef) *search.ObjectRef {
+	if ref == nil {
+		return nil
+	}
+
+	return &search.ObjectRef{
+		Cluster:   ref.ClusterName,
+		Kind:      ref.Kind,
+		Namespace: ref.Namespace,
+		Name:      ref.Name,
+	}
+}
+
+func searchResultToProto(r search.Result) *pb.SearchResult {
+	return &pb.SearchResult{
+		ClusterName: r.Cluster,
+		Kind:        r.Kind,
+		Namespace:   r.Namespace,
+		Name:        r.Name,
+		Ready:       r.Ready,
+		Message:     r.Message,
+		SourceRef:   searchRefToProto(r.SourceRef),
+		Score:       int32(r.Score),
+	}
+}
+
+func searchRefToProto(ref *search.ObjectRef) *pb.ObjectRef {
+	if ref == nil {
+		return nil
+	}
+
+	return &pb.ObjectRef{
+		ClusterName: ref.Cluster,
+		Kind:        ref.Kind,
+		Namespace:   ref.Namespace,
+		Name:        ref.Name,
+	}
+}
@@ -0,0 +1,96 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typedauth "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/search"
+	api "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestSearch(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	objects := []*kustomizev1.Kustomization{
+		{ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "team-a"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "team-b"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "podinfo-cache", Namespace: "team-a", Labels: map[string]string{"tier": "cache"}}},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+		objects[0], objects[1], objects[2],
+	).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	c := makeServer(ctx, t, cfg)
+
+	userCtx := metadata.AppendToOutgoingContext(ctx, MetadataUserKey, "bob@example.com", MetadataGroupsKey, "team-a")
+
+	_, err = c.Search(userCtx, &api.SearchRequest{Query: "podinfo"})
+	g.Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+	// The user can only access team-a
+	nsChecker.FilterAccessibleNamespacesStub = func(ctx context.Context, t typedauth.AuthorizationV1Interface, n []v1.Namespace) ([]v1.Namespace, error) {
+		allowed := []v1.Namespace{}
+		for _, ns := range n {
+			if ns.Name == "team-a" {
+				allowed = append(allowed, ns)
+			}
+		}
+
+		return allowed, nil
+	}
+
+	g.Expect(cfg.ClustersManager.UpdateClusters(ctx)).To(Succeed())
+
+	cfg.SearchIndex = search.NewClientIndex(logr.Discard(), "Default", fakeClient)
+	cfg.SearchIndex.Refresh(ctx)
+	c = makeServer(ctx, t, cfg)
+
+	res, err := c.Search(userCtx, &api.SearchRequest{Query: "podinfo"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Total).To(Equal(int32(2)))
+	g.Expect(res.IndexedAt).NotTo(BeEmpty())
+	g.Expect(res.Results).To(HaveLen(2))
+	g.Expect(res.Results[0].Name).To(Equal("podinfo"))
+	g.Expect(res.Results[0].Namespace).To(Equal("team-a"))
+	g.Expect(res.Results[0].ClusterName).To(Equal("Default"))
+	g.Expect(res.Results[0].Ready).To(Equal("Unknown"))
+	g.Expect(res.Results[1].Name).To(Equal("podinfo-cache"))
+
+	res, err = c.Search(userCtx, &api.SearchRequest{Query: "podinfo", Limit: 1})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Total).To(Equal(int32(2)))
+	g.Expect(res.Results).To(HaveLen(1))
+
+	res, err = c.Search(userCtx, &api.SearchRequest{LabelSelector: "tier in (cache)"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Results).To(HaveLen(1))
+	g.Expect(res.Results[0].Name).To(Equal("podinfo-cache"))
+
+	_, err = c.Search(userCtx, &api.SearchRequest{LabelSelector: "tier in"})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	_, err = c.Search(userCtx, &api.SearchRequest{Limit: -1})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
@@ -8,10 +8,15 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"k8s.io/client-go/rest"
 
+	"github.com/weaveworks/weave-gitops/core/artifacts"
+	"github.com/weaveworks/weave-gitops/core/audit"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/history"
 	"github.com/weaveworks/weave-gitops/core/nsaccess"
+	"github.com/weaveworks/weave-gitops/core/search"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/health"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 	"github.com/weaveworks/weave-gitops/pkg/services/crd"
 )
 
@@ -25,6 +30,10 @@ func Hydrate(ctx context.Context, mux *runtime.ServeMux, cfg CoreServerConfig) e
 		return fmt.Errorf("could not register new app server: %w", err)
 	}
 
+	if err = registerStreamingHandlers(mux, appsServer); err != nil {
+		return fmt.Errorf("could not register streaming handlers: %w", err)
+	}
+
 	return nil
 }
 
@@ -39,6 +48,15 @@ type coreServer struct {
 	primaryKinds    *PrimaryKinds
 	crd             crd.Fetcher
 	healthChecker   health.HealthChecker
+	historyStore    history.Store
+	freezeWindows   bool
+	auditLog        *audit.Log
+	authorizer      auth.Authorizer
+	apiTokens       *auth.APITokenStore
+	sessions        *auth.SessionAdmin
+	searchIndex     *search.Index
+	artifacts       *artifacts.Cache
+	controllerLogs  bool
 }
 
 type CoreServerConfig struct {
@@ -50,6 +68,29 @@ type CoreServerConfig struct {
 	PrimaryKinds    *PrimaryKinds
 	CRDService      crd.Fetcher
 	HealthChecker   health.HealthChecker
+	// HistoryStore holds the reconciliation history, nil if it's not recorded.
+	HistoryStore history.Store
+	// FreezeWindows enables suspending objects until a given time or during
+	// freeze windows, which needs a FreezeScheduler to be running.
+	FreezeWindows bool
+	// AuditLog records the actions users take, nil if they're not audited.
+	AuditLog *audit.Log
+	// Authorizer gates actions on top of impersonation, nil if they're
+	// only gated by impersonation.
+	Authorizer auth.Authorizer
+	// APITokens stores the users' API tokens, nil if they're not enabled.
+	APITokens *auth.APITokenStore
+	// Sessions lists and revokes the users' dashboard sessions.
+	Sessions *auth.SessionAdmin
+	// SearchIndex indexes the objects on all clusters, nil if search isn't
+	// enabled.
+	SearchIndex *search.Index
+	// Artifacts caches the source artifacts users browse.
+	Artifacts *artifacts.Cache
+	// ControllerLogs enables reading the logs of the Flux controllers, which
+	// needs the service account to list deployments and pods, and to read
+	// pods' logs.
+	ControllerLogs bool
 }
 
 func NewCoreConfig(log logr.Logger, cfg *rest.Config, clusterName string, clustersManager clustersmngr.ClustersManager, healthChecker health.HealthChecker) (CoreServerConfig, error) {
@@ -74,6 +115,10 @@ func NewCoreServer(ctx context.Context, cfg CoreServerConfig) (pb.CoreServer, er
 		cfg.CRDService = crd.NewFetcher(ctx, cfg.log, cfg.ClustersManager)
 	}
 
+	if cfg.Artifacts == nil {
+		cfg.Artifacts = artifacts.NewCache(artifactHTTPClient, artifacts.DefaultMaxArtifactSize, artifacts.DefaultMaxCacheSize)
+	}
+
 	return &coreServer{
 		logger:          cfg.log,
 		nsChecker:       cfg.NSAccess,
@@ -81,5 +126,14 @@ func NewCoreServer(ctx context.Context, cfg CoreServerConfig) (pb.CoreServer, er
 		primaryKinds:    cfg.PrimaryKinds,
 		crd:             cfg.CRDService,
 		healthChecker:   cfg.HealthChecker,
+		historyStore:    cfg.HistoryStore,
+		freezeWindows:   cfg.FreezeWindows,
+		auditLog:        cfg.AuditLog,
+		authorizer:      cfg.Authorizer,
+		apiTokens:       cfg.APITokens,
+		sessions:        cfg.Sessions,
+		searchIndex:     cfg.SearchIndex,
+		artifacts:       cfg.Artifacts,
+		controllerLogs:  cfg.ControllerLogs,
 	}, nil
 }
@@ -97,6 +97,11 @@ func (cs *coreServer) getFluxNamespace(ctx context.Context, k8sClient client.Cli
 
 // GetSessionLogs returns the logs for a session.
 func (cs *coreServer) GetSessionLogs(ctx context.Context, msg *pb.GetSessionLogsRequest) (*pb.GetSessionLogsResponse, error) {
+	// The sessions are in the default cluster
+	if err := cs.authorize(auth.Principal(ctx), auth.ActionGetSessionLogs, cluster.DefaultCluster, msg.GetSessionNamespace()); err != nil {
+		return nil, err
+	}
+
 	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("error getting impersonating client: %w", err)
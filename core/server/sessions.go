@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func (cs *coreServer) ListSessions(ctx context.Context, msg *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	if err := cs.authorizeSessions(ctx, auth.ActionListSessions); err != nil {
+		return nil, err
+	}
+
+	sessions, err := cs.sessions.List(ctx, msg.User)
+	if err != nil {
+		return nil, fmt.Errorf("listing sessions: %w", err)
+	}
+
+	res := &pb.ListSessionsResponse{Sessions: []*pb.Session{}}
+	for _, s := range sessions {
+		res.Sessions = append(res.Sessions, sessionToProto(s))
+	}
+
+	return res, nil
+}
+
+func (cs *coreServer) RevokeSessions(ctx context.Context, msg *pb.RevokeSessionsRequest) (*pb.RevokeSessionsResponse, error) {
+	if err := cs.authorizeSessions(ctx, auth.ActionRevokeSessions); err != nil {
+		return nil, err
+	}
+
+	if msg.User == "" && msg.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "a user or a session id is required")
+	}
+
+	revoked, err := cs.sessions.Revoke(ctx, msg.User, msg.Id)
+	if err != nil {
+		if errors.Is(err, auth.ErrSessionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "session %q not found", msg.Id)
+		}
+
+		return nil, fmt.Errorf("revoking sessions: %w", err)
+	}
+
+	cs.logger.Info("Revoked sessions", "by", auth.Principal(ctx).ID, "user", msg.User, "id", msg.Id, "revoked", revoked)
+
+	return &pb.RevokeSessionsResponse{Revoked: int32(revoked)}, nil
+}
+
+// authorizeSessions checks the user is an administrator. Unlike other
+// actions, managing other users' sessions is never allowed without
+// policies.
+func (cs *coreServer) authorizeSessions(ctx context.Context, action string) error {
+	if cs.sessions == nil {
+		return status.Error(codes.FailedPrecondition, "sessions can't be managed")
+	}
+
+	if cs.authorizer == nil {
+		return status.Error(codes.FailedPrecondition, "managing sessions needs policies allowing it")
+	}
+
+	return cs.authorizer.Authorize(auth.Principal(ctx), action, "", "")
+}
+
+func sessionToProto(s auth.Session) *pb.Session {
+	session := &pb.Session{
+		Id:        s.ID,
+		User:      s.User,
+		ExpiresAt: s.ExpiresAt.Format(time.RFC3339),
+	}
+
+	if !s.CreatedAt.IsZero() {
+		session.CreatedAt = s.CreatedAt.Format(time.RFC3339)
+	}
+
+	return session
+}
@@ -0,0 +1,70 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexedwards/scs/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func TestSessions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	cfg.Sessions = auth.NewSessionAdmin(scs.New())
+	c := makeServer(ctx, t, cfg)
+
+	adminCtx := metadata.AppendToOutgoingContext(ctx, MetadataUserKey, "alice@example.com", MetadataGroupsKey, "admins")
+	userCtx := metadata.AppendToOutgoingContext(ctx, MetadataUserKey, "bob@example.com", MetadataGroupsKey, "team-a")
+
+	// Without policies nobody can manage sessions
+	_, err = c.ListSessions(adminCtx, &api.ListSessionsRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+	authorizer := auth.NewPolicyAuthorizer()
+	g.Expect(authorizer.SetPolicies([]auth.Policy{{
+		Name:    "admins",
+		Groups:  []string{"admins"},
+		Actions: []string{auth.ActionListSessions, auth.ActionRevokeSessions},
+	}})).To(Succeed())
+
+	cfg.Authorizer = authorizer
+	c = makeServer(ctx, t, cfg)
+
+	res, err := c.ListSessions(adminCtx, &api.ListSessionsRequest{User: "bob@example.com"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Sessions).To(BeEmpty())
+
+	_, err = c.ListSessions(userCtx, &api.ListSessionsRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+	_, err = c.RevokeSessions(userCtx, &api.RevokeSessionsRequest{User: "alice@example.com"})
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+	_, err = c.RevokeSessions(adminCtx, &api.RevokeSessionsRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	_, err = c.RevokeSessions(adminCtx, &api.RevokeSessionsRequest{Id: "missing"})
+	g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+	revoked, err := c.RevokeSessions(adminCtx, &api.RevokeSessionsRequest{User: "bob@example.com"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(revoked.Revoked).To(BeZero())
+}
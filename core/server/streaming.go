@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+// registerStreamingHandlers registers the server streaming RPCs on the gateway mux.
+// The generated in-process handlers (RegisterCoreHandlerServer) don't support
+// streaming, so we forward these calls to the server ourselves. The responses are
+// sent as newline delimited JSON, the same as the gateway does for gRPC streams.
+// These must be registered after the generated handlers, so they take precedence.
+func registerStreamingHandlers(mux *runtime.ServeMux, server pb.CoreServer) error {
+	if err := handleServerStream(mux, "/v1/watch_objects", "/gitops_core.v1.Core/WatchObjects", server.WatchObjects); err != nil {
+		return err
+	}
+
+	return handleServerStream(mux, "/v1/controller_logs", "/gitops_core.v1.Core/GetControllerLogs", server.GetControllerLogs)
+}
+
+// handleServerStream forwards the POST requests to the path to the server
+// streaming RPC.
+func handleServerStream[Req, Res any, PReq interface {
+	*Req
+	proto.Message
+}](mux *runtime.ServeMux, path, rpcMethod string, rpc func(PReq, grpc.ServerStreamingServer[Res]) error) error {
+	return mux.HandlePath(http.MethodPost, path, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+
+		ctx, err := runtime.AnnotateIncomingContext(req.Context(), mux, req, rpcMethod, runtime.WithHTTPPathPattern(path))
+		if err != nil {
+			runtime.HTTPError(req.Context(), mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		msg := PReq(new(Req))
+		if err := inboundMarshaler.NewDecoder(req.Body).Decode(msg); err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+
+		stream := newInProcessStream[Res](ctx)
+		// Stop the server if we stop forwarding, e.g. when the client went away
+		defer stream.cancel()
+
+		go func() {
+			stream.close(rpc(msg, stream))
+		}()
+
+		ctx = runtime.NewServerMetadataContext(ctx, runtime.ServerMetadata{})
+		runtime.ForwardResponseStream(ctx, mux, outboundMarshaler, w, req, stream.recv, mux.GetForwardResponseOptions()...)
+	})
+}
+
+// inProcessStream implements grpc.ServerStreamingServer by passing the messages
+// sent by the server over a channel to the HTTP handler.
+type inProcessStream[T any] struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	msgs   chan *T
+	err    error
+}
+
+func newInProcessStream[T any](ctx context.Context) *inProcessStream[T] {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &inProcessStream[T]{
+		ctx:    ctx,
+		cancel: cancel,
+		msgs:   make(chan *T),
+	}
+}
+
+// close is called by the server once it's done sending messages.
+func (s *inProcessStream[T]) close(err error) {
+	s.err = err
+	close(s.msgs)
+}
+
+// recv returns the next message sent by the server, or io.EOF once the server is done.
+func (s *inProcessStream[T]) recv() (proto.Message, error) {
+	msg, ok := <-s.msgs
+	if !ok {
+		if s.err != nil {
+			return nil, s.err
+		}
+
+		return nil, io.EOF
+	}
+
+	m, ok := any(msg).(proto.Message)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected message type %T", msg)
+	}
+
+	return m, nil
+}
+
+func (s *inProcessStream[T]) Send(msg *T) error {
+	select {
+	case s.msgs <- msg:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+func (s *inProcessStream[T]) Context() context.Context {
+	return s.ctx
+}
+
+func (s *inProcessStream[T]) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *inProcessStream[T]) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *inProcessStream[T]) SetTrailer(metadata.MD) {}
+
+func (s *inProcessStream[T]) SendMsg(m any) error {
+	msg, ok := m.(*T)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected message type %T", m)
+	}
+
+	return s.Send(msg)
+}
+
+func (s *inProcessStream[T]) RecvMsg(any) error {
+	return status.Error(codes.Unimplemented, "client streaming is not supported")
+}
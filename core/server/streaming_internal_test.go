@@ -0,0 +1,62 @@
+package server
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/gomega"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+type streamingCoreServer struct {
+	pb.UnimplementedCoreServer
+
+	request *pb.WatchObjectsRequest
+}
+
+func (s *streamingCoreServer) WatchObjects(msg *pb.WatchObjectsRequest, stream pb.Core_WatchObjectsServer) error {
+	s.request = msg
+
+	for _, t := range []string{"ADDED", "DELETED"} {
+		if err := stream.Send(&pb.WatchObjectsResponse{Type: t, Object: &pb.Object{ClusterName: "Default"}}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestRegisterStreamingHandlers(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	mux := runtime.NewServeMux()
+	server := &streamingCoreServer{}
+
+	g.Expect(pb.RegisterCoreHandlerServer(t.Context(), mux, server)).To(Succeed())
+	g.Expect(registerStreamingHandlers(mux, server)).To(Succeed())
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/watch_objects", strings.NewReader(`{"kind": "Kustomization", "namespace": "flux-system"}`))
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, req)
+
+	g.Expect(w.Code).To(Equal(http.StatusOK))
+	g.Expect(server.request.Kind).To(Equal("Kustomization"))
+	g.Expect(server.request.Namespace).To(Equal("flux-system"))
+
+	lines := []string{}
+	scanner := bufio.NewScanner(w.Body)
+
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	g.Expect(lines).To(HaveLen(2))
+	g.Expect(lines[0]).To(ContainSubstring(`"type":"ADDED"`))
+	g.Expect(lines[1]).To(ContainSubstring(`"type":"DELETED"`))
+}
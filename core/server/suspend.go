@@ -3,10 +3,14 @@ package server
 import (
 	"context"
 	"fmt"
+	"time"
 
-	"github.com/hashicorp/go-multierror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/weaveworks/weave-gitops/core/audit"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/core/fluxsync"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
@@ -15,74 +19,148 @@ import (
 const (
 	SuspendedByAnnotation      = "metadata.weave.works/suspended-by"
 	SuspendedCommentAnnotation = "metadata.weave.works/suspended-comment"
+	// SuspendedUntilAnnotation is when the object is automatically resumed.
+	SuspendedUntilAnnotation = "metadata.weave.works/suspended-until"
+	// FreezeScheduleAnnotation is the cron schedule of the object's freeze
+	// windows, which last for FreezeDurationAnnotation.
+	FreezeScheduleAnnotation    = "metadata.weave.works/freeze-schedule"
+	FreezeDurationAnnotation    = "metadata.weave.works/freeze-duration"
+	FreezeScheduledByAnnotation = "metadata.weave.works/freeze-scheduled-by"
+	// FreezeResumedUntilAnnotation is the end of the freeze window the
+	// object was resumed during, so it isn't suspended again until the next
+	// one.
+	FreezeResumedUntilAnnotation = "metadata.weave.works/freeze-resumed-until"
 )
 
 func (cs *coreServer) ToggleSuspendResource(ctx context.Context, msg *pb.ToggleSuspendResourceRequest) (*pb.ToggleSuspendResourceResponse, error) {
 	principal := auth.Principal(ctx)
-	respErrors := multierror.Error{}
-
-	for _, obj := range msg.Objects {
-		clusterName := obj.ClusterName
-		clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
-		if err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("error getting impersonating client: %w", err), respErrors.Errors...)
-			continue
-		}
 
-		c, err := clustersClient.Scoped(clusterName)
-		if err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("getting cluster client: %w", err), respErrors.Errors...)
-			continue
-		}
+	opts, err := newSuspendOptions(msg, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		key := client.ObjectKey{
-			Name:      obj.Name,
-			Namespace: obj.Namespace,
-		}
+	if !cs.freezeWindows && (!opts.until.IsZero() || opts.freezeSchedule != "") {
+		return nil, status.Error(codes.FailedPrecondition, "freeze windows are not enabled")
+	}
 
-		gvk, err := cs.primaryKinds.Lookup(obj.Kind)
-		if err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("looking up GVK for %q: %w", obj.Kind, err), respErrors.Errors...)
-			continue
+	requestID := audit.RequestID(ctx)
+
+	action := audit.ActionResume
+	switch {
+	case opts.freezeSchedule != "":
+		action = audit.ActionScheduleFreeze
+	case opts.suspend:
+		action = audit.ActionSuspend
+	}
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	waves := cs.bulkWaves(ctx, clustersClient, msg.Objects, msg.Cascade)
+
+	results := runBulk(ctx, waves, func(ctx context.Context, obj *pb.ObjectRef) (string, error) {
+		// Each object is authorized on its own, so a denied one only fails
+		// its own result
+		if err := cs.authorizeObject(principal, auth.ActionToggleSuspendResource, obj); err != nil {
+			cs.recordAction(ctx, requestID, principal, obj, action, opts.comment, err)
+			return "", err
 		}
 
-		obj := fluxsync.ToReconcileable(*gvk)
+		revision, err := cs.suspendObject(ctx, clustersClient, principal, obj, opts)
+		cs.recordAction(ctx, requestID, principal, obj, action, opts.comment, err)
+
+		return revision, err
+	})
+
+	return &pb.ToggleSuspendResourceResponse{Results: results}, nil
+}
+
+// suspendObject suspends or resumes the object, or schedules its freeze
+// windows, and returns the revision it is at.
+func (cs *coreServer) suspendObject(ctx context.Context, clustersClient clustersmngr.Client, principal *auth.UserPrincipal, ref *pb.ObjectRef, opts suspendOptions) (string, error) {
+	clusterName := ref.ClusterName
 
-		log := cs.logger.WithValues(
-			"user", principal.ID,
-			"kind", obj.GroupVersionKind().Kind,
-			"name", key.Name,
-			"namespace", key.Namespace,
-			"principal", principal.ID,
-			"cluster", clusterName,
-		)
+	c, err := clustersClient.Scoped(clusterName)
+	if err != nil {
+		return "", fmt.Errorf("getting cluster client: %w", err)
+	}
 
-		if err := c.Get(ctx, key, obj.AsClientObject()); err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("getting reconcilable object: %w", err), respErrors.Errors...)
-			continue
+	key := client.ObjectKey{
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+	}
+
+	gvk, err := cs.primaryKinds.Lookup(ref.Kind)
+	if err != nil {
+		return "", fmt.Errorf("looking up GVK for %q: %w", ref.Kind, err)
+	}
+
+	obj := fluxsync.ToReconcileable(*gvk)
+
+	log := cs.logger.WithValues(
+		"user", principal.ID,
+		"kind", obj.GroupVersionKind().Kind,
+		"name", key.Name,
+		"namespace", key.Namespace,
+		"principal", principal.ID,
+		"cluster", clusterName,
+	)
+
+	if err := c.Get(ctx, key, obj.AsClientObject()); err != nil {
+		return "", fmt.Errorf("getting reconcilable object: %w", err)
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyClientObject())
+
+	switch {
+	case opts.freezeSchedule != "":
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[FreezeScheduleAnnotation] = opts.freezeSchedule
+		annotations[FreezeDurationAnnotation] = opts.freezeDuration
+		annotations[FreezeScheduledByAnnotation] = principal.ID
+		obj.SetAnnotations(annotations)
+
+		log.Info("Scheduling freeze window", "schedule", opts.freezeSchedule, "duration", opts.freezeDuration)
+
+		// Suspend straight away if a window is already in progress
+		if _, err := applyFreeze(obj, time.Now()); err != nil {
+			return "", err
+		}
+	default:
+		if err := obj.SetSuspended(opts.suspend); err != nil {
+			return "", err
 		}
 
-		patch := client.MergeFrom(obj.DeepCopyClientObject())
+		changeSuspendAnnotations(obj, opts.suspend, opts.comment, principal)
 
-		err = obj.SetSuspended(msg.Suspend)
-		if err != nil {
-			return nil, err
+		if !opts.until.IsZero() {
+			annotations := obj.GetAnnotations()
+			annotations[SuspendedUntilAnnotation] = opts.until.UTC().Format(time.RFC3339)
+			obj.SetAnnotations(annotations)
 		}
 
-		changeSuspendAnnotations(obj, msg.Suspend, msg.Comment, principal)
+		if !opts.suspend {
+			resumeFreeze(obj, opts.clearFreezeSchedule, time.Now())
+		}
 
-		if msg.Suspend {
-			log.Info("Suspending resource")
+		if opts.suspend {
+			log.Info("Suspending resource", "until", opts.until)
 		} else {
 			log.Info("Resuming resource")
 		}
+	}
 
-		if err := c.Patch(ctx, obj.AsClientObject(), patch); err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("patching object: %w", err), respErrors.Errors...)
-		}
+	if err := c.Patch(ctx, obj.AsClientObject(), patch); err != nil {
+		return "", fmt.Errorf("patching object: %w", err)
 	}
 
-	return &pb.ToggleSuspendResourceResponse{}, respErrors.ErrorOrNil()
+	return lastRevision(obj.AsClientObject()), nil
 }
 
 func changeSuspendAnnotations(obj fluxsync.Reconcilable, suspend bool, comment string, principal *auth.UserPrincipal) {
@@ -95,10 +173,13 @@ func changeSuspendAnnotations(obj fluxsync.Reconcilable, suspend bool, comment s
 		if comment != "" {
 			annotations[SuspendedCommentAnnotation] = comment
 		}
+		// A manual suspension lasts until it's resumed
+		delete(annotations, SuspendedUntilAnnotation)
 		obj.SetAnnotations(annotations)
 	} else {
 		delete(annotations, SuspendedByAnnotation)
 		delete(annotations, SuspendedCommentAnnotation)
+		delete(annotations, SuspendedUntilAnnotation)
 		obj.SetAnnotations(annotations)
 	}
 }
@@ -8,12 +8,16 @@ import (
 	imgautomationv1 "github.com/fluxcd/image-automation-controller/api/v1beta2"
 	reflectorv1 "github.com/fluxcd/image-reflector-controller/api/v1beta2"
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	api "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
@@ -143,7 +147,7 @@ func TestSuspend_Suspend(t *testing.T) {
 	t.Run("will error", func(t *testing.T) {
 		md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
 		outgoingCtx := metadata.NewOutgoingContext(ctx, md)
-		_, err = c.ToggleSuspendResource(outgoingCtx, &api.ToggleSuspendResourceRequest{
+		res, err := c.ToggleSuspendResource(outgoingCtx, &api.ToggleSuspendResourceRequest{
 			Objects: []*api.ObjectRef{{
 				Kind:        sourcev1.GitRepositoryKind,
 				Name:        "fakeName",
@@ -158,8 +162,88 @@ func TestSuspend_Suspend(t *testing.T) {
 			Suspend: true,
 		})
 
-		g.Expect(err.Error()).To(ContainSubstring("2 errors occurred"))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(res.Results).To(HaveLen(2))
+
+		for _, r := range res.Results {
+			g.Expect(r.Status).To(Equal("failed"))
+			g.Expect(r.Error).NotTo(BeEmpty())
+		}
+	})
+}
+
+func TestSuspend_Cascade(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: ns.Name},
+		Status: sourcev1.GitRepositoryStatus{
+			Artifact: &sourcev1.Artifact{Revision: "main@sha1:abc"},
+		},
+	}
+	infra := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: ns.Name},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: repo.Name},
+		},
+	}
+	apps := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: ns.Name},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: "other"},
+			DependsOn: []meta.NamespacedObjectReference{{Name: infra.Name}},
+		},
+	}
+	unrelated := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: ns.Name},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: "other"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, repo, infra, apps, unrelated).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	c := makeServer(ctx, t, cfg)
+
+	res, err := c.ToggleSuspendResource(ctx, &api.ToggleSuspendResourceRequest{
+		Objects: []*api.ObjectRef{{
+			Kind:        sourcev1.GitRepositoryKind,
+			Name:        repo.Name,
+			Namespace:   ns.Name,
+			ClusterName: "Default",
+		}},
+		Suspend: true,
+		Cascade: true,
 	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	names := []string{}
+	for _, r := range res.Results {
+		g.Expect(r.Status).To(Equal("succeeded"), r.Error)
+		names = append(names, r.Object.Kind+"/"+r.Object.Name)
+	}
+
+	// Dependents come after what they depend on
+	g.Expect(names).To(Equal([]string{"GitRepository/apps", "Kustomization/infra", "Kustomization/apps"}))
+	g.Expect(res.Results[0].Dependent).To(BeFalse())
+	g.Expect(res.Results[0].Revision).To(Equal("main@sha1:abc"))
+	g.Expect(res.Results[1].Dependent).To(BeTrue())
+
+	for _, ks := range []*kustomizev1.Kustomization{infra, apps, unrelated} {
+		g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(ks), ks)).To(Succeed())
+	}
+
+	g.Expect(infra.Spec.Suspend).To(BeTrue())
+	g.Expect(apps.Spec.Suspend).To(BeTrue())
+	g.Expect(unrelated.Spec.Suspend).To(BeFalse())
 }
 
 func getUnstructuredObj(t *testing.T, k client.Client, name types.NamespacedName, kind, apiVersion string) *unstructured.Unstructured {
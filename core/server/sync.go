@@ -2,11 +2,12 @@ package server
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/weaveworks/weave-gitops/core/audit"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/core/fluxsync"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
@@ -14,102 +15,124 @@ import (
 
 func (cs *coreServer) SyncFluxObject(ctx context.Context, msg *pb.SyncFluxObjectRequest) (*pb.SyncFluxObjectResponse, error) {
 	principal := auth.Principal(ctx)
-	var syncErr error
+	requestID := audit.RequestID(ctx)
 
-	for _, sync := range msg.Objects {
-		clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
-		if err != nil {
-			syncErr = errors.Join(syncErr, fmt.Errorf("error getting impersonating client: %w", err))
-			continue
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	waves := cs.bulkWaves(ctx, clustersClient, msg.Objects, msg.Cascade)
+
+	results := runBulk(ctx, waves, func(ctx context.Context, sync *pb.ObjectRef) (string, error) {
+		// Each object is authorized on its own, so a denied one only fails
+		// its own result
+		if err := cs.authorizeObject(principal, auth.ActionSyncFluxObject, sync); err != nil {
+			cs.recordAction(ctx, requestID, principal, sync, audit.ActionSync, "", err)
+			return "", err
 		}
 
-		c, err := clustersClient.Scoped(sync.ClusterName)
+		revision, err := cs.syncObject(ctx, clustersClient, principal, sync, msg.WithSource)
+		cs.recordAction(ctx, requestID, principal, sync, audit.ActionSync, "", err)
+
+		return revision, err
+	})
+
+	return &pb.SyncFluxObjectResponse{Results: results}, nil
+}
+
+// syncObject requests a reconciliation of the object, and of its source
+// first if withSource is set and the principal may sync it, and waits for it
+// to finish. It returns the revision the object is at afterwards.
+func (cs *coreServer) syncObject(ctx context.Context, clustersClient clustersmngr.Client, principal *auth.UserPrincipal, sync *pb.ObjectRef, withSource bool) (string, error) {
+	c, err := clustersClient.Scoped(sync.ClusterName)
+	if err != nil {
+		return "", fmt.Errorf("getting cluster client: %w", err)
+	}
+
+	key := client.ObjectKey{
+		Name:      sync.Name,
+		Namespace: sync.Namespace,
+	}
+
+	gvk, err := cs.primaryKinds.Lookup(sync.Kind)
+	if err != nil {
+		return "", fmt.Errorf("looking up GVK for %q: %w", sync.Kind, err)
+	}
+
+	obj := fluxsync.ToReconcileable(*gvk)
+	if err := c.Get(ctx, key, obj.AsClientObject()); err != nil {
+		return "", fmt.Errorf("error getting object: %w", err)
+	}
+
+	automation, isAutomation := obj.(fluxsync.Automation)
+	if withSource && isAutomation {
+		sourceRef := automation.SourceRef()
+
+		sourceGVK, err := cs.primaryKinds.Lookup(sourceRef.Kind())
 		if err != nil {
-			syncErr = errors.Join(syncErr, fmt.Errorf("getting cluster client: %w", err))
-			continue
+			return "", fmt.Errorf("looking up GVK for %q: %w", sourceRef.Kind(), err)
 		}
 
-		key := client.ObjectKey{
-			Name:      sync.Name,
-			Namespace: sync.Namespace,
-		}
+		sourceObj := fluxsync.ToReconcileable(*sourceGVK)
+		sourceNs := sourceRef.Namespace()
 
-		gvk, err := cs.primaryKinds.Lookup(sync.Kind)
-		if err != nil {
-			syncErr = errors.Join(syncErr, fmt.Errorf("looking up GVK for %q: %w", sync.Kind, err))
-			continue
+		// sourceRef.Namespace is an optional field in flux
+		// From the flux type reference:
+		// "Namespace of the referent, defaults to the namespace of the Kubernetes resource object that contains the reference."
+		// https://github.com/fluxcd/kustomize-controller/blob/4da17e1ffb9c2b9e057ff3440f66500394a4f765/api/v1beta2/reference_types.go#L37
+		if sourceNs == "" {
+			sourceNs = sync.Namespace
 		}
 
-		obj := fluxsync.ToReconcileable(*gvk)
-		if err := c.Get(ctx, key, obj.AsClientObject()); err != nil {
-			syncErr = errors.Join(syncErr, fmt.Errorf("error getting object: %w", err))
-			continue
+		sourceKey := client.ObjectKey{
+			Name:      sourceRef.Name(),
+			Namespace: sourceNs,
 		}
 
-		automation, isAutomation := obj.(fluxsync.Automation)
-		if msg.WithSource && isAutomation {
-			sourceRef := automation.SourceRef()
-
-			sourceGVK, err := cs.primaryKinds.Lookup(sourceRef.Kind())
-			if err != nil {
-				return nil, err
-			}
-
-			sourceObj := fluxsync.ToReconcileable(*sourceGVK)
-			sourceNs := sourceRef.Namespace()
-
-			// sourceRef.Namespace is an optional field in flux
-			// From the flux type reference:
-			// "Namespace of the referent, defaults to the namespace of the Kubernetes resource object that contains the reference."
-			// https://github.com/fluxcd/kustomize-controller/blob/4da17e1ffb9c2b9e057ff3440f66500394a4f765/api/v1beta2/reference_types.go#L37
-			if sourceNs == "" {
-				sourceNs = sync.Namespace
-			}
-
-			sourceKey := client.ObjectKey{
-				Name:      sourceRef.Name(),
-				Namespace: sourceNs,
-			}
-
-			sourceGvk := sourceObj.GroupVersionKind()
-
-			log := cs.logger.WithValues(
-				"user", principal.ID,
-				"kind", sourceRef.Kind(),
-				"name", sourceRef.Name(),
-				"namespace", sourceNs,
-			)
-			log.Info("Syncing resource")
-
-			if err := fluxsync.RequestReconciliation(ctx, c, sourceKey, sourceGvk); err != nil {
-				syncErr = errors.Join(syncErr, fmt.Errorf("requesting source reconciliation: %w", err))
-				continue
-			}
-
-			if err := fluxsync.WaitForSync(ctx, c, sourceKey, sourceObj); err != nil {
-				syncErr = errors.Join(syncErr, fmt.Errorf("syncing source: %w", err))
-				continue
-			}
+		if err := cs.authorizeObject(principal, auth.ActionSyncFluxObject, &pb.ObjectRef{
+			Kind:        sourceRef.Kind(),
+			Name:        sourceKey.Name,
+			Namespace:   sourceKey.Namespace,
+			ClusterName: sync.ClusterName,
+		}); err != nil {
+			return "", fmt.Errorf("syncing source: %w", err)
 		}
 
+		sourceGvk := sourceObj.GroupVersionKind()
+
 		log := cs.logger.WithValues(
 			"user", principal.ID,
-			"kind", obj.GroupVersionKind().Kind,
-			"name", key.Name,
-			"namespace", key.Namespace,
+			"kind", sourceRef.Kind(),
+			"name", sourceRef.Name(),
+			"namespace", sourceNs,
 		)
 		log.Info("Syncing resource")
 
-		if err := fluxsync.RequestReconciliation(ctx, c, key, *gvk); err != nil {
-			syncErr = errors.Join(syncErr, fmt.Errorf("requesting reconciliation: %w", err))
-			continue
+		if err := fluxsync.RequestReconciliation(ctx, c, sourceKey, sourceGvk); err != nil {
+			return "", fmt.Errorf("requesting source reconciliation: %w", err)
 		}
 
-		if err := fluxsync.WaitForSync(ctx, c, key, obj); err != nil {
-			syncErr = errors.Join(syncErr, fmt.Errorf("syncing automation: %w", err))
-			continue
+		if err := fluxsync.WaitForSync(ctx, c, sourceKey, sourceObj); err != nil {
+			return "", fmt.Errorf("syncing source: %w", err)
 		}
 	}
 
-	return &pb.SyncFluxObjectResponse{}, syncErr
+	log := cs.logger.WithValues(
+		"user", principal.ID,
+		"kind", obj.GroupVersionKind().Kind,
+		"name", key.Name,
+		"namespace", key.Namespace,
+	)
+	log.Info("Syncing resource")
+
+	if err := fluxsync.RequestReconciliation(ctx, c, key, *gvk); err != nil {
+		return lastRevision(obj.AsClientObject()), fmt.Errorf("requesting reconciliation: %w", err)
+	}
+
+	if err := fluxsync.WaitForSync(ctx, c, key, obj); err != nil {
+		return lastRevision(obj.AsClientObject()), fmt.Errorf("syncing automation: %w", err)
+	}
+
+	return lastRevision(obj.AsClientObject()), nil
 }
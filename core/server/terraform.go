@@ -0,0 +1,306 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	tfv1alpha2 "github.com/flux-iac/tofu-controller/api/v1alpha2"
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/audit"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/terraform"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func (cs *coreServer) ListTerraformObjects(ctx context.Context, msg *pb.ListTerraformObjectsRequest) (*pb.ListTerraformObjectsResponse, error) {
+	respErrors := []*pb.ListError{}
+
+	var (
+		clustersClient clustersmngr.Client
+		err            error
+	)
+
+	if msg.ClusterName != "" {
+		clustersClient, err = cs.clustersManager.GetImpersonatedClientForCluster(ctx, auth.Principal(ctx), msg.ClusterName)
+	} else {
+		clustersClient, err = cs.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	}
+
+	if err != nil {
+		var merr *multierror.Error
+		if errors.As(err, &merr) {
+			for _, err := range merr.Errors {
+				var cerr *clustersmngr.ClientError
+				if errors.As(err, &cerr) {
+					respErrors = append(respErrors, &pb.ListError{ClusterName: cerr.ClusterName, Message: cerr.Error()})
+				}
+			}
+		}
+	}
+
+	if clustersClient == nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	clist := clustersmngr.NewClusteredList(func() client.ObjectList {
+		return &tfv1alpha2.TerraformList{}
+	})
+
+	if err := clustersClient.ClusteredList(ctx, clist, true, client.InNamespace(msg.Namespace)); err != nil {
+		var errs clustersmngr.ClusteredListError
+		if !errors.As(err, &errs) {
+			return nil, err
+		}
+
+		for _, e := range errs.Errors {
+			respErrors = append(respErrors, &pb.ListError{ClusterName: e.Cluster, Namespace: e.Namespace, Message: e.Err.Error()})
+		}
+	}
+
+	results := []*pb.TerraformObject{}
+
+	for clusterName, lists := range clist.Lists() {
+		if msg.ClusterName != "" && clusterName != msg.ClusterName {
+			continue
+		}
+
+		for _, l := range lists {
+			list, ok := l.(*tfv1alpha2.TerraformList)
+			if !ok {
+				continue
+			}
+
+			for i := range list.Items {
+				results = append(results, terraformObjectToProto(clusterName, &list.Items[i]))
+			}
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.ClusterName != b.ClusterName {
+			return a.ClusterName < b.ClusterName
+		}
+
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+
+		return a.Name < b.Name
+	})
+
+	return &pb.ListTerraformObjectsResponse{
+		Objects: results,
+		Errors:  respErrors,
+	}, nil
+}
+
+func terraformObjectToProto(clusterName string, tf *tfv1alpha2.Terraform) *pb.TerraformObject {
+	obj := &pb.TerraformObject{
+		ClusterName: clusterName,
+		Namespace:   tf.Namespace,
+		Name:        tf.Name,
+		SourceRef: &pb.ObjectRef{
+			Kind:        tf.Spec.SourceRef.Kind,
+			Name:        tf.Spec.SourceRef.Name,
+			Namespace:   tf.Spec.SourceRef.Namespace,
+			ClusterName: clusterName,
+		},
+		Suspended:           tf.Spec.Suspend,
+		LastAppliedRevision: tf.Status.LastAppliedRevision,
+		LastPlannedRevision: tf.Status.LastPlannedRevision,
+		PendingPlan:         tf.Status.Plan.Pending,
+		ApprovePlan:         tf.Spec.ApprovePlan,
+		StoreReadablePlan:   tf.Spec.StoreReadablePlan,
+	}
+
+	if obj.SourceRef.Namespace == "" {
+		obj.SourceRef.Namespace = tf.Namespace
+	}
+
+	if ready := apimeta.FindStatusCondition(tf.Status.Conditions, meta.ReadyCondition); ready != nil {
+		obj.Ready = string(ready.Status)
+		obj.Message = ready.Message
+	}
+
+	return obj
+}
+
+func (cs *coreServer) GetTerraformPlan(ctx context.Context, msg *pb.GetTerraformPlanRequest) (*pb.GetTerraformPlanResponse, error) {
+	if msg.ClusterName == "" {
+		msg.ClusterName = DefaultCluster
+	}
+
+	c, err := cs.terraformClient(ctx, auth.Principal(ctx), msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	tf := &tfv1alpha2.Terraform{}
+	if err := c.Get(ctx, client.ObjectKey{Name: msg.Name, Namespace: msg.Namespace}, tf); err != nil {
+		return nil, wrapK8sAPIError("get terraform", err)
+	}
+
+	plan, err := terraform.PendingPlan(ctx, c, tf)
+	if err != nil {
+		if errors.Is(err, terraform.ErrNoPendingPlan) || errors.Is(err, terraform.ErrNoReadablePlan) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, wrapK8sAPIError("get terraform plan", err)
+	}
+
+	return &pb.GetTerraformPlanResponse{
+		PlanId: plan.ID,
+		Format: plan.Format,
+		Output: plan.Output,
+	}, nil
+}
+
+func (cs *coreServer) ApproveTerraformPlan(ctx context.Context, msg *pb.ApproveTerraformPlanRequest) (*pb.ApproveTerraformPlanResponse, error) {
+	principal := auth.Principal(ctx)
+	requestID := audit.RequestID(ctx)
+
+	if msg.ClusterName == "" {
+		msg.ClusterName = DefaultCluster
+	}
+
+	ref := &pb.ObjectRef{
+		Kind:        tfv1alpha2.TerraformKind,
+		Name:        msg.Name,
+		Namespace:   msg.Namespace,
+		ClusterName: msg.ClusterName,
+	}
+
+	if err := cs.authorizeObject(principal, auth.ActionApproveTerraformPlan, ref); err != nil {
+		cs.recordAction(ctx, requestID, principal, ref, audit.ActionApprovePlan, msg.PlanId, err)
+		return nil, err
+	}
+
+	res, err := cs.approveTerraformPlan(ctx, principal, msg)
+
+	comment := msg.PlanId
+	if res != nil {
+		comment = res.PlanId
+	}
+
+	cs.recordAction(ctx, requestID, principal, ref, audit.ActionApprovePlan, comment, err)
+
+	return res, err
+}
+
+func (cs *coreServer) approveTerraformPlan(ctx context.Context, principal *auth.UserPrincipal, msg *pb.ApproveTerraformPlanRequest) (*pb.ApproveTerraformPlanResponse, error) {
+	c, err := cs.terraformClient(ctx, principal, msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	tf := &tfv1alpha2.Terraform{}
+	if err := c.Get(ctx, client.ObjectKey{Name: msg.Name, Namespace: msg.Namespace}, tf); err != nil {
+		return nil, wrapK8sAPIError("get terraform", err)
+	}
+
+	if msg.PlanId != "" && tf.Status.Plan.Pending != "" && msg.PlanId != tf.Status.Plan.Pending {
+		return nil, status.Errorf(codes.FailedPrecondition, "plan %s is no longer pending, %s is", msg.PlanId, tf.Status.Plan.Pending)
+	}
+
+	cs.logger.Info("Approving Terraform plan",
+		"principal", principal.ID,
+		"name", msg.Name,
+		"namespace", msg.Namespace,
+		"cluster", msg.ClusterName,
+		"plan", tf.Status.Plan.Pending,
+	)
+
+	planID, err := terraform.Approve(ctx, c, tf)
+	if err != nil {
+		if errors.Is(err, terraform.ErrNoPendingPlan) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, wrapK8sAPIError("patch terraform", err)
+	}
+
+	return &pb.ApproveTerraformPlanResponse{PlanId: planID}, nil
+}
+
+func (cs *coreServer) ReplanTerraformObject(ctx context.Context, msg *pb.ReplanTerraformObjectRequest) (*pb.ReplanTerraformObjectResponse, error) {
+	principal := auth.Principal(ctx)
+	requestID := audit.RequestID(ctx)
+
+	if msg.ClusterName == "" {
+		msg.ClusterName = DefaultCluster
+	}
+
+	ref := &pb.ObjectRef{
+		Kind:        tfv1alpha2.TerraformKind,
+		Name:        msg.Name,
+		Namespace:   msg.Namespace,
+		ClusterName: msg.ClusterName,
+	}
+
+	if err := cs.authorizeObject(principal, auth.ActionReplanTerraformObject, ref); err != nil {
+		cs.recordAction(ctx, requestID, principal, ref, audit.ActionReplan, "", err)
+		return nil, err
+	}
+
+	err := cs.replanTerraformObject(ctx, principal, msg)
+	cs.recordAction(ctx, requestID, principal, ref, audit.ActionReplan, "", err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReplanTerraformObjectResponse{}, nil
+}
+
+func (cs *coreServer) replanTerraformObject(ctx context.Context, principal *auth.UserPrincipal, msg *pb.ReplanTerraformObjectRequest) error {
+	c, err := cs.terraformClient(ctx, principal, msg.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	tf := &tfv1alpha2.Terraform{}
+	if err := c.Get(ctx, client.ObjectKey{Name: msg.Name, Namespace: msg.Namespace}, tf); err != nil {
+		return wrapK8sAPIError("get terraform", err)
+	}
+
+	cs.logger.Info("Replanning Terraform",
+		"principal", principal.ID,
+		"name", msg.Name,
+		"namespace", msg.Namespace,
+		"cluster", msg.ClusterName,
+	)
+
+	if err := terraform.Replan(ctx, c, tf); err != nil {
+		return wrapK8sAPIError("replan terraform", err)
+	}
+
+	return nil
+}
+
+// terraformClient returns a client for the cluster that impersonates the
+// principal, so that they need RBAC on the Terraform objects, not a
+// kubeconfig.
+func (cs *coreServer) terraformClient(ctx context.Context, principal *auth.UserPrincipal, clusterName string) (client.Client, error) {
+	clustersClient, err := cs.clustersManager.GetImpersonatedClientForCluster(ctx, principal, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	c, err := clustersClient.Scoped(clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scoped client for cluster=%s: %w", clusterName, err)
+	}
+
+	return c, nil
+}
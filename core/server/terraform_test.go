@@ -0,0 +1,101 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	tfv1alpha2 "github.com/flux-iac/tofu-controller/api/v1alpha2"
+	"github.com/fluxcd/pkg/apis/meta"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestTerraformPlans(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}}
+
+	tf := &tfv1alpha2.Terraform{
+		ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "flux-system"},
+		Spec: tfv1alpha2.TerraformSpec{
+			StoreReadablePlan: "human",
+			SourceRef:         tfv1alpha2.CrossNamespaceSourceReference{Kind: "GitRepository", Name: "infra"},
+		},
+		Status: tfv1alpha2.TerraformStatus{
+			Plan: tfv1alpha2.PlanStatus{Pending: "plan-main-b8e362c2"},
+			Conditions: []metav1.Condition{{
+				Type:    meta.ReadyCondition,
+				Status:  metav1.ConditionUnknown,
+				Reason:  "TerraformPlannedWithChanges",
+				Message: "Plan generated",
+			}},
+		},
+	}
+
+	plan := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "tfplan-default-infra", Namespace: "flux-system"},
+		Data:       map[string]string{"tfplan": "Plan: 1 to add, 0 to change, 0 to destroy."},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ns, tf, plan).WithStatusSubresource(tf).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	c := makeServer(ctx, t, cfg)
+
+	userCtx := metadata.AppendToOutgoingContext(ctx, MetadataUserKey, "bob@example.com")
+
+	list, err := c.ListTerraformObjects(userCtx, &pb.ListTerraformObjectsRequest{Namespace: "flux-system"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(list.Errors).To(BeEmpty())
+	g.Expect(list.Objects).To(HaveLen(1))
+	g.Expect(list.Objects[0].ClusterName).To(Equal("Default"))
+	g.Expect(list.Objects[0].Name).To(Equal("infra"))
+	g.Expect(list.Objects[0].SourceRef.Namespace).To(Equal("flux-system"))
+	g.Expect(list.Objects[0].Ready).To(Equal("Unknown"))
+	g.Expect(list.Objects[0].PendingPlan).To(Equal("plan-main-b8e362c2"))
+
+	res, err := c.GetTerraformPlan(userCtx, &pb.GetTerraformPlanRequest{Namespace: "flux-system", Name: "infra"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.PlanId).To(Equal("plan-main-b8e362c2"))
+	g.Expect(res.Format).To(Equal("human"))
+	g.Expect(res.Output).To(ContainSubstring("1 to add"))
+
+	_, err = c.ApproveTerraformPlan(userCtx, &pb.ApproveTerraformPlanRequest{Namespace: "flux-system", Name: "infra", PlanId: "plan-main-0d5e1c2a"})
+	g.Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+	approved, err := c.ApproveTerraformPlan(userCtx, &pb.ApproveTerraformPlanRequest{Namespace: "flux-system", Name: "infra", PlanId: "plan-main-b8e362c2"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(approved.PlanId).To(Equal("plan-main-b8e362c2"))
+
+	got := &tfv1alpha2.Terraform{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(tf), got)).To(Succeed())
+	g.Expect(got.Spec.ApprovePlan).To(Equal("plan-main-b8e362c2"))
+
+	_, err = c.ReplanTerraformObject(userCtx, &pb.ReplanTerraformObjectRequest{Namespace: "flux-system", Name: "infra"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(tf), got)).To(Succeed())
+	g.Expect(got.Status.Plan.Pending).To(BeEmpty())
+	g.Expect(got.Annotations).To(HaveKey(meta.ReconcileRequestAnnotation))
+
+	_, err = c.GetTerraformPlan(userCtx, &pb.GetTerraformPlanRequest{Namespace: "flux-system", Name: "infra"})
+	g.Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+	_, err = c.GetTerraformPlan(userCtx, &pb.GetTerraformPlanRequest{Namespace: "flux-system", Name: "missing"})
+	g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+}
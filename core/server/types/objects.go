@@ -9,11 +9,6 @@ import (
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 )
 
-type HelmReleaseStorage struct {
-	Name     string `json:"name,omitempty"`
-	Manifest string `json:"manifest,omitempty"`
-}
-
 func K8sObjectToProto(object client.Object, clusterName, tenant string, inventory []*pb.GroupVersionKind, info string) (*pb.Object, error) {
 	var buf bytes.Buffer
 
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/server/types"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func (cs *coreServer) WatchObjects(msg *pb.WatchObjectsRequest, stream pb.Core_WatchObjectsServer) error {
+	ctx := stream.Context()
+
+	gvk, err := cs.primaryKinds.Lookup(msg.Kind)
+	if err != nil {
+		return err
+	}
+
+	var clustersClient clustersmngr.Client
+
+	if msg.ClusterName != "" {
+		clustersClient, err = cs.clustersManager.GetImpersonatedClientForCluster(ctx, auth.Principal(ctx), msg.ClusterName)
+	} else {
+		clustersClient, err = cs.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	}
+
+	if err != nil {
+		var merr *multierror.Error
+		if !errors.As(err, &merr) || clustersClient == nil {
+			return fmt.Errorf("error getting impersonating client: %w", err)
+		}
+
+		for _, err := range merr.Errors {
+			var cerr *clustersmngr.ClientError
+			if errors.As(err, &cerr) {
+				if err := stream.Send(&pb.WatchObjectsResponse{Error: &pb.ListError{ClusterName: cerr.ClusterName, Message: cerr.Error()}}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	listOptions := []client.ListOption{
+		client.InNamespace(msg.Namespace),
+	}
+	if len(msg.Labels) > 0 {
+		listOptions = append(listOptions, client.MatchingLabels(msg.Labels))
+	}
+
+	events := clustersClient.ClusteredWatch(ctx, func() client.ObjectList {
+		list := unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(*gvk)
+		return &list
+	}, true, listOptions...)
+
+	clusterUserNamespaces := clustersClient.Namespaces()
+
+	for ev := range events {
+		res := &pb.WatchObjectsResponse{Type: string(ev.Type)}
+
+		if ev.Err != nil {
+			res.Error = &pb.ListError{ClusterName: ev.Cluster, Namespace: ev.Namespace, Message: ev.Err.Error()}
+		} else {
+			obj, err := watchEventObjectToProto(ev, gvk.Kind, clusterUserNamespaces)
+			if err != nil {
+				res.Error = &pb.ListError{ClusterName: ev.Cluster, Namespace: ev.Namespace, Message: err.Error()}
+			}
+
+			res.Object = obj
+		}
+
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func watchEventObjectToProto(ev clustersmngr.ClusteredWatchEvent, kind string, clusterUserNamespaces map[string][]v1.Namespace) (*pb.Object, error) {
+	obj := ev.Object
+
+	if kind == "Secret" {
+		unstructuredObj, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("unexpected secret type %T", obj)
+		}
+
+		var err error
+
+		obj, err = sanitizeSecret(unstructuredObj)
+		if err != nil {
+			return nil, fmt.Errorf("error sanitizing secrets: %w", err)
+		}
+	}
+
+	tenant := GetTenant(obj.GetNamespace(), ev.Cluster, clusterUserNamespaces)
+
+	o, err := types.K8sObjectToProto(obj, ev.Cluster, tenant, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("converting object: %w", err)
+	}
+
+	return o, nil
+}
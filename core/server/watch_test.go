@@ -0,0 +1,91 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestWatchObjects(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-namespace",
+		},
+	}
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-kustomization",
+			Namespace: ns.Name,
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, kust).Build()
+
+	cfg := makeServerConfig(t, fakeClient, "")
+	c := makeServer(ctx, t, cfg)
+
+	stream, err := c.WatchObjects(ctx, &pb.WatchObjectsRequest{
+		Kind: kustomizev1.KustomizationKind,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	events := make(chan *pb.WatchObjectsResponse)
+
+	go func() {
+		defer close(events)
+
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			events <- res
+		}
+	}()
+
+	// The watch is started asynchronously, so keep changing the object until
+	// we see the change come through.
+	var res *pb.WatchObjectsResponse
+
+	g.Eventually(func() bool {
+		patch := client.MergeFrom(kust.DeepCopy())
+		kust.SetLabels(map[string]string{"changed": fmt.Sprint(time.Now().UnixNano())})
+		g.Expect(fakeClient.Patch(ctx, kust, patch)).To(Succeed())
+
+		select {
+		case res = <-events:
+			return true
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second).Should(BeTrue())
+
+	g.Expect(res.Error).To(BeNil())
+	g.Expect(res.Type).To(Equal(string(watch.Modified)))
+	g.Expect(res.Object.ClusterName).To(Equal("Default"))
+	g.Expect(res.Object.Payload).To(ContainSubstring("my-kustomization"))
+
+	g.Expect(fakeClient.Delete(ctx, kust)).To(Succeed())
+
+	g.Eventually(events).Should(Receive(HaveField("Type", string(watch.Deleted))))
+}
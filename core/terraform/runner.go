@@ -0,0 +1,239 @@
+package terraform
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	tfv1alpha2 "github.com/flux-iac/tofu-controller/api/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// maxLogLineSize is the longest log line that's streamed, as Terraform
+// prints some resources' attributes on a single line.
+const maxLogLineSize = 1 << 20
+
+// runnerRetryInterval is how long to wait for the runner pod to come back
+// when following its logs.
+var runnerRetryInterval = 2 * time.Second
+
+// ErrNoRunnerPod is returned when the Terraform object has no runner pod,
+// which only exists while it's being reconciled unless
+// spec.alwaysCleanupRunnerPod is false.
+var ErrNoRunnerPod = errors.New("no runner pod found")
+
+// errNoContainer is returned when the runner pod doesn't have the container
+// to stream the logs of, which the API server reports as a bad request like
+// the container not having started yet.
+var errNoContainer = errors.New("no such container")
+
+// errWriting is returned when the logs can't be written out.
+var errWriting = errors.New("failed to write the logs")
+
+// LogOptions selects the runner logs to stream.
+type LogOptions struct {
+	// Container defaults to the runner pod's only container.
+	Container string
+	// Follow keeps streaming the logs, across restarts of the runner pod,
+	// until the context is cancelled.
+	Follow bool
+	// Since only streams the logs newer than this, all of them if zero.
+	Since time.Duration
+	// Tail only streams this many of the latest lines, all of them if
+	// negative.
+	Tail int64
+	// Status is written a line when following starts waiting for the
+	// runner pod, nothing if it's nil.
+	Status io.Writer
+}
+
+// RunnerPod returns the newest runner pod of the Terraform object,
+// discovered by tofu-controller's runner label rather than by its name.
+func RunnerPod(ctx context.Context, c kubernetes.Interface, namespace, name string) (*corev1.Pod, error) {
+	pods, err := c.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{tfv1alpha2.RunnerLabel: name}.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the runner pods of Terraform '%s': %w", name, err)
+	}
+
+	if len(pods.Items) == 0 {
+		return nil, fmt.Errorf("%w for Terraform '%s' in namespace '%s'", ErrNoRunnerPod, name, namespace)
+	}
+
+	newest := &pods.Items[0]
+	for i := range pods.Items {
+		if pods.Items[i].CreationTimestamp.After(newest.CreationTimestamp.Time) {
+			newest = &pods.Items[i]
+		}
+	}
+
+	return newest, nil
+}
+
+// StreamRunnerLogs writes the timestamped logs of the Terraform object's
+// runner pod to out. When following, it waits for the runner pod to be
+// (re)created, and carries on from the last line it wrote. Errors that
+// waiting won't fix are returned.
+func StreamRunnerLogs(ctx context.Context, c kubernetes.Interface, namespace, name string, opts LogOptions, out io.Writer) error {
+	var (
+		last    time.Time
+		waiting bool
+	)
+
+	for {
+		pod, err := RunnerPod(ctx, c, namespace, name)
+		if err == nil {
+			var streamed time.Time
+
+			streamed, err = streamPodLogs(ctx, c, pod, opts, last, out)
+			if streamed.After(last) {
+				last = streamed
+				waiting = false
+			}
+		}
+
+		if !opts.Follow {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if !isTransient(err) {
+			return err
+		}
+
+		if !waiting && opts.Status != nil {
+			if err != nil {
+				fmt.Fprintf(opts.Status, "waiting for the runner pod of Terraform '%s': %s\n", name, err)
+			} else {
+				fmt.Fprintf(opts.Status, "waiting for the runner pod of Terraform '%s' to restart\n", name)
+			}
+		}
+
+		waiting = true
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(runnerRetryInterval):
+		}
+	}
+}
+
+// isTransient tells whether following the logs should carry on after err,
+// because the runner pod is gone, restarting, or its container hasn't
+// started yet.
+func isTransient(err error) bool {
+	switch {
+	case err == nil, errors.Is(err, ErrNoRunnerPod):
+		return true
+	case errors.Is(err, errNoContainer), errors.Is(err, errWriting):
+		return false
+	}
+
+	var apiStatus apierrors.APIStatus
+	if !errors.As(err, &apiStatus) {
+		// The connection to the API server was lost
+		return true
+	}
+
+	return apierrors.IsNotFound(err) ||
+		apierrors.IsBadRequest(err) ||
+		apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsInternalError(err)
+}
+
+// streamPodLogs writes the logs of the pod newer than the last line already
+// written, and returns the timestamp of the last line it wrote.
+func streamPodLogs(ctx context.Context, c kubernetes.Interface, pod *corev1.Pod, opts LogOptions, last time.Time, out io.Writer) (time.Time, error) {
+	if opts.Container != "" && !hasContainer(pod, opts.Container) {
+		return last, fmt.Errorf("%w '%s' in pod '%s'", errNoContainer, opts.Container, pod.Name)
+	}
+
+	logOptions := &corev1.PodLogOptions{
+		Container:  opts.Container,
+		Follow:     opts.Follow,
+		Timestamps: true,
+	}
+
+	if last.IsZero() {
+		if opts.Tail >= 0 {
+			logOptions.TailLines = &opts.Tail
+		}
+
+		if opts.Since > 0 {
+			sinceSeconds := int64(opts.Since.Seconds())
+			logOptions.SinceSeconds = &sinceSeconds
+		}
+	} else {
+		// SinceTime is truncated to the second, the lines that were
+		// already written are skipped below
+		sinceTime := metav1.NewTime(last)
+		logOptions.SinceTime = &sinceTime
+	}
+
+	stream, err := c.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).Stream(ctx)
+	if err != nil {
+		return last, fmt.Errorf("failed to stream the logs of pod '%s': %w", pod.Name, err)
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 64*1024), maxLogLineSize)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if timestamp, ok := lineTimestamp(line); ok {
+			if !timestamp.After(last) {
+				continue
+			}
+
+			last = timestamp
+		}
+
+		if _, err := fmt.Fprintln(out, line); err != nil {
+			return last, fmt.Errorf("%w: %w", errWriting, err)
+		}
+	}
+
+	return last, scanner.Err()
+}
+
+func hasContainer(pod *corev1.Pod, name string) bool {
+	for _, containers := range [][]corev1.Container{pod.Spec.InitContainers, pod.Spec.Containers} {
+		for _, container := range containers {
+			if container.Name == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// lineTimestamp parses the timestamp the API server prefixes log lines with.
+func lineTimestamp(line string) (time.Time, bool) {
+	prefix, _, _ := strings.Cut(line, " ")
+
+	timestamp, err := time.Parse(time.RFC3339Nano, prefix)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return timestamp, true
+}
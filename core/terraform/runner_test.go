@@ -0,0 +1,156 @@
+package terraform_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	tfv1alpha2 "github.com/flux-iac/tofu-controller/api/v1alpha2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+
+	"github.com/weaveworks/weave-gitops/core/terraform"
+)
+
+func TestRunnerPod(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	now := time.Now()
+
+	c := fake.NewSimpleClientset(
+		runnerPod("infra-tf-runner", "infra", now.Add(-time.Hour)),
+		runnerPod("infra-tf-runner-restarted", "infra", now),
+		runnerPod("other-tf-runner", "other", now),
+	)
+
+	pod, err := terraform.RunnerPod(ctx, c, "flux-system", "infra")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pod.Name).To(Equal("infra-tf-runner-restarted"))
+
+	_, err = terraform.RunnerPod(ctx, c, "flux-system", "missing")
+	g.Expect(err).To(MatchError(terraform.ErrNoRunnerPod))
+}
+
+func TestStreamRunnerLogs(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := fake.NewSimpleClientset(runnerPod("infra-tf-runner", "infra", time.Now()))
+
+	out := &bytes.Buffer{}
+	g.Expect(terraform.StreamRunnerLogs(context.Background(), c, "flux-system", "infra", terraform.LogOptions{Tail: -1}, out)).To(Succeed())
+	g.Expect(out.String()).To(Equal("fake logs\n"))
+
+	err := terraform.StreamRunnerLogs(context.Background(), c, "flux-system", "missing", terraform.LogOptions{Tail: -1}, out)
+	g.Expect(err).To(MatchError(terraform.ErrNoRunnerPod))
+
+	// Following waits for the runner pod until it's cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	g.Expect(terraform.StreamRunnerLogs(ctx, c, "flux-system", "missing", terraform.LogOptions{Follow: true, Tail: -1}, out)).To(Succeed())
+}
+
+func TestStreamRunnerLogsFollow(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	lines := []string{
+		"2024-01-01T10:00:00.100000000Z init",
+		"2024-01-01T10:00:00.700000000Z plan",
+		"2024-01-01T10:00:01.200000000Z apply",
+		"2024-01-01T10:00:01.500000000Z done",
+	}
+
+	// The runner pod restarts after planning, and the API server resends
+	// the lines of the second the logs are resumed from
+	logs := [][]string{lines[:2], lines}
+	sinceTimes := []string{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/v1/namespaces/flux-system/pods":
+			pod := runnerPod("infra-tf-runner", "infra", time.Now())
+			_ = json.NewEncoder(w).Encode(&corev1.PodList{
+				TypeMeta: metav1.TypeMeta{Kind: "PodList", APIVersion: "v1"},
+				Items:    []corev1.Pod{*pod},
+			})
+		case "/api/v1/namespaces/flux-system/pods/infra-tf-runner/log":
+			sinceTimes = append(sinceTimes, r.URL.Query().Get("sinceTime"))
+
+			if len(logs) == 0 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			fmt.Fprintln(w, strings.Join(logs[0], "\n"))
+			logs = logs[1:]
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := kubernetes.NewForConfig(&rest.Config{Host: srv.URL})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// The writer fails on the last line to stop following
+	out := &failingWriter{lines: 3}
+	status := &bytes.Buffer{}
+
+	err = terraform.StreamRunnerLogs(ctx, c, "flux-system", "infra", terraform.LogOptions{Follow: true, Tail: -1, Status: status}, out)
+	g.Expect(err).To(MatchError(ContainSubstring("disk full")))
+
+	g.Expect(sinceTimes).To(Equal([]string{"", "2024-01-01T10:00:00Z"}))
+	g.Expect(out.String()).To(Equal(strings.Join(lines[:3], "\n") + "\n"))
+	g.Expect(status.String()).To(Equal("waiting for the runner pod of Terraform 'infra' to restart\n"))
+
+	// Errors that waiting won't fix are returned rather than retried
+	err = terraform.StreamRunnerLogs(ctx, c, "flux-system", "infra", terraform.LogOptions{Follow: true, Tail: -1, Container: "missing"}, out)
+	g.Expect(err).To(MatchError(ContainSubstring("no such container 'missing'")))
+}
+
+// failingWriter fails to write after writing some lines.
+type failingWriter struct {
+	bytes.Buffer
+	lines int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.lines == 0 {
+		return 0, errors.New("disk full")
+	}
+
+	w.lines--
+
+	return w.Buffer.Write(p)
+}
+
+func runnerPod(name, terraformName string, created time.Time) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "flux-system",
+			Labels:            map[string]string{tfv1alpha2.RunnerLabel: terraformName},
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "runner"}},
+		},
+	}
+}
@@ -0,0 +1,145 @@
+// Package terraform reads the plans of tofu-controller's Terraform objects,
+// and approves or replans them.
+package terraform
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	tfv1alpha2 "github.com/flux-iac/tofu-controller/api/v1alpha2"
+	"github.com/fluxcd/pkg/apis/meta"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/fluxsync"
+)
+
+const (
+	// PlanFormatHuman plans are stored as the output of terraform show.
+	PlanFormatHuman = "human"
+	// PlanFormatJSON plans are stored as the output of terraform show -json.
+	PlanFormatJSON = "json"
+
+	// planKey holds the plan in the ConfigMap or Secret.
+	planKey = "tfplan"
+	// fieldManager is tofu-controller's, so replanning doesn't take over the
+	// ownership of the status fields.
+	fieldManager = "tf-controller"
+)
+
+// ErrNoPendingPlan is returned when there's no plan to read or approve.
+var ErrNoPendingPlan = errors.New("there is no plan pending")
+
+// ErrNoReadablePlan is returned when the Terraform object doesn't store a
+// readable plan, as its binary plan can only be read by Terraform.
+var ErrNoReadablePlan = errors.New("no readable plan is stored, set spec.storeReadablePlan to human or json")
+
+// Plan is the pending plan of a Terraform object.
+type Plan struct {
+	// ID identifies the plan, e.g. plan-main-b8e362c2.
+	ID string
+	// Format is PlanFormatHuman or PlanFormatJSON.
+	Format string
+	Output string
+}
+
+// PendingPlan returns the pending plan of the Terraform object, decoded from
+// the ConfigMap or Secret tofu-controller stores its readable form in.
+func PendingPlan(ctx context.Context, c client.Client, tf *tfv1alpha2.Terraform) (*Plan, error) {
+	if tf.Status.Plan.Pending == "" {
+		return nil, ErrNoPendingPlan
+	}
+
+	plan := &Plan{ID: tf.Status.Plan.Pending, Format: tf.Spec.StoreReadablePlan}
+
+	switch tf.Spec.StoreReadablePlan {
+	case PlanFormatHuman:
+		cm := &corev1.ConfigMap{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: tf.Namespace, Name: planName(tf)}, cm); err != nil {
+			return nil, fmt.Errorf("failed to get the plan of Terraform '%s': %w", tf.Name, err)
+		}
+
+		plan.Output = cm.Data[planKey]
+	case PlanFormatJSON:
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: tf.Namespace, Name: planName(tf) + ".json"}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get the plan of Terraform '%s': %w", tf.Name, err)
+		}
+
+		output, err := gunzip(secret.Data[planKey])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the plan of Terraform '%s': %w", tf.Name, err)
+		}
+
+		plan.Output = string(output)
+	default:
+		return nil, ErrNoReadablePlan
+	}
+
+	return plan, nil
+}
+
+// Approve approves the pending plan of the Terraform object, which
+// tofu-controller then applies. It returns the ID of the approved plan.
+func Approve(ctx context.Context, c client.Client, tf *tfv1alpha2.Terraform) (string, error) {
+	if tf.Status.Plan.Pending == "" {
+		return "", ErrNoPendingPlan
+	}
+
+	patch := client.MergeFrom(tf.DeepCopy())
+	tf.Spec.ApprovePlan = tf.Status.Plan.Pending
+
+	if err := c.Patch(ctx, tf, patch); err != nil {
+		return "", err
+	}
+
+	return tf.Spec.ApprovePlan, nil
+}
+
+// Replan discards the pending plan of the Terraform object, and requests a
+// reconciliation so that tofu-controller plans again.
+func Replan(ctx context.Context, c client.Client, tf *tfv1alpha2.Terraform) error {
+	statusPatch := client.MergeFrom(tf.DeepCopy())
+
+	apimeta.SetStatusCondition(&tf.Status.Conditions, metav1.Condition{
+		Type:    meta.ReadyCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  "ReplanRequested",
+		Message: "Replan requested",
+	})
+	tf.Status.Plan.Pending = ""
+	tf.Status.LastPlannedRevision = ""
+	tf.Status.LastAttemptedRevision = ""
+
+	if err := c.Status().Patch(ctx, tf, statusPatch, client.FieldOwner(fieldManager)); err != nil {
+		return fmt.Errorf("failed to discard the pending plan: %w", err)
+	}
+
+	if err := fluxsync.RequestReconciliation(ctx, c, client.ObjectKeyFromObject(tf), tfv1alpha2.GroupVersion.WithKind(tfv1alpha2.TerraformKind)); err != nil {
+		return fmt.Errorf("failed to request a reconciliation: %w", err)
+	}
+
+	return nil
+}
+
+// planName is the name of the plan's ConfigMap, and of its Secret without
+// the format suffix.
+func planName(tf *tfv1alpha2.Terraform) string {
+	return fmt.Sprintf("tfplan-%s-%s", tf.WorkspaceName(), tf.Name)
+}
+
+func gunzip(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
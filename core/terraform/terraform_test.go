@@ -0,0 +1,139 @@
+package terraform_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+
+	tfv1alpha2 "github.com/flux-iac/tofu-controller/api/v1alpha2"
+	"github.com/fluxcd/pkg/apis/meta"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/core/terraform"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+func TestPendingPlan(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	humanPlan := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "tfplan-default-human", Namespace: "flux-system"},
+		Data:       map[string]string{"tfplan": "Plan: 1 to add, 0 to change, 0 to destroy."},
+	}
+
+	jsonPlan := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tfplan-default-json.json", Namespace: "flux-system"},
+		Data:       map[string][]byte{"tfplan": gzipped(g, `{"format_version":"1.2"}`)},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(humanPlan, jsonPlan).Build()
+
+	tests := []struct {
+		name     string
+		tf       *tfv1alpha2.Terraform
+		expected *terraform.Plan
+		err      error
+	}{
+		{
+			name:     "human",
+			tf:       pendingTerraform("human", "human", "plan-main-b8e362c2"),
+			expected: &terraform.Plan{ID: "plan-main-b8e362c2", Format: "human", Output: "Plan: 1 to add, 0 to change, 0 to destroy."},
+		},
+		{
+			name:     "json",
+			tf:       pendingTerraform("json", "json", "plan-main-b8e362c2"),
+			expected: &terraform.Plan{ID: "plan-main-b8e362c2", Format: "json", Output: `{"format_version":"1.2"}`},
+		},
+		{
+			name: "no pending plan",
+			tf:   pendingTerraform("human", "human", ""),
+			err:  terraform.ErrNoPendingPlan,
+		},
+		{
+			name: "no readable plan",
+			tf:   pendingTerraform("human", "", "plan-main-b8e362c2"),
+			err:  terraform.ErrNoReadablePlan,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			plan, err := terraform.PendingPlan(context.Background(), c, tt.tf)
+			if tt.err != nil {
+				g.Expect(err).To(MatchError(tt.err))
+				return
+			}
+
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(plan).To(Equal(tt.expected))
+		})
+	}
+}
+
+func TestApproveAndReplan(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	tf := pendingTerraform("infra", "human", "plan-main-b8e362c2")
+	tf.Status.LastPlannedRevision = "main@sha1:b8e362c2"
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tf).WithStatusSubresource(tf).Build()
+
+	planID, err := terraform.Approve(ctx, c, tf)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(planID).To(Equal("plan-main-b8e362c2"))
+
+	approved := &tfv1alpha2.Terraform{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(tf), approved)).To(Succeed())
+	g.Expect(approved.Spec.ApprovePlan).To(Equal("plan-main-b8e362c2"))
+
+	g.Expect(terraform.Replan(ctx, c, approved)).To(Succeed())
+
+	replanned := &tfv1alpha2.Terraform{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(tf), replanned)).To(Succeed())
+	g.Expect(replanned.Status.Plan.Pending).To(BeEmpty())
+	g.Expect(replanned.Status.LastPlannedRevision).To(BeEmpty())
+	g.Expect(replanned.Annotations).To(HaveKey(meta.ReconcileRequestAnnotation))
+	g.Expect(apimeta.IsStatusConditionFalse(replanned.Status.Conditions, meta.ReadyCondition)).To(BeTrue())
+
+	_, err = terraform.Approve(ctx, c, replanned)
+	g.Expect(err).To(MatchError(terraform.ErrNoPendingPlan))
+}
+
+func pendingTerraform(name, storeReadablePlan, pending string) *tfv1alpha2.Terraform {
+	return &tfv1alpha2.Terraform{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "flux-system"},
+		Spec: tfv1alpha2.TerraformSpec{
+			StoreReadablePlan: storeReadablePlan,
+			SourceRef:         tfv1alpha2.CrossNamespaceSourceReference{Kind: "GitRepository", Name: "infra"},
+		},
+		Status: tfv1alpha2.TerraformStatus{
+			Plan: tfv1alpha2.PlanStatus{Pending: pending},
+		},
+	}
+}
+
+func gzipped(g *WithT, s string) []byte {
+	var buf bytes.Buffer
+
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(s))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(w.Close()).To(Succeed())
+
+	return buf.Bytes()
+}
@@ -105,8 +105,10 @@ func (x *GetInventoryRequest) GetWithChildren() bool {
 }
 
 type GetInventoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entries       []*InventoryEntry      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Entries []*InventoryEntry      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// health_summary covers the object and everything in its inventory
+	HealthSummary *HealthSummary `protobuf:"bytes,2,opt,name=health_summary,json=healthSummary,proto3" json:"health_summary,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -118,14 +120,2412 @@ func (x *GetInventoryResponse) Reset() {
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInventoryResponse) String() string {
+func (x *GetInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryResponse) ProtoMessage() {}
+
+func (x *GetInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryResponse.ProtoReflect.Descriptor instead.
+func (*GetInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetInventoryResponse) GetEntries() []*InventoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetInventoryResponse) GetHealthSummary() *HealthSummary {
+	if x != nil {
+		return x.HealthSummary
+	}
+	return nil
+}
+
+type GetApplicationHealthRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Kind        string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace   string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ClusterName string                 `protobuf:"bytes,4,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// with_children also includes the children of the inventory objects,
+	// e.g. the Pods of a Deployment
+	WithChildren  bool `protobuf:"varint,5,opt,name=with_children,json=withChildren,proto3" json:"with_children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationHealthRequest) Reset() {
+	*x = GetApplicationHealthRequest{}
+	mi := &file_api_core_core_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationHealthRequest) ProtoMessage() {}
+
+func (x *GetApplicationHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationHealthRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetApplicationHealthRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetApplicationHealthRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetApplicationHealthRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetApplicationHealthRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *GetApplicationHealthRequest) GetWithChildren() bool {
+	if x != nil {
+		return x.WithChildren
+	}
+	return false
+}
+
+type GetApplicationHealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *HealthSummary         `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApplicationHealthResponse) Reset() {
+	*x = GetApplicationHealthResponse{}
+	mi := &file_api_core_core_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApplicationHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationHealthResponse) ProtoMessage() {}
+
+func (x *GetApplicationHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetApplicationHealthResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetApplicationHealthResponse) GetSummary() *HealthSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type GetDependencyGraphRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// namespace limits the graph to objects in a namespace, and the
+	// objects they refer to. All namespaces if empty.
+	Namespace     string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDependencyGraphRequest) Reset() {
+	*x = GetDependencyGraphRequest{}
+	mi := &file_api_core_core_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDependencyGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDependencyGraphRequest) ProtoMessage() {}
+
+func (x *GetDependencyGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDependencyGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetDependencyGraphRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDependencyGraphRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *GetDependencyGraphRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetDependencyGraphResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*DependencyNode      `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*DependencyEdge      `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	Cycles        []*DependencyCycle     `protobuf:"bytes,3,rep,name=cycles,proto3" json:"cycles,omitempty"`
+	Errors        []*ListError           `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDependencyGraphResponse) Reset() {
+	*x = GetDependencyGraphResponse{}
+	mi := &file_api_core_core_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDependencyGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDependencyGraphResponse) ProtoMessage() {}
+
+func (x *GetDependencyGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDependencyGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetDependencyGraphResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDependencyGraphResponse) GetNodes() []*DependencyNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetDependencyGraphResponse) GetEdges() []*DependencyEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *GetDependencyGraphResponse) GetCycles() []*DependencyCycle {
+	if x != nil {
+		return x.Cycles
+	}
+	return nil
+}
+
+func (x *GetDependencyGraphResponse) GetErrors() []*ListError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type DiffKustomizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ClusterName   string                 `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffKustomizationRequest) Reset() {
+	*x = DiffKustomizationRequest{}
+	mi := &file_api_core_core_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffKustomizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffKustomizationRequest) ProtoMessage() {}
+
+func (x *DiffKustomizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffKustomizationRequest.ProtoReflect.Descriptor instead.
+func (*DiffKustomizationRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DiffKustomizationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiffKustomizationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DiffKustomizationRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type DiffKustomizationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// revision is the revision of the source artifact that was diffed
+	Revision      string        `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Diffs         []*ObjectDiff `protobuf:"bytes,2,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffKustomizationResponse) Reset() {
+	*x = DiffKustomizationResponse{}
+	mi := &file_api_core_core_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffKustomizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffKustomizationResponse) ProtoMessage() {}
+
+func (x *DiffKustomizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffKustomizationResponse.ProtoReflect.Descriptor instead.
+func (*DiffKustomizationResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DiffKustomizationResponse) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *DiffKustomizationResponse) GetDiffs() []*ObjectDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The filters are ignored when empty
+	ClusterName string `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Namespace   string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Kind        string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name        string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Principal   string `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal,omitempty"`
+	// limit is the most events returned, all the recorded events if 0
+	Limit         int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_api_core_core_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListAuditEventsRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_api_core_core_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CreateAPITokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// scopes are read, which only allows calling the RPCs that read, and
+	// write
+	Scopes []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// ttl is how long the token lasts, e.g. 720h. 30 days if empty
+	Ttl           string `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPITokenRequest) Reset() {
+	*x = CreateAPITokenRequest{}
+	mi := &file_api_core_core_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPITokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPITokenRequest) ProtoMessage() {}
+
+func (x *CreateAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateAPITokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPITokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPITokenRequest) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+type CreateAPITokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token is the bearer token, which can't be retrieved again
+	Token         string    `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Details       *APIToken `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPITokenResponse) Reset() {
+	*x = CreateAPITokenResponse{}
+	mi := &file_api_core_core_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPITokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPITokenResponse) ProtoMessage() {}
+
+func (x *CreateAPITokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPITokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPITokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateAPITokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateAPITokenResponse) GetDetails() *APIToken {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ListAPITokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPITokensRequest) Reset() {
+	*x = ListAPITokensRequest{}
+	mi := &file_api_core_core_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPITokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPITokensRequest) ProtoMessage() {}
+
+func (x *ListAPITokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPITokensRequest.ProtoReflect.Descriptor instead.
+func (*ListAPITokensRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{12}
+}
+
+type ListAPITokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*APIToken            `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPITokensResponse) Reset() {
+	*x = ListAPITokensResponse{}
+	mi := &file_api_core_core_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPITokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPITokensResponse) ProtoMessage() {}
+
+func (x *ListAPITokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPITokensResponse.ProtoReflect.Descriptor instead.
+func (*ListAPITokensResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListAPITokensResponse) GetTokens() []*APIToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type RevokeAPITokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPITokenRequest) Reset() {
+	*x = RevokeAPITokenRequest{}
+	mi := &file_api_core_core_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPITokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPITokenRequest) ProtoMessage() {}
+
+func (x *RevokeAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevokeAPITokenRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeAPITokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPITokenResponse) Reset() {
+	*x = RevokeAPITokenResponse{}
+	mi := &file_api_core_core_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPITokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPITokenResponse) ProtoMessage() {}
+
+func (x *RevokeAPITokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPITokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPITokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{15}
+}
+
+type ListSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user limits the sessions to one user's
+	User          string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_api_core_core_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListSessionsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_api_core_core_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user revokes all of the user's sessions, or only the session with id
+	// if it's set too
+	User          string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionsRequest) Reset() {
+	*x = RevokeSessionsRequest{}
+	mi := &file_api_core_core_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RevokeSessionsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *RevokeSessionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revoked       int32                  `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionsResponse) Reset() {
+	*x = RevokeSessionsResponse{}
+	mi := &file_api_core_core_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RevokeSessionsResponse) GetRevoked() int32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+type GetOwningAutomationRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Kind        string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace   string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// api_version is only needed for kinds the server doesn't know, e.g.
+	// "example.com/v1"
+	ApiVersion    string `protobuf:"bytes,5,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOwningAutomationRequest) Reset() {
+	*x = GetOwningAutomationRequest{}
+	mi := &file_api_core_core_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOwningAutomationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOwningAutomationRequest) ProtoMessage() {}
+
+func (x *GetOwningAutomationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOwningAutomationRequest.ProtoReflect.Descriptor instead.
+func (*GetOwningAutomationRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetOwningAutomationRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *GetOwningAutomationRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetOwningAutomationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetOwningAutomationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetOwningAutomationRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+type GetOwningAutomationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// owners are nearest first, the last one wasn't applied by an automation
+	Owners []*OwningAutomation `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
+	// warnings explain why owners may be missing
+	Warnings      []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOwningAutomationResponse) Reset() {
+	*x = GetOwningAutomationResponse{}
+	mi := &file_api_core_core_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOwningAutomationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOwningAutomationResponse) ProtoMessage() {}
+
+func (x *GetOwningAutomationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOwningAutomationResponse.ProtoReflect.Descriptor instead.
+func (*GetOwningAutomationResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetOwningAutomationResponse) GetOwners() []*OwningAutomation {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *GetOwningAutomationResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query is split into terms that must all match an object's name, kind,
+	// namespace, label values or condition messages
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Kind  string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// name must be contained in the object's name
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	LabelSelector string `protobuf:"bytes,4,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	// annotations are `key` or `key=value`, and objects must have all of them
+	Annotations []string `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	// source_ref must match the object's source, its empty fields match any
+	SourceRef *ObjectRef `protobuf:"bytes,6,opt,name=source_ref,json=sourceRef,proto3" json:"source_ref,omitempty"`
+	// message must be contained in one of the object's condition messages
+	Message string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	// inventory_member must be in the object's inventory, its empty fields
+	// match any object. Only the inventories of Kustomizations are indexed,
+	// so HelmReleases never match it
+	InventoryMember *ObjectRef `protobuf:"bytes,8,opt,name=inventory_member,json=inventoryMember,proto3" json:"inventory_member,omitempty"`
+	ClusterName     string     `protobuf:"bytes,9,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// limit is the maximum number of results, 50 if it's not set
+	Limit         int32 `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_api_core_core_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetAnnotations() []string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetSourceRef() *ObjectRef {
+	if x != nil {
+		return x.SourceRef
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetInventoryMember() *ObjectRef {
+	if x != nil {
+		return x.InventoryMember
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// total is the number of matches, including those beyond the limit
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// indexed_at is when the index was last refreshed
+	IndexedAt     string `protobuf:"bytes,3,opt,name=indexed_at,json=indexedAt,proto3" json:"indexed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_api_core_core_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetIndexedAt() string {
+	if x != nil {
+		return x.IndexedAt
+	}
+	return ""
+}
+
+type GetDriftRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// kind is Kustomization or HelmRelease
+	Kind          string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDriftRequest) Reset() {
+	*x = GetDriftRequest{}
+	mi := &file_api_core_core_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriftRequest) ProtoMessage() {}
+
+func (x *GetDriftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriftRequest.ProtoReflect.Descriptor instead.
+func (*GetDriftRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetDriftRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *GetDriftRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetDriftRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetDriftRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetDriftResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// revision is the revision the objects were last applied from
+	Revision string `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// checked is how many objects were compared
+	Checked int32 `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	// objects are those that drifted, or couldn't be compared
+	Objects []*ObjectDrift `protobuf:"bytes,3,rep,name=objects,proto3" json:"objects,omitempty"`
+	// warnings explain why the report may be inaccurate
+	Warnings      []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDriftResponse) Reset() {
+	*x = GetDriftResponse{}
+	mi := &file_api_core_core_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriftResponse) ProtoMessage() {}
+
+func (x *GetDriftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriftResponse.ProtoReflect.Descriptor instead.
+func (*GetDriftResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetDriftResponse) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *GetDriftResponse) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *GetDriftResponse) GetObjects() []*ObjectDrift {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *GetDriftResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type ListHelmReleaseRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHelmReleaseRevisionsRequest) Reset() {
+	*x = ListHelmReleaseRevisionsRequest{}
+	mi := &file_api_core_core_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHelmReleaseRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHelmReleaseRevisionsRequest) ProtoMessage() {}
+
+func (x *ListHelmReleaseRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHelmReleaseRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListHelmReleaseRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListHelmReleaseRevisionsRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ListHelmReleaseRevisionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListHelmReleaseRevisionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListHelmReleaseRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*HelmReleaseRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHelmReleaseRevisionsResponse) Reset() {
+	*x = ListHelmReleaseRevisionsResponse{}
+	mi := &file_api_core_core_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHelmReleaseRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHelmReleaseRevisionsResponse) ProtoMessage() {}
+
+func (x *ListHelmReleaseRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHelmReleaseRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListHelmReleaseRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListHelmReleaseRevisionsResponse) GetRevisions() []*HelmReleaseRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type RollbackHelmReleaseRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace   string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// version is the revision to roll back to
+	Version       int32  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Comment       string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackHelmReleaseRequest) Reset() {
+	*x = RollbackHelmReleaseRequest{}
+	mi := &file_api_core_core_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackHelmReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackHelmReleaseRequest) ProtoMessage() {}
+
+func (x *RollbackHelmReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackHelmReleaseRequest.ProtoReflect.Descriptor instead.
+func (*RollbackHelmReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RollbackHelmReleaseRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *RollbackHelmReleaseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RollbackHelmReleaseRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RollbackHelmReleaseRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RollbackHelmReleaseRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type RollbackHelmReleaseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// chart_version is the chart version the HelmRelease was pinned to
+	ChartVersion string `protobuf:"bytes,1,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"`
+	// warnings explain what couldn't be rolled back
+	Warnings      []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackHelmReleaseResponse) Reset() {
+	*x = RollbackHelmReleaseResponse{}
+	mi := &file_api_core_core_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackHelmReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackHelmReleaseResponse) ProtoMessage() {}
+
+func (x *RollbackHelmReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackHelmReleaseResponse.ProtoReflect.Descriptor instead.
+func (*RollbackHelmReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RollbackHelmReleaseResponse) GetChartVersion() string {
+	if x != nil {
+		return x.ChartVersion
+	}
+	return ""
+}
+
+func (x *RollbackHelmReleaseResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type ListArtifactFilesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// kind is GitRepository, OCIRepository or Bucket
+	Kind      string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// directory only lists the files under it, e.g. "apps/podinfo"
+	Directory     string `protobuf:"bytes,5,opt,name=directory,proto3" json:"directory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArtifactFilesRequest) Reset() {
+	*x = ListArtifactFilesRequest{}
+	mi := &file_api_core_core_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArtifactFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArtifactFilesRequest) ProtoMessage() {}
+
+func (x *ListArtifactFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArtifactFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListArtifactFilesRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListArtifactFilesRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ListArtifactFilesRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListArtifactFilesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListArtifactFilesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListArtifactFilesRequest) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+type ListArtifactFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      string                 `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Digest        string                 `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	Files         []*ArtifactFile        `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArtifactFilesResponse) Reset() {
+	*x = ListArtifactFilesResponse{}
+	mi := &file_api_core_core_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArtifactFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArtifactFilesResponse) ProtoMessage() {}
+
+func (x *ListArtifactFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArtifactFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListArtifactFilesResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListArtifactFilesResponse) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *ListArtifactFilesResponse) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *ListArtifactFilesResponse) GetFiles() []*ArtifactFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type GetArtifactFileRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// kind is GitRepository, OCIRepository or Bucket
+	Kind      string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// path is relative to the root of the artifact, e.g. "apps/podinfo.yaml"
+	Path          string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArtifactFileRequest) Reset() {
+	*x = GetArtifactFileRequest{}
+	mi := &file_api_core_core_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArtifactFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArtifactFileRequest) ProtoMessage() {}
+
+func (x *GetArtifactFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArtifactFileRequest.ProtoReflect.Descriptor instead.
+func (*GetArtifactFileRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetArtifactFileRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *GetArtifactFileRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetArtifactFileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetArtifactFileRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetArtifactFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetArtifactFileResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Revision string                 `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Digest   string                 `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	Path     string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// size is the size of the whole file
+	Size    int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Content []byte `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// truncated is true if the file is too large to be returned whole
+	Truncated     bool `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArtifactFileResponse) Reset() {
+	*x = GetArtifactFileResponse{}
+	mi := &file_api_core_core_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArtifactFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArtifactFileResponse) ProtoMessage() {}
+
+func (x *GetArtifactFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArtifactFileResponse.ProtoReflect.Descriptor instead.
+func (*GetArtifactFileResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetArtifactFileResponse) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *GetArtifactFileResponse) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *GetArtifactFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetArtifactFileResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetArtifactFileResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GetArtifactFileResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type ListTerraformObjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cluster_name and namespace are all of them if empty
+	ClusterName   string `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Namespace     string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTerraformObjectsRequest) Reset() {
+	*x = ListTerraformObjectsRequest{}
+	mi := &file_api_core_core_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTerraformObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTerraformObjectsRequest) ProtoMessage() {}
+
+func (x *ListTerraformObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTerraformObjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListTerraformObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListTerraformObjectsRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ListTerraformObjectsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListTerraformObjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Objects       []*TerraformObject     `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	Errors        []*ListError           `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTerraformObjectsResponse) Reset() {
+	*x = ListTerraformObjectsResponse{}
+	mi := &file_api_core_core_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTerraformObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTerraformObjectsResponse) ProtoMessage() {}
+
+func (x *ListTerraformObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTerraformObjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListTerraformObjectsResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListTerraformObjectsResponse) GetObjects() []*TerraformObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *ListTerraformObjectsResponse) GetErrors() []*ListError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type GetTerraformPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTerraformPlanRequest) Reset() {
+	*x = GetTerraformPlanRequest{}
+	mi := &file_api_core_core_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTerraformPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTerraformPlanRequest) ProtoMessage() {}
+
+func (x *GetTerraformPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTerraformPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetTerraformPlanRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetTerraformPlanRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *GetTerraformPlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetTerraformPlanRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetTerraformPlanResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PlanId string                 `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// format is "human" or "json", as set in spec.storeReadablePlan
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Output        string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTerraformPlanResponse) Reset() {
+	*x = GetTerraformPlanResponse{}
+	mi := &file_api_core_core_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTerraformPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTerraformPlanResponse) ProtoMessage() {}
+
+func (x *GetTerraformPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTerraformPlanResponse.ProtoReflect.Descriptor instead.
+func (*GetTerraformPlanResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetTerraformPlanResponse) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *GetTerraformPlanResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetTerraformPlanResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type ApproveTerraformPlanRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace   string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// plan_id must be the pending plan's if it's set, so that a plan that
+	// replaced the one the user reviewed isn't approved
+	PlanId        string `protobuf:"bytes,4,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTerraformPlanRequest) Reset() {
+	*x = ApproveTerraformPlanRequest{}
+	mi := &file_api_core_core_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTerraformPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTerraformPlanRequest) ProtoMessage() {}
+
+func (x *ApproveTerraformPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTerraformPlanRequest.ProtoReflect.Descriptor instead.
+func (*ApproveTerraformPlanRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ApproveTerraformPlanRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ApproveTerraformPlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApproveTerraformPlanRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ApproveTerraformPlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+type ApproveTerraformPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTerraformPlanResponse) Reset() {
+	*x = ApproveTerraformPlanResponse{}
+	mi := &file_api_core_core_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTerraformPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTerraformPlanResponse) ProtoMessage() {}
+
+func (x *ApproveTerraformPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTerraformPlanResponse.ProtoReflect.Descriptor instead.
+func (*ApproveTerraformPlanResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ApproveTerraformPlanResponse) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+type ReplanTerraformObjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplanTerraformObjectRequest) Reset() {
+	*x = ReplanTerraformObjectRequest{}
+	mi := &file_api_core_core_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplanTerraformObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplanTerraformObjectRequest) ProtoMessage() {}
+
+func (x *ReplanTerraformObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplanTerraformObjectRequest.ProtoReflect.Descriptor instead.
+func (*ReplanTerraformObjectRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReplanTerraformObjectRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ReplanTerraformObjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReplanTerraformObjectRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ReplanTerraformObjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplanTerraformObjectResponse) Reset() {
+	*x = ReplanTerraformObjectResponse{}
+	mi := &file_api_core_core_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplanTerraformObjectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInventoryResponse) ProtoMessage() {}
+func (*ReplanTerraformObjectResponse) ProtoMessage() {}
 
-func (x *GetInventoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[1]
+func (x *ReplanTerraformObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -136,16 +2536,9 @@ func (x *GetInventoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInventoryResponse.ProtoReflect.Descriptor instead.
-func (*GetInventoryResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *GetInventoryResponse) GetEntries() []*InventoryEntry {
-	if x != nil {
-		return x.Entries
-	}
-	return nil
+// Deprecated: Use ReplanTerraformObjectResponse.ProtoReflect.Descriptor instead.
+func (*ReplanTerraformObjectResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{41}
 }
 
 type PolicyValidation struct {
@@ -173,7 +2566,7 @@ type PolicyValidation struct {
 
 func (x *PolicyValidation) Reset() {
 	*x = PolicyValidation{}
-	mi := &file_api_core_core_proto_msgTypes[2]
+	mi := &file_api_core_core_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -185,7 +2578,7 @@ func (x *PolicyValidation) String() string {
 func (*PolicyValidation) ProtoMessage() {}
 
 func (x *PolicyValidation) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[2]
+	mi := &file_api_core_core_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,7 +2591,7 @@ func (x *PolicyValidation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyValidation.ProtoReflect.Descriptor instead.
 func (*PolicyValidation) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{2}
+	return file_api_core_core_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *PolicyValidation) GetId() string {
@@ -335,7 +2728,7 @@ type ListPolicyValidationsRequest struct {
 
 func (x *ListPolicyValidationsRequest) Reset() {
 	*x = ListPolicyValidationsRequest{}
-	mi := &file_api_core_core_proto_msgTypes[3]
+	mi := &file_api_core_core_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +2740,7 @@ func (x *ListPolicyValidationsRequest) String() string {
 func (*ListPolicyValidationsRequest) ProtoMessage() {}
 
 func (x *ListPolicyValidationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[3]
+	mi := &file_api_core_core_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +2753,7 @@ func (x *ListPolicyValidationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPolicyValidationsRequest.ProtoReflect.Descriptor instead.
 func (*ListPolicyValidationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{3}
+	return file_api_core_core_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ListPolicyValidationsRequest) GetClusterName() string {
@@ -424,7 +2817,7 @@ type ListPolicyValidationsResponse struct {
 
 func (x *ListPolicyValidationsResponse) Reset() {
 	*x = ListPolicyValidationsResponse{}
-	mi := &file_api_core_core_proto_msgTypes[4]
+	mi := &file_api_core_core_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -436,7 +2829,7 @@ func (x *ListPolicyValidationsResponse) String() string {
 func (*ListPolicyValidationsResponse) ProtoMessage() {}
 
 func (x *ListPolicyValidationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[4]
+	mi := &file_api_core_core_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +2842,7 @@ func (x *ListPolicyValidationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPolicyValidationsResponse.ProtoReflect.Descriptor instead.
 func (*ListPolicyValidationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{4}
+	return file_api_core_core_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ListPolicyValidationsResponse) GetViolations() []*PolicyValidation {
@@ -491,7 +2884,7 @@ type GetPolicyValidationRequest struct {
 
 func (x *GetPolicyValidationRequest) Reset() {
 	*x = GetPolicyValidationRequest{}
-	mi := &file_api_core_core_proto_msgTypes[5]
+	mi := &file_api_core_core_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -503,7 +2896,7 @@ func (x *GetPolicyValidationRequest) String() string {
 func (*GetPolicyValidationRequest) ProtoMessage() {}
 
 func (x *GetPolicyValidationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[5]
+	mi := &file_api_core_core_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +2909,7 @@ func (x *GetPolicyValidationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPolicyValidationRequest.ProtoReflect.Descriptor instead.
 func (*GetPolicyValidationRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{5}
+	return file_api_core_core_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetPolicyValidationRequest) GetValidationId() string {
@@ -549,7 +2942,7 @@ type GetPolicyValidationResponse struct {
 
 func (x *GetPolicyValidationResponse) Reset() {
 	*x = GetPolicyValidationResponse{}
-	mi := &file_api_core_core_proto_msgTypes[6]
+	mi := &file_api_core_core_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -561,7 +2954,7 @@ func (x *GetPolicyValidationResponse) String() string {
 func (*GetPolicyValidationResponse) ProtoMessage() {}
 
 func (x *GetPolicyValidationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[6]
+	mi := &file_api_core_core_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +2967,7 @@ func (x *GetPolicyValidationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPolicyValidationResponse.ProtoReflect.Descriptor instead.
 func (*GetPolicyValidationResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{6}
+	return file_api_core_core_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetPolicyValidationResponse) GetValidation() *PolicyValidation {
@@ -593,7 +2986,7 @@ type PolicyValidationOccurrence struct {
 
 func (x *PolicyValidationOccurrence) Reset() {
 	*x = PolicyValidationOccurrence{}
-	mi := &file_api_core_core_proto_msgTypes[7]
+	mi := &file_api_core_core_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -605,7 +2998,7 @@ func (x *PolicyValidationOccurrence) String() string {
 func (*PolicyValidationOccurrence) ProtoMessage() {}
 
 func (x *PolicyValidationOccurrence) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[7]
+	mi := &file_api_core_core_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,7 +3011,7 @@ func (x *PolicyValidationOccurrence) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyValidationOccurrence.ProtoReflect.Descriptor instead.
 func (*PolicyValidationOccurrence) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{7}
+	return file_api_core_core_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *PolicyValidationOccurrence) GetMessage() string {
@@ -641,7 +3034,7 @@ type PolicyValidationParam struct {
 
 func (x *PolicyValidationParam) Reset() {
 	*x = PolicyValidationParam{}
-	mi := &file_api_core_core_proto_msgTypes[8]
+	mi := &file_api_core_core_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -653,7 +3046,7 @@ func (x *PolicyValidationParam) String() string {
 func (*PolicyValidationParam) ProtoMessage() {}
 
 func (x *PolicyValidationParam) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[8]
+	mi := &file_api_core_core_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,7 +3059,7 @@ func (x *PolicyValidationParam) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyValidationParam.ProtoReflect.Descriptor instead.
 func (*PolicyValidationParam) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{8}
+	return file_api_core_core_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *PolicyValidationParam) GetName() string {
@@ -713,7 +3106,7 @@ type PolicyParamRepeatedString struct {
 
 func (x *PolicyParamRepeatedString) Reset() {
 	*x = PolicyParamRepeatedString{}
-	mi := &file_api_core_core_proto_msgTypes[9]
+	mi := &file_api_core_core_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -725,7 +3118,7 @@ func (x *PolicyParamRepeatedString) String() string {
 func (*PolicyParamRepeatedString) ProtoMessage() {}
 
 func (x *PolicyParamRepeatedString) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[9]
+	mi := &file_api_core_core_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -738,7 +3131,7 @@ func (x *PolicyParamRepeatedString) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyParamRepeatedString.ProtoReflect.Descriptor instead.
 func (*PolicyParamRepeatedString) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{9}
+	return file_api_core_core_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *PolicyParamRepeatedString) GetValue() []string {
@@ -758,7 +3151,7 @@ type Pagination struct {
 
 func (x *Pagination) Reset() {
 	*x = Pagination{}
-	mi := &file_api_core_core_proto_msgTypes[10]
+	mi := &file_api_core_core_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -770,7 +3163,7 @@ func (x *Pagination) String() string {
 func (*Pagination) ProtoMessage() {}
 
 func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[10]
+	mi := &file_api_core_core_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,7 +3176,7 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
 func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{10}
+	return file_api_core_core_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *Pagination) GetPageSize() int32 {
@@ -811,7 +3204,7 @@ type ListError struct {
 
 func (x *ListError) Reset() {
 	*x = ListError{}
-	mi := &file_api_core_core_proto_msgTypes[11]
+	mi := &file_api_core_core_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -823,7 +3216,7 @@ func (x *ListError) String() string {
 func (*ListError) ProtoMessage() {}
 
 func (x *ListError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[11]
+	mi := &file_api_core_core_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,7 +3229,7 @@ func (x *ListError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListError.ProtoReflect.Descriptor instead.
 func (*ListError) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{11}
+	return file_api_core_core_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ListError) GetClusterName() string {
@@ -870,7 +3263,7 @@ type ListFluxRuntimeObjectsRequest struct {
 
 func (x *ListFluxRuntimeObjectsRequest) Reset() {
 	*x = ListFluxRuntimeObjectsRequest{}
-	mi := &file_api_core_core_proto_msgTypes[12]
+	mi := &file_api_core_core_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -882,7 +3275,7 @@ func (x *ListFluxRuntimeObjectsRequest) String() string {
 func (*ListFluxRuntimeObjectsRequest) ProtoMessage() {}
 
 func (x *ListFluxRuntimeObjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[12]
+	mi := &file_api_core_core_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -895,7 +3288,7 @@ func (x *ListFluxRuntimeObjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFluxRuntimeObjectsRequest.ProtoReflect.Descriptor instead.
 func (*ListFluxRuntimeObjectsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{12}
+	return file_api_core_core_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ListFluxRuntimeObjectsRequest) GetNamespace() string {
@@ -922,7 +3315,7 @@ type ListFluxRuntimeObjectsResponse struct {
 
 func (x *ListFluxRuntimeObjectsResponse) Reset() {
 	*x = ListFluxRuntimeObjectsResponse{}
-	mi := &file_api_core_core_proto_msgTypes[13]
+	mi := &file_api_core_core_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -934,7 +3327,7 @@ func (x *ListFluxRuntimeObjectsResponse) String() string {
 func (*ListFluxRuntimeObjectsResponse) ProtoMessage() {}
 
 func (x *ListFluxRuntimeObjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[13]
+	mi := &file_api_core_core_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -947,7 +3340,7 @@ func (x *ListFluxRuntimeObjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFluxRuntimeObjectsResponse.ProtoReflect.Descriptor instead.
 func (*ListFluxRuntimeObjectsResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{13}
+	return file_api_core_core_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ListFluxRuntimeObjectsResponse) GetDeployments() []*Deployment {
@@ -974,7 +3367,7 @@ type ListRuntimeObjectsRequest struct {
 
 func (x *ListRuntimeObjectsRequest) Reset() {
 	*x = ListRuntimeObjectsRequest{}
-	mi := &file_api_core_core_proto_msgTypes[14]
+	mi := &file_api_core_core_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -986,7 +3379,7 @@ func (x *ListRuntimeObjectsRequest) String() string {
 func (*ListRuntimeObjectsRequest) ProtoMessage() {}
 
 func (x *ListRuntimeObjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[14]
+	mi := &file_api_core_core_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -999,7 +3392,7 @@ func (x *ListRuntimeObjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRuntimeObjectsRequest.ProtoReflect.Descriptor instead.
 func (*ListRuntimeObjectsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{14}
+	return file_api_core_core_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ListRuntimeObjectsRequest) GetNamespace() string {
@@ -1026,7 +3419,7 @@ type ListRuntimeObjectsResponse struct {
 
 func (x *ListRuntimeObjectsResponse) Reset() {
 	*x = ListRuntimeObjectsResponse{}
-	mi := &file_api_core_core_proto_msgTypes[15]
+	mi := &file_api_core_core_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1038,7 +3431,7 @@ func (x *ListRuntimeObjectsResponse) String() string {
 func (*ListRuntimeObjectsResponse) ProtoMessage() {}
 
 func (x *ListRuntimeObjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[15]
+	mi := &file_api_core_core_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1051,7 +3444,7 @@ func (x *ListRuntimeObjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRuntimeObjectsResponse.ProtoReflect.Descriptor instead.
 func (*ListRuntimeObjectsResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{15}
+	return file_api_core_core_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ListRuntimeObjectsResponse) GetDeployments() []*Deployment {
@@ -1077,7 +3470,7 @@ type ListFluxCrdsRequest struct {
 
 func (x *ListFluxCrdsRequest) Reset() {
 	*x = ListFluxCrdsRequest{}
-	mi := &file_api_core_core_proto_msgTypes[16]
+	mi := &file_api_core_core_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1089,7 +3482,7 @@ func (x *ListFluxCrdsRequest) String() string {
 func (*ListFluxCrdsRequest) ProtoMessage() {}
 
 func (x *ListFluxCrdsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[16]
+	mi := &file_api_core_core_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1102,7 +3495,7 @@ func (x *ListFluxCrdsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFluxCrdsRequest.ProtoReflect.Descriptor instead.
 func (*ListFluxCrdsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{16}
+	return file_api_core_core_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ListFluxCrdsRequest) GetClusterName() string {
@@ -1122,7 +3515,7 @@ type ListFluxCrdsResponse struct {
 
 func (x *ListFluxCrdsResponse) Reset() {
 	*x = ListFluxCrdsResponse{}
-	mi := &file_api_core_core_proto_msgTypes[17]
+	mi := &file_api_core_core_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1134,7 +3527,7 @@ func (x *ListFluxCrdsResponse) String() string {
 func (*ListFluxCrdsResponse) ProtoMessage() {}
 
 func (x *ListFluxCrdsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[17]
+	mi := &file_api_core_core_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,7 +3540,7 @@ func (x *ListFluxCrdsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFluxCrdsResponse.ProtoReflect.Descriptor instead.
 func (*ListFluxCrdsResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{17}
+	return file_api_core_core_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ListFluxCrdsResponse) GetCrds() []*Crd {
@@ -1173,7 +3566,7 @@ type ListRuntimeCrdsRequest struct {
 
 func (x *ListRuntimeCrdsRequest) Reset() {
 	*x = ListRuntimeCrdsRequest{}
-	mi := &file_api_core_core_proto_msgTypes[18]
+	mi := &file_api_core_core_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1185,7 +3578,7 @@ func (x *ListRuntimeCrdsRequest) String() string {
 func (*ListRuntimeCrdsRequest) ProtoMessage() {}
 
 func (x *ListRuntimeCrdsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[18]
+	mi := &file_api_core_core_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,7 +3591,7 @@ func (x *ListRuntimeCrdsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRuntimeCrdsRequest.ProtoReflect.Descriptor instead.
 func (*ListRuntimeCrdsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{18}
+	return file_api_core_core_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ListRuntimeCrdsRequest) GetClusterName() string {
@@ -1218,7 +3611,7 @@ type ListRuntimeCrdsResponse struct {
 
 func (x *ListRuntimeCrdsResponse) Reset() {
 	*x = ListRuntimeCrdsResponse{}
-	mi := &file_api_core_core_proto_msgTypes[19]
+	mi := &file_api_core_core_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1230,7 +3623,7 @@ func (x *ListRuntimeCrdsResponse) String() string {
 func (*ListRuntimeCrdsResponse) ProtoMessage() {}
 
 func (x *ListRuntimeCrdsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[19]
+	mi := &file_api_core_core_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1243,7 +3636,7 @@ func (x *ListRuntimeCrdsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRuntimeCrdsResponse.ProtoReflect.Descriptor instead.
 func (*ListRuntimeCrdsResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{19}
+	return file_api_core_core_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *ListRuntimeCrdsResponse) GetCrds() []*Crd {
@@ -1272,7 +3665,7 @@ type GetObjectRequest struct {
 
 func (x *GetObjectRequest) Reset() {
 	*x = GetObjectRequest{}
-	mi := &file_api_core_core_proto_msgTypes[20]
+	mi := &file_api_core_core_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1281,75 +3674,268 @@ func (x *GetObjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetObjectRequest) ProtoMessage() {}
+func (*GetObjectRequest) ProtoMessage() {}
+
+func (x *GetObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectRequest.ProtoReflect.Descriptor instead.
+func (*GetObjectRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetObjectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetObjectRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetObjectRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetObjectRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type GetObjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Object        *Object                `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectResponse) Reset() {
+	*x = GetObjectResponse{}
+	mi := &file_api_core_core_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectResponse) ProtoMessage() {}
+
+func (x *GetObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectResponse.ProtoReflect.Descriptor instead.
+func (*GetObjectResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetObjectResponse) GetObject() *Object {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+type ListObjectsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Namespace   string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Kind        string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	ClusterName string                 `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Labels      map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// page_size limits how many objects are returned, all of them if 0
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of the previous page, which must be
+	// requested with the same filters and order. The page starts after the
+	// last object of the previous page, even if it was deleted since
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// ready filters objects by the status of their Ready condition, one of
+	// True, False or Unknown. Objects without the condition are Unknown
+	Ready string `protobuf:"bytes,7,opt,name=ready,proto3" json:"ready,omitempty"`
+	// suspended filters objects by spec.suspend, one of true or false
+	Suspended string `protobuf:"bytes,8,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// source_ref filters objects by the source they reconcile, matching the
+	// fields that are set, so an empty namespace matches sources in any
+	// namespace. A source reference without a namespace in the object's spec
+	// is in the object's namespace
+	SourceRef *ObjectRef `protobuf:"bytes,9,opt,name=source_ref,json=sourceRef,proto3" json:"source_ref,omitempty"`
+	// name_contains filters objects whose name contains it
+	NameContains string `protobuf:"bytes,10,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
+	// order_by is one of name, namespace, cluster_name or creation_timestamp.
+	// Objects are ordered by cluster, namespace and name by default
+	OrderBy       string `protobuf:"bytes,11,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Descending    bool   `protobuf:"varint,12,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListObjectsRequest) Reset() {
+	*x = ListObjectsRequest{}
+	mi := &file_api_core_core_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectsRequest) ProtoMessage() {}
+
+func (x *ListObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListObjectsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
 
-func (x *GetObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[20]
+func (x *ListObjectsRequest) GetPageSize() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PageSize
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetObjectRequest.ProtoReflect.Descriptor instead.
-func (*GetObjectRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{20}
+func (x *ListObjectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
 }
 
-func (x *GetObjectRequest) GetName() string {
+func (x *ListObjectsRequest) GetReady() string {
 	if x != nil {
-		return x.Name
+		return x.Ready
 	}
 	return ""
 }
 
-func (x *GetObjectRequest) GetNamespace() string {
+func (x *ListObjectsRequest) GetSuspended() string {
 	if x != nil {
-		return x.Namespace
+		return x.Suspended
 	}
 	return ""
 }
 
-func (x *GetObjectRequest) GetKind() string {
+func (x *ListObjectsRequest) GetSourceRef() *ObjectRef {
 	if x != nil {
-		return x.Kind
+		return x.SourceRef
+	}
+	return nil
+}
+
+func (x *ListObjectsRequest) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
 	}
 	return ""
 }
 
-func (x *GetObjectRequest) GetClusterName() string {
+func (x *ListObjectsRequest) GetOrderBy() string {
 	if x != nil {
-		return x.ClusterName
+		return x.OrderBy
 	}
 	return ""
 }
 
-type GetObjectResponse struct {
+func (x *ListObjectsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type WatchObjectsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Object        *Object                `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	ClusterName   string                 `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetObjectResponse) Reset() {
-	*x = GetObjectResponse{}
-	mi := &file_api_core_core_proto_msgTypes[21]
+func (x *WatchObjectsRequest) Reset() {
+	*x = WatchObjectsRequest{}
+	mi := &file_api_core_core_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetObjectResponse) String() string {
+func (x *WatchObjectsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetObjectResponse) ProtoMessage() {}
+func (*WatchObjectsRequest) ProtoMessage() {}
 
-func (x *GetObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[21]
+func (x *WatchObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,43 +3946,64 @@ func (x *GetObjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetObjectResponse.ProtoReflect.Descriptor instead.
-func (*GetObjectResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use WatchObjectsRequest.ProtoReflect.Descriptor instead.
+func (*WatchObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetObjectResponse) GetObject() *Object {
+func (x *WatchObjectsRequest) GetNamespace() string {
 	if x != nil {
-		return x.Object
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WatchObjectsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WatchObjectsRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *WatchObjectsRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
 	}
 	return nil
 }
 
-type ListObjectsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	ClusterName   string                 `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	Labels        map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type WatchObjectsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is one of ADDED, MODIFIED or DELETED
+	Type          string     `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Object        *Object    `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	Error         *ListError `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListObjectsRequest) Reset() {
-	*x = ListObjectsRequest{}
-	mi := &file_api_core_core_proto_msgTypes[22]
+func (x *WatchObjectsResponse) Reset() {
+	*x = WatchObjectsResponse{}
+	mi := &file_api_core_core_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListObjectsRequest) String() string {
+func (x *WatchObjectsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListObjectsRequest) ProtoMessage() {}
+func (*WatchObjectsResponse) ProtoMessage() {}
 
-func (x *ListObjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[22]
+func (x *WatchObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1407,35 +4014,28 @@ func (x *ListObjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListObjectsRequest.ProtoReflect.Descriptor instead.
-func (*ListObjectsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *ListObjectsRequest) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
+// Deprecated: Use WatchObjectsResponse.ProtoReflect.Descriptor instead.
+func (*WatchObjectsResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ListObjectsRequest) GetKind() string {
+func (x *WatchObjectsResponse) GetType() string {
 	if x != nil {
-		return x.Kind
+		return x.Type
 	}
 	return ""
 }
 
-func (x *ListObjectsRequest) GetClusterName() string {
+func (x *WatchObjectsResponse) GetObject() *Object {
 	if x != nil {
-		return x.ClusterName
+		return x.Object
 	}
-	return ""
+	return nil
 }
 
-func (x *ListObjectsRequest) GetLabels() map[string]string {
+func (x *WatchObjectsResponse) GetError() *ListError {
 	if x != nil {
-		return x.Labels
+		return x.Error
 	}
 	return nil
 }
@@ -1450,7 +4050,7 @@ type ClusterNamespaceList struct {
 
 func (x *ClusterNamespaceList) Reset() {
 	*x = ClusterNamespaceList{}
-	mi := &file_api_core_core_proto_msgTypes[23]
+	mi := &file_api_core_core_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1462,7 +4062,7 @@ func (x *ClusterNamespaceList) String() string {
 func (*ClusterNamespaceList) ProtoMessage() {}
 
 func (x *ClusterNamespaceList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[23]
+	mi := &file_api_core_core_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1475,7 +4075,7 @@ func (x *ClusterNamespaceList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterNamespaceList.ProtoReflect.Descriptor instead.
 func (*ClusterNamespaceList) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{23}
+	return file_api_core_core_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ClusterNamespaceList) GetClusterName() string {
@@ -1497,13 +4097,17 @@ type ListObjectsResponse struct {
 	Objects            []*Object               `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
 	Errors             []*ListError            `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 	SearchedNamespaces []*ClusterNamespaceList `protobuf:"bytes,3,rep,name=searched_namespaces,json=searchedNamespaces,proto3" json:"searched_namespaces,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// next_page_token requests the next page, it's empty on the last page
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// total is how many objects match the filters, in all the pages
+	Total         int32 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListObjectsResponse) Reset() {
 	*x = ListObjectsResponse{}
-	mi := &file_api_core_core_proto_msgTypes[24]
+	mi := &file_api_core_core_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1515,7 +4119,7 @@ func (x *ListObjectsResponse) String() string {
 func (*ListObjectsResponse) ProtoMessage() {}
 
 func (x *ListObjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[24]
+	mi := &file_api_core_core_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1528,7 +4132,7 @@ func (x *ListObjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListObjectsResponse.ProtoReflect.Descriptor instead.
 func (*ListObjectsResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{24}
+	return file_api_core_core_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *ListObjectsResponse) GetObjects() []*Object {
@@ -1552,6 +4156,20 @@ func (x *ListObjectsResponse) GetSearchedNamespaces() []*ClusterNamespaceList {
 	return nil
 }
 
+func (x *ListObjectsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListObjectsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type GetReconciledObjectsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	AutomationName string                 `protobuf:"bytes,1,opt,name=automation_name,json=automationName,proto3" json:"automation_name,omitempty"`
@@ -1565,7 +4183,7 @@ type GetReconciledObjectsRequest struct {
 
 func (x *GetReconciledObjectsRequest) Reset() {
 	*x = GetReconciledObjectsRequest{}
-	mi := &file_api_core_core_proto_msgTypes[25]
+	mi := &file_api_core_core_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1577,7 +4195,7 @@ func (x *GetReconciledObjectsRequest) String() string {
 func (*GetReconciledObjectsRequest) ProtoMessage() {}
 
 func (x *GetReconciledObjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[25]
+	mi := &file_api_core_core_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1590,7 +4208,7 @@ func (x *GetReconciledObjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReconciledObjectsRequest.ProtoReflect.Descriptor instead.
 func (*GetReconciledObjectsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{25}
+	return file_api_core_core_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *GetReconciledObjectsRequest) GetAutomationName() string {
@@ -1637,7 +4255,7 @@ type GetReconciledObjectsResponse struct {
 
 func (x *GetReconciledObjectsResponse) Reset() {
 	*x = GetReconciledObjectsResponse{}
-	mi := &file_api_core_core_proto_msgTypes[26]
+	mi := &file_api_core_core_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1649,7 +4267,7 @@ func (x *GetReconciledObjectsResponse) String() string {
 func (*GetReconciledObjectsResponse) ProtoMessage() {}
 
 func (x *GetReconciledObjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[26]
+	mi := &file_api_core_core_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1662,7 +4280,7 @@ func (x *GetReconciledObjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReconciledObjectsResponse.ProtoReflect.Descriptor instead.
 func (*GetReconciledObjectsResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{26}
+	return file_api_core_core_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *GetReconciledObjectsResponse) GetObjects() []*Object {
@@ -1684,7 +4302,7 @@ type GetChildObjectsRequest struct {
 
 func (x *GetChildObjectsRequest) Reset() {
 	*x = GetChildObjectsRequest{}
-	mi := &file_api_core_core_proto_msgTypes[27]
+	mi := &file_api_core_core_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1696,7 +4314,7 @@ func (x *GetChildObjectsRequest) String() string {
 func (*GetChildObjectsRequest) ProtoMessage() {}
 
 func (x *GetChildObjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[27]
+	mi := &file_api_core_core_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1709,7 +4327,7 @@ func (x *GetChildObjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChildObjectsRequest.ProtoReflect.Descriptor instead.
 func (*GetChildObjectsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{27}
+	return file_api_core_core_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *GetChildObjectsRequest) GetGroupVersionKind() *GroupVersionKind {
@@ -1749,7 +4367,7 @@ type GetChildObjectsResponse struct {
 
 func (x *GetChildObjectsResponse) Reset() {
 	*x = GetChildObjectsResponse{}
-	mi := &file_api_core_core_proto_msgTypes[28]
+	mi := &file_api_core_core_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1761,7 +4379,7 @@ func (x *GetChildObjectsResponse) String() string {
 func (*GetChildObjectsResponse) ProtoMessage() {}
 
 func (x *GetChildObjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[28]
+	mi := &file_api_core_core_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1774,7 +4392,7 @@ func (x *GetChildObjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChildObjectsResponse.ProtoReflect.Descriptor instead.
 func (*GetChildObjectsResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{28}
+	return file_api_core_core_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *GetChildObjectsResponse) GetObjects() []*Object {
@@ -1792,7 +4410,7 @@ type GetFluxNamespaceRequest struct {
 
 func (x *GetFluxNamespaceRequest) Reset() {
 	*x = GetFluxNamespaceRequest{}
-	mi := &file_api_core_core_proto_msgTypes[29]
+	mi := &file_api_core_core_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1804,7 +4422,7 @@ func (x *GetFluxNamespaceRequest) String() string {
 func (*GetFluxNamespaceRequest) ProtoMessage() {}
 
 func (x *GetFluxNamespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[29]
+	mi := &file_api_core_core_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1817,7 +4435,7 @@ func (x *GetFluxNamespaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFluxNamespaceRequest.ProtoReflect.Descriptor instead.
 func (*GetFluxNamespaceRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{29}
+	return file_api_core_core_proto_rawDescGZIP(), []int{71}
 }
 
 type GetFluxNamespaceResponse struct {
@@ -1829,7 +4447,7 @@ type GetFluxNamespaceResponse struct {
 
 func (x *GetFluxNamespaceResponse) Reset() {
 	*x = GetFluxNamespaceResponse{}
-	mi := &file_api_core_core_proto_msgTypes[30]
+	mi := &file_api_core_core_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1841,7 +4459,7 @@ func (x *GetFluxNamespaceResponse) String() string {
 func (*GetFluxNamespaceResponse) ProtoMessage() {}
 
 func (x *GetFluxNamespaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[30]
+	mi := &file_api_core_core_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1854,7 +4472,7 @@ func (x *GetFluxNamespaceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFluxNamespaceResponse.ProtoReflect.Descriptor instead.
 func (*GetFluxNamespaceResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{30}
+	return file_api_core_core_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *GetFluxNamespaceResponse) GetName() string {
@@ -1872,7 +4490,7 @@ type ListNamespacesRequest struct {
 
 func (x *ListNamespacesRequest) Reset() {
 	*x = ListNamespacesRequest{}
-	mi := &file_api_core_core_proto_msgTypes[31]
+	mi := &file_api_core_core_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1884,7 +4502,7 @@ func (x *ListNamespacesRequest) String() string {
 func (*ListNamespacesRequest) ProtoMessage() {}
 
 func (x *ListNamespacesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[31]
+	mi := &file_api_core_core_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1897,7 +4515,7 @@ func (x *ListNamespacesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNamespacesRequest.ProtoReflect.Descriptor instead.
 func (*ListNamespacesRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{31}
+	return file_api_core_core_proto_rawDescGZIP(), []int{73}
 }
 
 type ListNamespacesResponse struct {
@@ -1909,7 +4527,7 @@ type ListNamespacesResponse struct {
 
 func (x *ListNamespacesResponse) Reset() {
 	*x = ListNamespacesResponse{}
-	mi := &file_api_core_core_proto_msgTypes[32]
+	mi := &file_api_core_core_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1921,7 +4539,7 @@ func (x *ListNamespacesResponse) String() string {
 func (*ListNamespacesResponse) ProtoMessage() {}
 
 func (x *ListNamespacesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[32]
+	mi := &file_api_core_core_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1934,7 +4552,7 @@ func (x *ListNamespacesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNamespacesResponse.ProtoReflect.Descriptor instead.
 func (*ListNamespacesResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{32}
+	return file_api_core_core_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *ListNamespacesResponse) GetNamespaces() []*Namespace {
@@ -1953,7 +4571,7 @@ type ListEventsRequest struct {
 
 func (x *ListEventsRequest) Reset() {
 	*x = ListEventsRequest{}
-	mi := &file_api_core_core_proto_msgTypes[33]
+	mi := &file_api_core_core_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1965,7 +4583,7 @@ func (x *ListEventsRequest) String() string {
 func (*ListEventsRequest) ProtoMessage() {}
 
 func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[33]
+	mi := &file_api_core_core_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,38 +4596,150 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListEventsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{33}
+	return file_api_core_core_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *ListEventsRequest) GetInvolvedObject() *ObjectRef {
 	if x != nil {
-		return x.InvolvedObject
+		return x.InvolvedObject
+	}
+	return nil
+}
+
+type ListEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_api_core_core_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetReconciliationHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	ClusterName   string                 `protobuf:"bytes,4,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationHistoryRequest) Reset() {
+	*x = GetReconciliationHistoryRequest{}
+	mi := &file_api_core_core_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationHistoryRequest) ProtoMessage() {}
+
+func (x *GetReconciliationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetReconciliationHistoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetReconciliationHistoryRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetReconciliationHistoryRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetReconciliationHistoryRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
 	}
-	return nil
+	return ""
 }
 
-type ListEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+type GetReconciliationHistoryResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Records       []*ReconciliationRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventsResponse) Reset() {
-	*x = ListEventsResponse{}
-	mi := &file_api_core_core_proto_msgTypes[34]
+func (x *GetReconciliationHistoryResponse) Reset() {
+	*x = GetReconciliationHistoryResponse{}
+	mi := &file_api_core_core_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsResponse) String() string {
+func (x *GetReconciliationHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsResponse) ProtoMessage() {}
+func (*GetReconciliationHistoryResponse) ProtoMessage() {}
 
-func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[34]
+func (x *GetReconciliationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2020,29 +4750,31 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListEventsResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use GetReconciliationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetReconciliationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ListEventsResponse) GetEvents() []*Event {
+func (x *GetReconciliationHistoryResponse) GetRecords() []*ReconciliationRecord {
 	if x != nil {
-		return x.Events
+		return x.Records
 	}
 	return nil
 }
 
 type SyncFluxObjectRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Objects       []*ObjectRef           `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
-	WithSource    bool                   `protobuf:"varint,2,opt,name=with_source,json=withSource,proto3" json:"with_source,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Objects    []*ObjectRef           `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	WithSource bool                   `protobuf:"varint,2,opt,name=with_source,json=withSource,proto3" json:"with_source,omitempty"`
+	// cascade also syncs the objects that depend on the given objects
+	Cascade       bool `protobuf:"varint,3,opt,name=cascade,proto3" json:"cascade,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SyncFluxObjectRequest) Reset() {
 	*x = SyncFluxObjectRequest{}
-	mi := &file_api_core_core_proto_msgTypes[35]
+	mi := &file_api_core_core_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2054,7 +4786,7 @@ func (x *SyncFluxObjectRequest) String() string {
 func (*SyncFluxObjectRequest) ProtoMessage() {}
 
 func (x *SyncFluxObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[35]
+	mi := &file_api_core_core_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2067,7 +4799,7 @@ func (x *SyncFluxObjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncFluxObjectRequest.ProtoReflect.Descriptor instead.
 func (*SyncFluxObjectRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{35}
+	return file_api_core_core_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *SyncFluxObjectRequest) GetObjects() []*ObjectRef {
@@ -2084,15 +4816,23 @@ func (x *SyncFluxObjectRequest) GetWithSource() bool {
 	return false
 }
 
+func (x *SyncFluxObjectRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
 type SyncFluxObjectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ObjectResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SyncFluxObjectResponse) Reset() {
 	*x = SyncFluxObjectResponse{}
-	mi := &file_api_core_core_proto_msgTypes[36]
+	mi := &file_api_core_core_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2104,7 +4844,7 @@ func (x *SyncFluxObjectResponse) String() string {
 func (*SyncFluxObjectResponse) ProtoMessage() {}
 
 func (x *SyncFluxObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[36]
+	mi := &file_api_core_core_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2117,7 +4857,14 @@ func (x *SyncFluxObjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncFluxObjectResponse.ProtoReflect.Descriptor instead.
 func (*SyncFluxObjectResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{36}
+	return file_api_core_core_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SyncFluxObjectResponse) GetResults() []*ObjectResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
 }
 
 type GetVersionRequest struct {
@@ -2128,7 +4875,7 @@ type GetVersionRequest struct {
 
 func (x *GetVersionRequest) Reset() {
 	*x = GetVersionRequest{}
-	mi := &file_api_core_core_proto_msgTypes[37]
+	mi := &file_api_core_core_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2140,7 +4887,7 @@ func (x *GetVersionRequest) String() string {
 func (*GetVersionRequest) ProtoMessage() {}
 
 func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[37]
+	mi := &file_api_core_core_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2153,7 +4900,7 @@ func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
 func (*GetVersionRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{37}
+	return file_api_core_core_proto_rawDescGZIP(), []int{81}
 }
 
 type GetVersionResponse struct {
@@ -2169,7 +4916,7 @@ type GetVersionResponse struct {
 
 func (x *GetVersionResponse) Reset() {
 	*x = GetVersionResponse{}
-	mi := &file_api_core_core_proto_msgTypes[38]
+	mi := &file_api_core_core_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2181,7 +4928,7 @@ func (x *GetVersionResponse) String() string {
 func (*GetVersionResponse) ProtoMessage() {}
 
 func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[38]
+	mi := &file_api_core_core_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2194,7 +4941,7 @@ func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
 func (*GetVersionResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{38}
+	return file_api_core_core_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *GetVersionResponse) GetSemver() string {
@@ -2240,7 +4987,7 @@ type GetFeatureFlagsRequest struct {
 
 func (x *GetFeatureFlagsRequest) Reset() {
 	*x = GetFeatureFlagsRequest{}
-	mi := &file_api_core_core_proto_msgTypes[39]
+	mi := &file_api_core_core_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2252,7 +4999,7 @@ func (x *GetFeatureFlagsRequest) String() string {
 func (*GetFeatureFlagsRequest) ProtoMessage() {}
 
 func (x *GetFeatureFlagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[39]
+	mi := &file_api_core_core_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2265,7 +5012,7 @@ func (x *GetFeatureFlagsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFeatureFlagsRequest.ProtoReflect.Descriptor instead.
 func (*GetFeatureFlagsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{39}
+	return file_api_core_core_proto_rawDescGZIP(), []int{83}
 }
 
 type GetFeatureFlagsResponse struct {
@@ -2277,7 +5024,7 @@ type GetFeatureFlagsResponse struct {
 
 func (x *GetFeatureFlagsResponse) Reset() {
 	*x = GetFeatureFlagsResponse{}
-	mi := &file_api_core_core_proto_msgTypes[40]
+	mi := &file_api_core_core_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2289,7 +5036,7 @@ func (x *GetFeatureFlagsResponse) String() string {
 func (*GetFeatureFlagsResponse) ProtoMessage() {}
 
 func (x *GetFeatureFlagsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[40]
+	mi := &file_api_core_core_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2302,7 +5049,7 @@ func (x *GetFeatureFlagsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFeatureFlagsResponse.ProtoReflect.Descriptor instead.
 func (*GetFeatureFlagsResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{40}
+	return file_api_core_core_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GetFeatureFlagsResponse) GetFlags() map[string]string {
@@ -2313,17 +5060,30 @@ func (x *GetFeatureFlagsResponse) GetFlags() map[string]string {
 }
 
 type ToggleSuspendResourceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Objects       []*ObjectRef           `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
-	Suspend       bool                   `protobuf:"varint,2,opt,name=suspend,proto3" json:"suspend,omitempty"`
-	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Objects []*ObjectRef           `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	Suspend bool                   `protobuf:"varint,2,opt,name=suspend,proto3" json:"suspend,omitempty"`
+	Comment string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// cascade also suspends or resumes the objects that depend on the given objects
+	Cascade bool `protobuf:"varint,4,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	// suspend_until is an RFC3339 time to automatically resume the objects at
+	SuspendUntil string `protobuf:"bytes,5,opt,name=suspend_until,json=suspendUntil,proto3" json:"suspend_until,omitempty"`
+	// freeze_schedule is a cron expression of when freeze windows start.
+	// The objects are suspended during each window instead of immediately.
+	FreezeSchedule string `protobuf:"bytes,6,opt,name=freeze_schedule,json=freezeSchedule,proto3" json:"freeze_schedule,omitempty"`
+	// freeze_duration is how long each freeze window lasts, e.g. "72h"
+	FreezeDuration string `protobuf:"bytes,7,opt,name=freeze_duration,json=freezeDuration,proto3" json:"freeze_duration,omitempty"`
+	// clear_freeze_schedule removes the freeze windows of the objects when
+	// resuming them. Otherwise they're kept, and an object resumed during a
+	// window stays resumed until the window ends.
+	ClearFreezeSchedule bool `protobuf:"varint,8,opt,name=clear_freeze_schedule,json=clearFreezeSchedule,proto3" json:"clear_freeze_schedule,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *ToggleSuspendResourceRequest) Reset() {
 	*x = ToggleSuspendResourceRequest{}
-	mi := &file_api_core_core_proto_msgTypes[41]
+	mi := &file_api_core_core_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2335,7 +5095,7 @@ func (x *ToggleSuspendResourceRequest) String() string {
 func (*ToggleSuspendResourceRequest) ProtoMessage() {}
 
 func (x *ToggleSuspendResourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[41]
+	mi := &file_api_core_core_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2348,7 +5108,7 @@ func (x *ToggleSuspendResourceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToggleSuspendResourceRequest.ProtoReflect.Descriptor instead.
 func (*ToggleSuspendResourceRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{41}
+	return file_api_core_core_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *ToggleSuspendResourceRequest) GetObjects() []*ObjectRef {
@@ -2372,15 +5132,51 @@ func (x *ToggleSuspendResourceRequest) GetComment() string {
 	return ""
 }
 
+func (x *ToggleSuspendResourceRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+func (x *ToggleSuspendResourceRequest) GetSuspendUntil() string {
+	if x != nil {
+		return x.SuspendUntil
+	}
+	return ""
+}
+
+func (x *ToggleSuspendResourceRequest) GetFreezeSchedule() string {
+	if x != nil {
+		return x.FreezeSchedule
+	}
+	return ""
+}
+
+func (x *ToggleSuspendResourceRequest) GetFreezeDuration() string {
+	if x != nil {
+		return x.FreezeDuration
+	}
+	return ""
+}
+
+func (x *ToggleSuspendResourceRequest) GetClearFreezeSchedule() bool {
+	if x != nil {
+		return x.ClearFreezeSchedule
+	}
+	return false
+}
+
 type ToggleSuspendResourceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ObjectResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ToggleSuspendResourceResponse) Reset() {
 	*x = ToggleSuspendResourceResponse{}
-	mi := &file_api_core_core_proto_msgTypes[42]
+	mi := &file_api_core_core_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2392,7 +5188,7 @@ func (x *ToggleSuspendResourceResponse) String() string {
 func (*ToggleSuspendResourceResponse) ProtoMessage() {}
 
 func (x *ToggleSuspendResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[42]
+	mi := &file_api_core_core_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2405,7 +5201,14 @@ func (x *ToggleSuspendResourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToggleSuspendResourceResponse.ProtoReflect.Descriptor instead.
 func (*ToggleSuspendResourceResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{42}
+	return file_api_core_core_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ToggleSuspendResourceResponse) GetResults() []*ObjectResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
 }
 
 type GetSessionLogsRequest struct {
@@ -2421,7 +5224,7 @@ type GetSessionLogsRequest struct {
 
 func (x *GetSessionLogsRequest) Reset() {
 	*x = GetSessionLogsRequest{}
-	mi := &file_api_core_core_proto_msgTypes[43]
+	mi := &file_api_core_core_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2433,7 +5236,7 @@ func (x *GetSessionLogsRequest) String() string {
 func (*GetSessionLogsRequest) ProtoMessage() {}
 
 func (x *GetSessionLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[43]
+	mi := &file_api_core_core_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2446,7 +5249,7 @@ func (x *GetSessionLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSessionLogsRequest.ProtoReflect.Descriptor instead.
 func (*GetSessionLogsRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{43}
+	return file_api_core_core_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *GetSessionLogsRequest) GetSessionNamespace() string {
@@ -2497,7 +5300,7 @@ type LogEntry struct {
 
 func (x *LogEntry) Reset() {
 	*x = LogEntry{}
-	mi := &file_api_core_core_proto_msgTypes[44]
+	mi := &file_api_core_core_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2509,7 +5312,7 @@ func (x *LogEntry) String() string {
 func (*LogEntry) ProtoMessage() {}
 
 func (x *LogEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[44]
+	mi := &file_api_core_core_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2522,7 +5325,7 @@ func (x *LogEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{44}
+	return file_api_core_core_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *LogEntry) GetTimestamp() string {
@@ -2572,7 +5375,7 @@ type GetSessionLogsResponse struct {
 
 func (x *GetSessionLogsResponse) Reset() {
 	*x = GetSessionLogsResponse{}
-	mi := &file_api_core_core_proto_msgTypes[45]
+	mi := &file_api_core_core_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2584,7 +5387,7 @@ func (x *GetSessionLogsResponse) String() string {
 func (*GetSessionLogsResponse) ProtoMessage() {}
 
 func (x *GetSessionLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[45]
+	mi := &file_api_core_core_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2597,7 +5400,7 @@ func (x *GetSessionLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSessionLogsResponse.ProtoReflect.Descriptor instead.
 func (*GetSessionLogsResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{45}
+	return file_api_core_core_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *GetSessionLogsResponse) GetLogs() []*LogEntry {
@@ -2628,6 +5431,155 @@ func (x *GetSessionLogsResponse) GetLogSources() []string {
 	return nil
 }
 
+type GetControllerLogsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Kind        string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace   string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// since_seconds only gets the entries newer than this, those of the
+	// last hour if it's 0
+	SinceSeconds int64 `protobuf:"varint,5,opt,name=since_seconds,json=sinceSeconds,proto3" json:"since_seconds,omitempty"`
+	// follow keeps streaming new entries until the request is cancelled
+	Follow bool `protobuf:"varint,6,opt,name=follow,proto3" json:"follow,omitempty"`
+	// log_level_filter is e.g. error, all the levels if it's empty
+	LogLevelFilter string `protobuf:"bytes,7,opt,name=log_level_filter,json=logLevelFilter,proto3" json:"log_level_filter,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetControllerLogsRequest) Reset() {
+	*x = GetControllerLogsRequest{}
+	mi := &file_api_core_core_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetControllerLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetControllerLogsRequest) ProtoMessage() {}
+
+func (x *GetControllerLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetControllerLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetControllerLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetControllerLogsRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *GetControllerLogsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetControllerLogsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetControllerLogsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetControllerLogsRequest) GetSinceSeconds() int64 {
+	if x != nil {
+		return x.SinceSeconds
+	}
+	return 0
+}
+
+func (x *GetControllerLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *GetControllerLogsRequest) GetLogLevelFilter() string {
+	if x != nil {
+		return x.LogLevelFilter
+	}
+	return ""
+}
+
+type GetControllerLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Either entry or error is set
+	Entry         *LogEntry  `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Error         *ListError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetControllerLogsResponse) Reset() {
+	*x = GetControllerLogsResponse{}
+	mi := &file_api_core_core_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetControllerLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetControllerLogsResponse) ProtoMessage() {}
+
+func (x *GetControllerLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_core_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetControllerLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetControllerLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_core_core_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetControllerLogsResponse) GetEntry() *LogEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *GetControllerLogsResponse) GetError() *ListError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
 type IsCRDAvailableRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -2637,7 +5589,7 @@ type IsCRDAvailableRequest struct {
 
 func (x *IsCRDAvailableRequest) Reset() {
 	*x = IsCRDAvailableRequest{}
-	mi := &file_api_core_core_proto_msgTypes[46]
+	mi := &file_api_core_core_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2649,7 +5601,7 @@ func (x *IsCRDAvailableRequest) String() string {
 func (*IsCRDAvailableRequest) ProtoMessage() {}
 
 func (x *IsCRDAvailableRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[46]
+	mi := &file_api_core_core_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2662,7 +5614,7 @@ func (x *IsCRDAvailableRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsCRDAvailableRequest.ProtoReflect.Descriptor instead.
 func (*IsCRDAvailableRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{46}
+	return file_api_core_core_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *IsCRDAvailableRequest) GetName() string {
@@ -2681,7 +5633,7 @@ type IsCRDAvailableResponse struct {
 
 func (x *IsCRDAvailableResponse) Reset() {
 	*x = IsCRDAvailableResponse{}
-	mi := &file_api_core_core_proto_msgTypes[47]
+	mi := &file_api_core_core_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2693,7 +5645,7 @@ func (x *IsCRDAvailableResponse) String() string {
 func (*IsCRDAvailableResponse) ProtoMessage() {}
 
 func (x *IsCRDAvailableResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[47]
+	mi := &file_api_core_core_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2706,7 +5658,7 @@ func (x *IsCRDAvailableResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsCRDAvailableResponse.ProtoReflect.Descriptor instead.
 func (*IsCRDAvailableResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{47}
+	return file_api_core_core_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *IsCRDAvailableResponse) GetClusters() map[string]bool {
@@ -2726,7 +5678,7 @@ type ListPoliciesRequest struct {
 
 func (x *ListPoliciesRequest) Reset() {
 	*x = ListPoliciesRequest{}
-	mi := &file_api_core_core_proto_msgTypes[48]
+	mi := &file_api_core_core_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2738,7 +5690,7 @@ func (x *ListPoliciesRequest) String() string {
 func (*ListPoliciesRequest) ProtoMessage() {}
 
 func (x *ListPoliciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[48]
+	mi := &file_api_core_core_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2751,7 +5703,7 @@ func (x *ListPoliciesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPoliciesRequest.ProtoReflect.Descriptor instead.
 func (*ListPoliciesRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{48}
+	return file_api_core_core_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *ListPoliciesRequest) GetClusterName() string {
@@ -2780,7 +5732,7 @@ type ListPoliciesResponse struct {
 
 func (x *ListPoliciesResponse) Reset() {
 	*x = ListPoliciesResponse{}
-	mi := &file_api_core_core_proto_msgTypes[49]
+	mi := &file_api_core_core_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2792,7 +5744,7 @@ func (x *ListPoliciesResponse) String() string {
 func (*ListPoliciesResponse) ProtoMessage() {}
 
 func (x *ListPoliciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[49]
+	mi := &file_api_core_core_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2805,7 +5757,7 @@ func (x *ListPoliciesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPoliciesResponse.ProtoReflect.Descriptor instead.
 func (*ListPoliciesResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{49}
+	return file_api_core_core_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *ListPoliciesResponse) GetPolicies() []*PolicyObj {
@@ -2846,7 +5798,7 @@ type GetPolicyRequest struct {
 
 func (x *GetPolicyRequest) Reset() {
 	*x = GetPolicyRequest{}
-	mi := &file_api_core_core_proto_msgTypes[50]
+	mi := &file_api_core_core_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2858,7 +5810,7 @@ func (x *GetPolicyRequest) String() string {
 func (*GetPolicyRequest) ProtoMessage() {}
 
 func (x *GetPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[50]
+	mi := &file_api_core_core_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2871,7 +5823,7 @@ func (x *GetPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPolicyRequest.ProtoReflect.Descriptor instead.
 func (*GetPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{50}
+	return file_api_core_core_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *GetPolicyRequest) GetPolicyName() string {
@@ -2898,7 +5850,7 @@ type GetPolicyResponse struct {
 
 func (x *GetPolicyResponse) Reset() {
 	*x = GetPolicyResponse{}
-	mi := &file_api_core_core_proto_msgTypes[51]
+	mi := &file_api_core_core_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2910,7 +5862,7 @@ func (x *GetPolicyResponse) String() string {
 func (*GetPolicyResponse) ProtoMessage() {}
 
 func (x *GetPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[51]
+	mi := &file_api_core_core_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2923,7 +5875,7 @@ func (x *GetPolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPolicyResponse.ProtoReflect.Descriptor instead.
 func (*GetPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{51}
+	return file_api_core_core_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *GetPolicyResponse) GetPolicy() *PolicyObj {
@@ -2964,7 +5916,7 @@ type PolicyObj struct {
 
 func (x *PolicyObj) Reset() {
 	*x = PolicyObj{}
-	mi := &file_api_core_core_proto_msgTypes[52]
+	mi := &file_api_core_core_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2976,7 +5928,7 @@ func (x *PolicyObj) String() string {
 func (*PolicyObj) ProtoMessage() {}
 
 func (x *PolicyObj) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[52]
+	mi := &file_api_core_core_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2989,7 +5941,7 @@ func (x *PolicyObj) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyObj.ProtoReflect.Descriptor instead.
 func (*PolicyObj) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{52}
+	return file_api_core_core_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *PolicyObj) GetName() string {
@@ -3114,7 +6066,7 @@ type PolicyStandard struct {
 
 func (x *PolicyStandard) Reset() {
 	*x = PolicyStandard{}
-	mi := &file_api_core_core_proto_msgTypes[53]
+	mi := &file_api_core_core_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3126,7 +6078,7 @@ func (x *PolicyStandard) String() string {
 func (*PolicyStandard) ProtoMessage() {}
 
 func (x *PolicyStandard) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[53]
+	mi := &file_api_core_core_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3139,7 +6091,7 @@ func (x *PolicyStandard) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyStandard.ProtoReflect.Descriptor instead.
 func (*PolicyStandard) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{53}
+	return file_api_core_core_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *PolicyStandard) GetId() string {
@@ -3170,7 +6122,7 @@ type PolicyParam struct {
 
 func (x *PolicyParam) Reset() {
 	*x = PolicyParam{}
-	mi := &file_api_core_core_proto_msgTypes[54]
+	mi := &file_api_core_core_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3182,7 +6134,7 @@ func (x *PolicyParam) String() string {
 func (*PolicyParam) ProtoMessage() {}
 
 func (x *PolicyParam) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[54]
+	mi := &file_api_core_core_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3195,7 +6147,7 @@ func (x *PolicyParam) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyParam.ProtoReflect.Descriptor instead.
 func (*PolicyParam) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{54}
+	return file_api_core_core_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *PolicyParam) GetName() string {
@@ -3237,7 +6189,7 @@ type PolicyTargets struct {
 
 func (x *PolicyTargets) Reset() {
 	*x = PolicyTargets{}
-	mi := &file_api_core_core_proto_msgTypes[55]
+	mi := &file_api_core_core_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3249,7 +6201,7 @@ func (x *PolicyTargets) String() string {
 func (*PolicyTargets) ProtoMessage() {}
 
 func (x *PolicyTargets) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[55]
+	mi := &file_api_core_core_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3262,7 +6214,7 @@ func (x *PolicyTargets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyTargets.ProtoReflect.Descriptor instead.
 func (*PolicyTargets) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{55}
+	return file_api_core_core_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *PolicyTargets) GetKinds() []string {
@@ -3295,7 +6247,7 @@ type PolicyTargetLabel struct {
 
 func (x *PolicyTargetLabel) Reset() {
 	*x = PolicyTargetLabel{}
-	mi := &file_api_core_core_proto_msgTypes[56]
+	mi := &file_api_core_core_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3307,7 +6259,7 @@ func (x *PolicyTargetLabel) String() string {
 func (*PolicyTargetLabel) ProtoMessage() {}
 
 func (x *PolicyTargetLabel) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_core_proto_msgTypes[56]
+	mi := &file_api_core_core_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3320,7 +6272,7 @@ func (x *PolicyTargetLabel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyTargetLabel.ProtoReflect.Descriptor instead.
 func (*PolicyTargetLabel) Descriptor() ([]byte, []int) {
-	return file_api_core_core_proto_rawDescGZIP(), []int{56}
+	return file_api_core_core_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *PolicyTargetLabel) GetValues() map[string]string {
@@ -3340,9 +6292,166 @@ const file_api_core_core_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
 	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12!\n" +
 	"\fcluster_name\x18\x04 \x01(\tR\vclusterName\x12#\n" +
-	"\rwith_children\x18\x05 \x01(\bR\fwithChildren\"P\n" +
+	"\rwith_children\x18\x05 \x01(\bR\fwithChildren\"\x96\x01\n" +
 	"\x14GetInventoryResponse\x128\n" +
-	"\aentries\x18\x01 \x03(\v2\x1e.gitops_core.v1.InventoryEntryR\aentries\"\xe1\x04\n" +
+	"\aentries\x18\x01 \x03(\v2\x1e.gitops_core.v1.InventoryEntryR\aentries\x12D\n" +
+	"\x0ehealth_summary\x18\x02 \x01(\v2\x1d.gitops_core.v1.HealthSummaryR\rhealthSummary\"\xab\x01\n" +
+	"\x1bGetApplicationHealthRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12!\n" +
+	"\fcluster_name\x18\x04 \x01(\tR\vclusterName\x12#\n" +
+	"\rwith_children\x18\x05 \x01(\bR\fwithChildren\"W\n" +
+	"\x1cGetApplicationHealthResponse\x127\n" +
+	"\asummary\x18\x01 \x01(\v2\x1d.gitops_core.v1.HealthSummaryR\asummary\"\\\n" +
+	"\x19GetDependencyGraphRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\xf4\x01\n" +
+	"\x1aGetDependencyGraphResponse\x124\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1e.gitops_core.v1.DependencyNodeR\x05nodes\x124\n" +
+	"\x05edges\x18\x02 \x03(\v2\x1e.gitops_core.v1.DependencyEdgeR\x05edges\x127\n" +
+	"\x06cycles\x18\x03 \x03(\v2\x1f.gitops_core.v1.DependencyCycleR\x06cycles\x121\n" +
+	"\x06errors\x18\x04 \x03(\v2\x19.gitops_core.v1.ListErrorR\x06errors\"\x7f\n" +
+	"\x18DiffKustomizationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12!\n" +
+	"\fcluster_name\x18\x03 \x01(\tR\vclusterNameJ\x04\b\x04\x10\x05R\brevision\"i\n" +
+	"\x19DiffKustomizationResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\tR\brevision\x120\n" +
+	"\x05diffs\x18\x02 \x03(\v2\x1a.gitops_core.v1.ObjectDiffR\x05diffs\"\xb5\x01\n" +
+	"\x16ListAuditEventsRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1c\n" +
+	"\tprincipal\x18\x05 \x01(\tR\tprincipal\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"M\n" +
+	"\x17ListAuditEventsResponse\x122\n" +
+	"\x06events\x18\x01 \x03(\v2\x1a.gitops_core.v1.AuditEventR\x06events\"U\n" +
+	"\x15CreateAPITokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12\x10\n" +
+	"\x03ttl\x18\x03 \x01(\tR\x03ttl\"b\n" +
+	"\x16CreateAPITokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x122\n" +
+	"\adetails\x18\x02 \x01(\v2\x18.gitops_core.v1.APITokenR\adetails\"\x16\n" +
+	"\x14ListAPITokensRequest\"I\n" +
+	"\x15ListAPITokensResponse\x120\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x18.gitops_core.v1.APITokenR\x06tokens\"'\n" +
+	"\x15RevokeAPITokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16RevokeAPITokenResponse\")\n" +
+	"\x13ListSessionsRequest\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\"K\n" +
+	"\x14ListSessionsResponse\x123\n" +
+	"\bsessions\x18\x01 \x03(\v2\x17.gitops_core.v1.SessionR\bsessions\";\n" +
+	"\x15RevokeSessionsRequest\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"2\n" +
+	"\x16RevokeSessionsResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x05R\arevoked\"\xa6\x01\n" +
+	"\x1aGetOwningAutomationRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\vapi_version\x18\x05 \x01(\tR\n" +
+	"apiVersion\"s\n" +
+	"\x1bGetOwningAutomationResponse\x128\n" +
+	"\x06owners\x18\x01 \x03(\v2 .gitops_core.v1.OwningAutomationR\x06owners\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\xe9\x02\n" +
+	"\rSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\x0elabel_selector\x18\x04 \x01(\tR\rlabelSelector\x12 \n" +
+	"\vannotations\x18\x05 \x03(\tR\vannotations\x128\n" +
+	"\n" +
+	"source_ref\x18\x06 \x01(\v2\x19.gitops_core.v1.ObjectRefR\tsourceRef\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12D\n" +
+	"\x10inventory_member\x18\b \x01(\v2\x19.gitops_core.v1.ObjectRefR\x0finventoryMember\x12!\n" +
+	"\fcluster_name\x18\t \x01(\tR\vclusterName\x12\x14\n" +
+	"\x05limit\x18\n" +
+	" \x01(\x05R\x05limit\"}\n" +
+	"\x0eSearchResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.gitops_core.v1.SearchResultR\aresults\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1d\n" +
+	"\n" +
+	"indexed_at\x18\x03 \x01(\tR\tindexedAt\"z\n" +
+	"\x0fGetDriftRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\"\x9b\x01\n" +
+	"\x10GetDriftResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\tR\brevision\x12\x18\n" +
+	"\achecked\x18\x02 \x01(\x05R\achecked\x125\n" +
+	"\aobjects\x18\x03 \x03(\v2\x1b.gitops_core.v1.ObjectDriftR\aobjects\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\"v\n" +
+	"\x1fListHelmReleaseRevisionsRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"e\n" +
+	" ListHelmReleaseRevisionsResponse\x12A\n" +
+	"\trevisions\x18\x01 \x03(\v2#.gitops_core.v1.HelmReleaseRevisionR\trevisions\"\xa5\x01\n" +
+	"\x1aRollbackHelmReleaseRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\"^\n" +
+	"\x1bRollbackHelmReleaseResponse\x12#\n" +
+	"\rchart_version\x18\x01 \x01(\tR\fchartVersion\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\xa1\x01\n" +
+	"\x18ListArtifactFilesRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12\x1c\n" +
+	"\tdirectory\x18\x05 \x01(\tR\tdirectory\"\x83\x01\n" +
+	"\x19ListArtifactFilesResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\tR\brevision\x12\x16\n" +
+	"\x06digest\x18\x02 \x01(\tR\x06digest\x122\n" +
+	"\x05files\x18\x03 \x03(\v2\x1c.gitops_core.v1.ArtifactFileR\x05files\"\x95\x01\n" +
+	"\x16GetArtifactFileRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\"\xad\x01\n" +
+	"\x17GetArtifactFileResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\tR\brevision\x12\x16\n" +
+	"\x06digest\x18\x02 \x01(\tR\x06digest\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\fR\acontent\x12\x1c\n" +
+	"\ttruncated\x18\x06 \x01(\bR\ttruncated\"^\n" +
+	"\x1bListTerraformObjectsRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\x8c\x01\n" +
+	"\x1cListTerraformObjectsResponse\x129\n" +
+	"\aobjects\x18\x01 \x03(\v2\x1f.gitops_core.v1.TerraformObjectR\aobjects\x121\n" +
+	"\x06errors\x18\x02 \x03(\v2\x19.gitops_core.v1.ListErrorR\x06errors\"n\n" +
+	"\x17GetTerraformPlanRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"c\n" +
+	"\x18GetTerraformPlanResponse\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\tR\x06planId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x16\n" +
+	"\x06output\x18\x03 \x01(\tR\x06output\"\x8b\x01\n" +
+	"\x1bApproveTerraformPlanRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x17\n" +
+	"\aplan_id\x18\x04 \x01(\tR\x06planId\"7\n" +
+	"\x1cApproveTerraformPlanResponse\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\tR\x06planId\"s\n" +
+	"\x1cReplanTerraformObjectRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"\x1f\n" +
+	"\x1dReplanTerraformObjectResponse\"\xe1\x04\n" +
 	"\x10PolicyValidation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
@@ -3441,24 +6550,51 @@ const file_api_core_core_proto_rawDesc = "" +
 	"\x04kind\x18\x03 \x01(\tR\x04kind\x12!\n" +
 	"\fcluster_name\x18\x04 \x01(\tR\vclusterName\"C\n" +
 	"\x11GetObjectResponse\x12.\n" +
-	"\x06object\x18\x01 \x01(\v2\x16.gitops_core.v1.ObjectR\x06object\"\xec\x01\n" +
+	"\x06object\x18\x01 \x01(\v2\x16.gitops_core.v1.ObjectR\x06object\"\xf6\x03\n" +
 	"\x12ListObjectsRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
 	"\x04kind\x18\x02 \x01(\tR\x04kind\x12!\n" +
 	"\fcluster_name\x18\x03 \x01(\tR\vclusterName\x12F\n" +
-	"\x06labels\x18\x04 \x03(\v2..gitops_core.v1.ListObjectsRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\x06labels\x18\x04 \x03(\v2..gitops_core.v1.ListObjectsRequest.LabelsEntryR\x06labels\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12\x14\n" +
+	"\x05ready\x18\a \x01(\tR\x05ready\x12\x1c\n" +
+	"\tsuspended\x18\b \x01(\tR\tsuspended\x128\n" +
+	"\n" +
+	"source_ref\x18\t \x01(\v2\x19.gitops_core.v1.ObjectRefR\tsourceRef\x12#\n" +
+	"\rname_contains\x18\n" +
+	" \x01(\tR\fnameContains\x12\x19\n" +
+	"\border_by\x18\v \x01(\tR\aorderBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\f \x01(\bR\n" +
+	"descending\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xee\x01\n" +
+	"\x13WatchObjectsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12!\n" +
+	"\fcluster_name\x18\x03 \x01(\tR\vclusterName\x12G\n" +
+	"\x06labels\x18\x04 \x03(\v2/.gitops_core.v1.WatchObjectsRequest.LabelsEntryR\x06labels\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Y\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8b\x01\n" +
+	"\x14WatchObjectsResponse\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12.\n" +
+	"\x06object\x18\x02 \x01(\v2\x16.gitops_core.v1.ObjectR\x06object\x12/\n" +
+	"\x05error\x18\x03 \x01(\v2\x19.gitops_core.v1.ListErrorR\x05error\"Y\n" +
 	"\x14ClusterNamespaceList\x12!\n" +
 	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1e\n" +
 	"\n" +
 	"namespaces\x18\x02 \x03(\tR\n" +
-	"namespaces\"\xd1\x01\n" +
+	"namespaces\"\x8f\x02\n" +
 	"\x13ListObjectsResponse\x120\n" +
 	"\aobjects\x18\x01 \x03(\v2\x16.gitops_core.v1.ObjectR\aobjects\x121\n" +
 	"\x06errors\x18\x02 \x03(\v2\x19.gitops_core.v1.ListErrorR\x06errors\x12U\n" +
-	"\x13searched_namespaces\x18\x03 \x03(\v2$.gitops_core.v1.ClusterNamespaceListR\x12searchedNamespaces\"\xe8\x01\n" +
+	"\x13searched_namespaces\x18\x03 \x03(\v2$.gitops_core.v1.ClusterNamespaceListR\x12searchedNamespaces\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\"\xe8\x01\n" +
 	"\x1bGetReconciledObjectsRequest\x12'\n" +
 	"\x0fautomation_name\x18\x01 \x01(\tR\x0eautomationName\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12'\n" +
@@ -3486,12 +6622,21 @@ const file_api_core_core_proto_rawDesc = "" +
 	"\x11ListEventsRequest\x12B\n" +
 	"\x0finvolved_object\x18\x01 \x01(\v2\x19.gitops_core.v1.ObjectRefR\x0einvolvedObject\"C\n" +
 	"\x12ListEventsResponse\x12-\n" +
-	"\x06events\x18\x01 \x03(\v2\x15.gitops_core.v1.EventR\x06events\"m\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.gitops_core.v1.EventR\x06events\"\x8a\x01\n" +
+	"\x1fGetReconciliationHistoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12!\n" +
+	"\fcluster_name\x18\x04 \x01(\tR\vclusterName\"b\n" +
+	" GetReconciliationHistoryResponse\x12>\n" +
+	"\arecords\x18\x01 \x03(\v2$.gitops_core.v1.ReconciliationRecordR\arecords\"\x87\x01\n" +
 	"\x15SyncFluxObjectRequest\x123\n" +
 	"\aobjects\x18\x01 \x03(\v2\x19.gitops_core.v1.ObjectRefR\aobjects\x12\x1f\n" +
 	"\vwith_source\x18\x02 \x01(\bR\n" +
-	"withSource\"\x18\n" +
-	"\x16SyncFluxObjectResponse\"\x13\n" +
+	"withSource\x12\x18\n" +
+	"\acascade\x18\x03 \x01(\bR\acascade\"P\n" +
+	"\x16SyncFluxObjectResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.gitops_core.v1.ObjectResultR\aresults\"\x13\n" +
 	"\x11GetVersionRequest\"\x9e\x01\n" +
 	"\x12GetVersionResponse\x12\x16\n" +
 	"\x06semver\x18\x01 \x01(\tR\x06semver\x12\x16\n" +
@@ -3506,12 +6651,18 @@ const file_api_core_core_proto_rawDesc = "" +
 	"\n" +
 	"FlagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x87\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xcc\x02\n" +
 	"\x1cToggleSuspendResourceRequest\x123\n" +
 	"\aobjects\x18\x01 \x03(\v2\x19.gitops_core.v1.ObjectRefR\aobjects\x12\x18\n" +
 	"\asuspend\x18\x02 \x01(\bR\asuspend\x12\x18\n" +
-	"\acomment\x18\x03 \x01(\tR\acomment\"\x1f\n" +
-	"\x1dToggleSuspendResourceResponse\"\xcf\x01\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x18\n" +
+	"\acascade\x18\x04 \x01(\bR\acascade\x12#\n" +
+	"\rsuspend_until\x18\x05 \x01(\tR\fsuspendUntil\x12'\n" +
+	"\x0ffreeze_schedule\x18\x06 \x01(\tR\x0efreezeSchedule\x12'\n" +
+	"\x0ffreeze_duration\x18\a \x01(\tR\x0efreezeDuration\x122\n" +
+	"\x15clear_freeze_schedule\x18\b \x01(\bR\x13clearFreezeSchedule\"W\n" +
+	"\x1dToggleSuspendResourceResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.gitops_core.v1.ObjectResultR\aresults\"\xcf\x01\n" +
 	"\x15GetSessionLogsRequest\x12+\n" +
 	"\x11session_namespace\x18\x01 \x01(\tR\x10sessionNamespace\x12\x1d\n" +
 	"\n" +
@@ -3532,7 +6683,18 @@ const file_api_core_core_proto_rawDesc = "" +
 	"next_token\x18\x02 \x01(\tR\tnextToken\x12\x14\n" +
 	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1f\n" +
 	"\vlog_sources\x18\x04 \x03(\tR\n" +
-	"logSources\"+\n" +
+	"logSources\"\xea\x01\n" +
+	"\x18GetControllerLogsRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12#\n" +
+	"\rsince_seconds\x18\x05 \x01(\x03R\fsinceSeconds\x12\x16\n" +
+	"\x06follow\x18\x06 \x01(\bR\x06follow\x12(\n" +
+	"\x10log_level_filter\x18\a \x01(\tR\x0elogLevelFilter\"|\n" +
+	"\x19GetControllerLogsResponse\x12.\n" +
+	"\x05entry\x18\x01 \x01(\v2\x18.gitops_core.v1.LogEntryR\x05entry\x12/\n" +
+	"\x05error\x18\x02 \x01(\v2\x19.gitops_core.v1.ListErrorR\x05error\"+\n" +
 	"\x15IsCRDAvailableRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"\xa7\x01\n" +
 	"\x16IsCRDAvailableResponse\x12P\n" +
@@ -3598,10 +6760,11 @@ const file_api_core_core_proto_rawDesc = "" +
 	"\x06values\x18\x01 \x03(\v2-.gitops_core.v1.PolicyTargetLabel.ValuesEntryR\x06values\x1a9\n" +
 	"\vValuesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xf9\x15\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012\xa7.\n" +
 	"\x04Core\x12k\n" +
 	"\tGetObject\x12 .gitops_core.v1.GetObjectRequest\x1a!.gitops_core.v1.GetObjectResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/object/{name}\x12n\n" +
-	"\vListObjects\x12\".gitops_core.v1.ListObjectsRequest\x1a#.gitops_core.v1.ListObjectsResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/objects\x12\x99\x01\n" +
+	"\vListObjects\x12\".gitops_core.v1.ListObjectsRequest\x1a#.gitops_core.v1.ListObjectsResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/objects\x12y\n" +
+	"\fWatchObjects\x12#.gitops_core.v1.WatchObjectsRequest\x1a$.gitops_core.v1.WatchObjectsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/watch_objects0\x01\x12\x99\x01\n" +
 	"\x16ListFluxRuntimeObjects\x12-.gitops_core.v1.ListFluxRuntimeObjectsRequest\x1a..gitops_core.v1.ListFluxRuntimeObjectsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/flux_runtime_objects\x12p\n" +
 	"\fListFluxCrds\x12#.gitops_core.v1.ListFluxCrdsRequest\x1a$.gitops_core.v1.ListFluxCrdsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/flux_crds\x12\x88\x01\n" +
 	"\x12ListRuntimeObjects\x12).gitops_core.v1.ListRuntimeObjectsRequest\x1a*.gitops_core.v1.ListRuntimeObjectsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/runtime_objects\x12|\n" +
@@ -3612,15 +6775,38 @@ const file_api_core_core_proto_rawDesc = "" +
 	"\x0eListNamespaces\x12%.gitops_core.v1.ListNamespacesRequest\x1a&.gitops_core.v1.ListNamespacesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/namespaces\x12g\n" +
 	"\n" +
 	"ListEvents\x12!.gitops_core.v1.ListEventsRequest\x1a\".gitops_core.v1.ListEventsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
-	"/v1/events\x12t\n" +
+	"/v1/events\x12\xa1\x01\n" +
+	"\x18GetReconciliationHistory\x12/.gitops_core.v1.GetReconciliationHistoryRequest\x1a0.gitops_core.v1.GetReconciliationHistoryResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/reconciliation_history\x12t\n" +
 	"\x0eSyncFluxObject\x12%.gitops_core.v1.SyncFluxObjectRequest\x1a&.gitops_core.v1.SyncFluxObjectResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/sync\x12h\n" +
 	"\n" +
 	"GetVersion\x12!.gitops_core.v1.GetVersionRequest\x1a\".gitops_core.v1.GetVersionResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/version\x12|\n" +
 	"\x0fGetFeatureFlags\x12&.gitops_core.v1.GetFeatureFlagsRequest\x1a'.gitops_core.v1.GetFeatureFlagsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/featureflags\x12\x8c\x01\n" +
 	"\x15ToggleSuspendResource\x12,.gitops_core.v1.ToggleSuspendResourceRequest\x1a-.gitops_core.v1.ToggleSuspendResourceResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/suspend\x12|\n" +
-	"\x0eGetSessionLogs\x12%.gitops_core.v1.GetSessionLogsRequest\x1a&.gitops_core.v1.GetSessionLogsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/session_logs\x12}\n" +
+	"\x0eGetSessionLogs\x12%.gitops_core.v1.GetSessionLogsRequest\x1a&.gitops_core.v1.GetSessionLogsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/session_logs\x12\x8a\x01\n" +
+	"\x11GetControllerLogs\x12(.gitops_core.v1.GetControllerLogsRequest\x1a).gitops_core.v1.GetControllerLogsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/controller_logs0\x01\x12}\n" +
 	"\x0eIsCRDAvailable\x12%.gitops_core.v1.IsCRDAvailableRequest\x1a&.gitops_core.v1.IsCRDAvailableResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/crd/is_available\x12p\n" +
-	"\fGetInventory\x12#.gitops_core.v1.GetInventoryRequest\x1a$.gitops_core.v1.GetInventoryResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/inventory\x12o\n" +
+	"\fGetInventory\x12#.gitops_core.v1.GetInventoryRequest\x1a$.gitops_core.v1.GetInventoryResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/inventory\x12\x91\x01\n" +
+	"\x14GetApplicationHealth\x12+.gitops_core.v1.GetApplicationHealthRequest\x1a,.gitops_core.v1.GetApplicationHealthResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/application_health\x12\x89\x01\n" +
+	"\x12GetDependencyGraph\x12).gitops_core.v1.GetDependencyGraphRequest\x1a*.gitops_core.v1.GetDependencyGraphResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/dependency_graph\x12\x8b\x01\n" +
+	"\x11DiffKustomization\x12(.gitops_core.v1.DiffKustomizationRequest\x1a).gitops_core.v1.DiffKustomizationResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/diff_kustomization\x12|\n" +
+	"\x0fListAuditEvents\x12&.gitops_core.v1.ListAuditEventsRequest\x1a'.gitops_core.v1.ListAuditEventsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/audit_events\x12z\n" +
+	"\x0eCreateAPIToken\x12%.gitops_core.v1.CreateAPITokenRequest\x1a&.gitops_core.v1.CreateAPITokenResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/api_tokens\x12t\n" +
+	"\rListAPITokens\x12$.gitops_core.v1.ListAPITokensRequest\x1a%.gitops_core.v1.ListAPITokensResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/api_tokens\x12\x81\x01\n" +
+	"\x0eRevokeAPIToken\x12%.gitops_core.v1.RevokeAPITokenRequest\x1a&.gitops_core.v1.RevokeAPITokenResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/api_tokens/revoke\x12o\n" +
+	"\fListSessions\x12#.gitops_core.v1.ListSessionsRequest\x1a$.gitops_core.v1.ListSessionsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/sessions\x12\x7f\n" +
+	"\x0eRevokeSessions\x12%.gitops_core.v1.RevokeSessionsRequest\x1a&.gitops_core.v1.RevokeSessionsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/sessions/revoke\x12\x8d\x01\n" +
+	"\x13GetOwningAutomation\x12*.gitops_core.v1.GetOwningAutomationRequest\x1a+.gitops_core.v1.GetOwningAutomationResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/owning_automation\x12^\n" +
+	"\x06Search\x12\x1d.gitops_core.v1.SearchRequest\x1a\x1e.gitops_core.v1.SearchResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/search\x12`\n" +
+	"\bGetDrift\x12\x1f.gitops_core.v1.GetDriftRequest\x1a .gitops_core.v1.GetDriftResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/drift\x12\xa0\x01\n" +
+	"\x18ListHelmReleaseRevisions\x12/.gitops_core.v1.ListHelmReleaseRevisionsRequest\x1a0.gitops_core.v1.ListHelmReleaseRevisionsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/helmrelease/revisions\x12\x93\x01\n" +
+	"\x13RollbackHelmRelease\x12*.gitops_core.v1.RollbackHelmReleaseRequest\x1a+.gitops_core.v1.RollbackHelmReleaseResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/helmrelease/rollback\x12\x84\x01\n" +
+	"\x11ListArtifactFiles\x12(.gitops_core.v1.ListArtifactFilesRequest\x1a).gitops_core.v1.ListArtifactFilesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/artifact/files\x12}\n" +
+	"\x0fGetArtifactFile\x12&.gitops_core.v1.GetArtifactFileRequest\x1a'.gitops_core.v1.GetArtifactFileResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/artifact/file\x12\x90\x01\n" +
+	"\x14ListTerraformObjects\x12+.gitops_core.v1.ListTerraformObjectsRequest\x1a,.gitops_core.v1.ListTerraformObjectsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/terraform_objects\x12\x89\x01\n" +
+	"\x10GetTerraformPlan\x12'.gitops_core.v1.GetTerraformPlanRequest\x1a(.gitops_core.v1.GetTerraformPlanResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/terraform_objects/plan\x12\x9b\x01\n" +
+	"\x14ApproveTerraformPlan\x12+.gitops_core.v1.ApproveTerraformPlanRequest\x1a,.gitops_core.v1.ApproveTerraformPlanResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/terraform_objects/approve\x12\x9d\x01\n" +
+	"\x15ReplanTerraformObject\x12,.gitops_core.v1.ReplanTerraformObjectRequest\x1a-.gitops_core.v1.ReplanTerraformObjectResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/terraform_objects/replan\x12o\n" +
 	"\fListPolicies\x12#.gitops_core.v1.ListPoliciesRequest\x1a$.gitops_core.v1.ListPoliciesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/policies\x12t\n" +
 	"\tGetPolicy\x12 .gitops_core.v1.GetPolicyRequest\x1a!.gitops_core.v1.GetPolicyResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/policies/{policy_name}\x12\x96\x01\n" +
 	"\x15ListPolicyValidations\x12,.gitops_core.v1.ListPolicyValidationsRequest\x1a-.gitops_core.v1.ListPolicyValidationsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/policyvalidations\x12\x9d\x01\n" +
@@ -3639,172 +6825,310 @@ func file_api_core_core_proto_rawDescGZIP() []byte {
 	return file_api_core_core_proto_rawDescData
 }
 
-var file_api_core_core_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
+var file_api_core_core_proto_msgTypes = make([]protoimpl.MessageInfo, 108)
 var file_api_core_core_proto_goTypes = []any{
-	(*GetInventoryRequest)(nil),            // 0: gitops_core.v1.GetInventoryRequest
-	(*GetInventoryResponse)(nil),           // 1: gitops_core.v1.GetInventoryResponse
-	(*PolicyValidation)(nil),               // 2: gitops_core.v1.PolicyValidation
-	(*ListPolicyValidationsRequest)(nil),   // 3: gitops_core.v1.ListPolicyValidationsRequest
-	(*ListPolicyValidationsResponse)(nil),  // 4: gitops_core.v1.ListPolicyValidationsResponse
-	(*GetPolicyValidationRequest)(nil),     // 5: gitops_core.v1.GetPolicyValidationRequest
-	(*GetPolicyValidationResponse)(nil),    // 6: gitops_core.v1.GetPolicyValidationResponse
-	(*PolicyValidationOccurrence)(nil),     // 7: gitops_core.v1.PolicyValidationOccurrence
-	(*PolicyValidationParam)(nil),          // 8: gitops_core.v1.PolicyValidationParam
-	(*PolicyParamRepeatedString)(nil),      // 9: gitops_core.v1.PolicyParamRepeatedString
-	(*Pagination)(nil),                     // 10: gitops_core.v1.Pagination
-	(*ListError)(nil),                      // 11: gitops_core.v1.ListError
-	(*ListFluxRuntimeObjectsRequest)(nil),  // 12: gitops_core.v1.ListFluxRuntimeObjectsRequest
-	(*ListFluxRuntimeObjectsResponse)(nil), // 13: gitops_core.v1.ListFluxRuntimeObjectsResponse
-	(*ListRuntimeObjectsRequest)(nil),      // 14: gitops_core.v1.ListRuntimeObjectsRequest
-	(*ListRuntimeObjectsResponse)(nil),     // 15: gitops_core.v1.ListRuntimeObjectsResponse
-	(*ListFluxCrdsRequest)(nil),            // 16: gitops_core.v1.ListFluxCrdsRequest
-	(*ListFluxCrdsResponse)(nil),           // 17: gitops_core.v1.ListFluxCrdsResponse
-	(*ListRuntimeCrdsRequest)(nil),         // 18: gitops_core.v1.ListRuntimeCrdsRequest
-	(*ListRuntimeCrdsResponse)(nil),        // 19: gitops_core.v1.ListRuntimeCrdsResponse
-	(*GetObjectRequest)(nil),               // 20: gitops_core.v1.GetObjectRequest
-	(*GetObjectResponse)(nil),              // 21: gitops_core.v1.GetObjectResponse
-	(*ListObjectsRequest)(nil),             // 22: gitops_core.v1.ListObjectsRequest
-	(*ClusterNamespaceList)(nil),           // 23: gitops_core.v1.ClusterNamespaceList
-	(*ListObjectsResponse)(nil),            // 24: gitops_core.v1.ListObjectsResponse
-	(*GetReconciledObjectsRequest)(nil),    // 25: gitops_core.v1.GetReconciledObjectsRequest
-	(*GetReconciledObjectsResponse)(nil),   // 26: gitops_core.v1.GetReconciledObjectsResponse
-	(*GetChildObjectsRequest)(nil),         // 27: gitops_core.v1.GetChildObjectsRequest
-	(*GetChildObjectsResponse)(nil),        // 28: gitops_core.v1.GetChildObjectsResponse
-	(*GetFluxNamespaceRequest)(nil),        // 29: gitops_core.v1.GetFluxNamespaceRequest
-	(*GetFluxNamespaceResponse)(nil),       // 30: gitops_core.v1.GetFluxNamespaceResponse
-	(*ListNamespacesRequest)(nil),          // 31: gitops_core.v1.ListNamespacesRequest
-	(*ListNamespacesResponse)(nil),         // 32: gitops_core.v1.ListNamespacesResponse
-	(*ListEventsRequest)(nil),              // 33: gitops_core.v1.ListEventsRequest
-	(*ListEventsResponse)(nil),             // 34: gitops_core.v1.ListEventsResponse
-	(*SyncFluxObjectRequest)(nil),          // 35: gitops_core.v1.SyncFluxObjectRequest
-	(*SyncFluxObjectResponse)(nil),         // 36: gitops_core.v1.SyncFluxObjectResponse
-	(*GetVersionRequest)(nil),              // 37: gitops_core.v1.GetVersionRequest
-	(*GetVersionResponse)(nil),             // 38: gitops_core.v1.GetVersionResponse
-	(*GetFeatureFlagsRequest)(nil),         // 39: gitops_core.v1.GetFeatureFlagsRequest
-	(*GetFeatureFlagsResponse)(nil),        // 40: gitops_core.v1.GetFeatureFlagsResponse
-	(*ToggleSuspendResourceRequest)(nil),   // 41: gitops_core.v1.ToggleSuspendResourceRequest
-	(*ToggleSuspendResourceResponse)(nil),  // 42: gitops_core.v1.ToggleSuspendResourceResponse
-	(*GetSessionLogsRequest)(nil),          // 43: gitops_core.v1.GetSessionLogsRequest
-	(*LogEntry)(nil),                       // 44: gitops_core.v1.LogEntry
-	(*GetSessionLogsResponse)(nil),         // 45: gitops_core.v1.GetSessionLogsResponse
-	(*IsCRDAvailableRequest)(nil),          // 46: gitops_core.v1.IsCRDAvailableRequest
-	(*IsCRDAvailableResponse)(nil),         // 47: gitops_core.v1.IsCRDAvailableResponse
-	(*ListPoliciesRequest)(nil),            // 48: gitops_core.v1.ListPoliciesRequest
-	(*ListPoliciesResponse)(nil),           // 49: gitops_core.v1.ListPoliciesResponse
-	(*GetPolicyRequest)(nil),               // 50: gitops_core.v1.GetPolicyRequest
-	(*GetPolicyResponse)(nil),              // 51: gitops_core.v1.GetPolicyResponse
-	(*PolicyObj)(nil),                      // 52: gitops_core.v1.PolicyObj
-	(*PolicyStandard)(nil),                 // 53: gitops_core.v1.PolicyStandard
-	(*PolicyParam)(nil),                    // 54: gitops_core.v1.PolicyParam
-	(*PolicyTargets)(nil),                  // 55: gitops_core.v1.PolicyTargets
-	(*PolicyTargetLabel)(nil),              // 56: gitops_core.v1.PolicyTargetLabel
-	nil,                                    // 57: gitops_core.v1.ListObjectsRequest.LabelsEntry
-	nil,                                    // 58: gitops_core.v1.GetFeatureFlagsResponse.FlagsEntry
-	nil,                                    // 59: gitops_core.v1.IsCRDAvailableResponse.ClustersEntry
-	nil,                                    // 60: gitops_core.v1.PolicyTargetLabel.ValuesEntry
-	(*InventoryEntry)(nil),                 // 61: gitops_core.v1.InventoryEntry
-	(*anypb.Any)(nil),                      // 62: google.protobuf.Any
-	(*Deployment)(nil),                     // 63: gitops_core.v1.Deployment
-	(*Crd)(nil),                            // 64: gitops_core.v1.Crd
-	(*Object)(nil),                         // 65: gitops_core.v1.Object
-	(*GroupVersionKind)(nil),               // 66: gitops_core.v1.GroupVersionKind
-	(*Namespace)(nil),                      // 67: gitops_core.v1.Namespace
-	(*ObjectRef)(nil),                      // 68: gitops_core.v1.ObjectRef
-	(*Event)(nil),                          // 69: gitops_core.v1.Event
+	(*GetInventoryRequest)(nil),              // 0: gitops_core.v1.GetInventoryRequest
+	(*GetInventoryResponse)(nil),             // 1: gitops_core.v1.GetInventoryResponse
+	(*GetApplicationHealthRequest)(nil),      // 2: gitops_core.v1.GetApplicationHealthRequest
+	(*GetApplicationHealthResponse)(nil),     // 3: gitops_core.v1.GetApplicationHealthResponse
+	(*GetDependencyGraphRequest)(nil),        // 4: gitops_core.v1.GetDependencyGraphRequest
+	(*GetDependencyGraphResponse)(nil),       // 5: gitops_core.v1.GetDependencyGraphResponse
+	(*DiffKustomizationRequest)(nil),         // 6: gitops_core.v1.DiffKustomizationRequest
+	(*DiffKustomizationResponse)(nil),        // 7: gitops_core.v1.DiffKustomizationResponse
+	(*ListAuditEventsRequest)(nil),           // 8: gitops_core.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),          // 9: gitops_core.v1.ListAuditEventsResponse
+	(*CreateAPITokenRequest)(nil),            // 10: gitops_core.v1.CreateAPITokenRequest
+	(*CreateAPITokenResponse)(nil),           // 11: gitops_core.v1.CreateAPITokenResponse
+	(*ListAPITokensRequest)(nil),             // 12: gitops_core.v1.ListAPITokensRequest
+	(*ListAPITokensResponse)(nil),            // 13: gitops_core.v1.ListAPITokensResponse
+	(*RevokeAPITokenRequest)(nil),            // 14: gitops_core.v1.RevokeAPITokenRequest
+	(*RevokeAPITokenResponse)(nil),           // 15: gitops_core.v1.RevokeAPITokenResponse
+	(*ListSessionsRequest)(nil),              // 16: gitops_core.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),             // 17: gitops_core.v1.ListSessionsResponse
+	(*RevokeSessionsRequest)(nil),            // 18: gitops_core.v1.RevokeSessionsRequest
+	(*RevokeSessionsResponse)(nil),           // 19: gitops_core.v1.RevokeSessionsResponse
+	(*GetOwningAutomationRequest)(nil),       // 20: gitops_core.v1.GetOwningAutomationRequest
+	(*GetOwningAutomationResponse)(nil),      // 21: gitops_core.v1.GetOwningAutomationResponse
+	(*SearchRequest)(nil),                    // 22: gitops_core.v1.SearchRequest
+	(*SearchResponse)(nil),                   // 23: gitops_core.v1.SearchResponse
+	(*GetDriftRequest)(nil),                  // 24: gitops_core.v1.GetDriftRequest
+	(*GetDriftResponse)(nil),                 // 25: gitops_core.v1.GetDriftResponse
+	(*ListHelmReleaseRevisionsRequest)(nil),  // 26: gitops_core.v1.ListHelmReleaseRevisionsRequest
+	(*ListHelmReleaseRevisionsResponse)(nil), // 27: gitops_core.v1.ListHelmReleaseRevisionsResponse
+	(*RollbackHelmReleaseRequest)(nil),       // 28: gitops_core.v1.RollbackHelmReleaseRequest
+	(*RollbackHelmReleaseResponse)(nil),      // 29: gitops_core.v1.RollbackHelmReleaseResponse
+	(*ListArtifactFilesRequest)(nil),         // 30: gitops_core.v1.ListArtifactFilesRequest
+	(*ListArtifactFilesResponse)(nil),        // 31: gitops_core.v1.ListArtifactFilesResponse
+	(*GetArtifactFileRequest)(nil),           // 32: gitops_core.v1.GetArtifactFileRequest
+	(*GetArtifactFileResponse)(nil),          // 33: gitops_core.v1.GetArtifactFileResponse
+	(*ListTerraformObjectsRequest)(nil),      // 34: gitops_core.v1.ListTerraformObjectsRequest
+	(*ListTerraformObjectsResponse)(nil),     // 35: gitops_core.v1.ListTerraformObjectsResponse
+	(*GetTerraformPlanRequest)(nil),          // 36: gitops_core.v1.GetTerraformPlanRequest
+	(*GetTerraformPlanResponse)(nil),         // 37: gitops_core.v1.GetTerraformPlanResponse
+	(*ApproveTerraformPlanRequest)(nil),      // 38: gitops_core.v1.ApproveTerraformPlanRequest
+	(*ApproveTerraformPlanResponse)(nil),     // 39: gitops_core.v1.ApproveTerraformPlanResponse
+	(*ReplanTerraformObjectRequest)(nil),     // 40: gitops_core.v1.ReplanTerraformObjectRequest
+	(*ReplanTerraformObjectResponse)(nil),    // 41: gitops_core.v1.ReplanTerraformObjectResponse
+	(*PolicyValidation)(nil),                 // 42: gitops_core.v1.PolicyValidation
+	(*ListPolicyValidationsRequest)(nil),     // 43: gitops_core.v1.ListPolicyValidationsRequest
+	(*ListPolicyValidationsResponse)(nil),    // 44: gitops_core.v1.ListPolicyValidationsResponse
+	(*GetPolicyValidationRequest)(nil),       // 45: gitops_core.v1.GetPolicyValidationRequest
+	(*GetPolicyValidationResponse)(nil),      // 46: gitops_core.v1.GetPolicyValidationResponse
+	(*PolicyValidationOccurrence)(nil),       // 47: gitops_core.v1.PolicyValidationOccurrence
+	(*PolicyValidationParam)(nil),            // 48: gitops_core.v1.PolicyValidationParam
+	(*PolicyParamRepeatedString)(nil),        // 49: gitops_core.v1.PolicyParamRepeatedString
+	(*Pagination)(nil),                       // 50: gitops_core.v1.Pagination
+	(*ListError)(nil),                        // 51: gitops_core.v1.ListError
+	(*ListFluxRuntimeObjectsRequest)(nil),    // 52: gitops_core.v1.ListFluxRuntimeObjectsRequest
+	(*ListFluxRuntimeObjectsResponse)(nil),   // 53: gitops_core.v1.ListFluxRuntimeObjectsResponse
+	(*ListRuntimeObjectsRequest)(nil),        // 54: gitops_core.v1.ListRuntimeObjectsRequest
+	(*ListRuntimeObjectsResponse)(nil),       // 55: gitops_core.v1.ListRuntimeObjectsResponse
+	(*ListFluxCrdsRequest)(nil),              // 56: gitops_core.v1.ListFluxCrdsRequest
+	(*ListFluxCrdsResponse)(nil),             // 57: gitops_core.v1.ListFluxCrdsResponse
+	(*ListRuntimeCrdsRequest)(nil),           // 58: gitops_core.v1.ListRuntimeCrdsRequest
+	(*ListRuntimeCrdsResponse)(nil),          // 59: gitops_core.v1.ListRuntimeCrdsResponse
+	(*GetObjectRequest)(nil),                 // 60: gitops_core.v1.GetObjectRequest
+	(*GetObjectResponse)(nil),                // 61: gitops_core.v1.GetObjectResponse
+	(*ListObjectsRequest)(nil),               // 62: gitops_core.v1.ListObjectsRequest
+	(*WatchObjectsRequest)(nil),              // 63: gitops_core.v1.WatchObjectsRequest
+	(*WatchObjectsResponse)(nil),             // 64: gitops_core.v1.WatchObjectsResponse
+	(*ClusterNamespaceList)(nil),             // 65: gitops_core.v1.ClusterNamespaceList
+	(*ListObjectsResponse)(nil),              // 66: gitops_core.v1.ListObjectsResponse
+	(*GetReconciledObjectsRequest)(nil),      // 67: gitops_core.v1.GetReconciledObjectsRequest
+	(*GetReconciledObjectsResponse)(nil),     // 68: gitops_core.v1.GetReconciledObjectsResponse
+	(*GetChildObjectsRequest)(nil),           // 69: gitops_core.v1.GetChildObjectsRequest
+	(*GetChildObjectsResponse)(nil),          // 70: gitops_core.v1.GetChildObjectsResponse
+	(*GetFluxNamespaceRequest)(nil),          // 71: gitops_core.v1.GetFluxNamespaceRequest
+	(*GetFluxNamespaceResponse)(nil),         // 72: gitops_core.v1.GetFluxNamespaceResponse
+	(*ListNamespacesRequest)(nil),            // 73: gitops_core.v1.ListNamespacesRequest
+	(*ListNamespacesResponse)(nil),           // 74: gitops_core.v1.ListNamespacesResponse
+	(*ListEventsRequest)(nil),                // 75: gitops_core.v1.ListEventsRequest
+	(*ListEventsResponse)(nil),               // 76: gitops_core.v1.ListEventsResponse
+	(*GetReconciliationHistoryRequest)(nil),  // 77: gitops_core.v1.GetReconciliationHistoryRequest
+	(*GetReconciliationHistoryResponse)(nil), // 78: gitops_core.v1.GetReconciliationHistoryResponse
+	(*SyncFluxObjectRequest)(nil),            // 79: gitops_core.v1.SyncFluxObjectRequest
+	(*SyncFluxObjectResponse)(nil),           // 80: gitops_core.v1.SyncFluxObjectResponse
+	(*GetVersionRequest)(nil),                // 81: gitops_core.v1.GetVersionRequest
+	(*GetVersionResponse)(nil),               // 82: gitops_core.v1.GetVersionResponse
+	(*GetFeatureFlagsRequest)(nil),           // 83: gitops_core.v1.GetFeatureFlagsRequest
+	(*GetFeatureFlagsResponse)(nil),          // 84: gitops_core.v1.GetFeatureFlagsResponse
+	(*ToggleSuspendResourceRequest)(nil),     // 85: gitops_core.v1.ToggleSuspendResourceRequest
+	(*ToggleSuspendResourceResponse)(nil),    // 86: gitops_core.v1.ToggleSuspendResourceResponse
+	(*GetSessionLogsRequest)(nil),            // 87: gitops_core.v1.GetSessionLogsRequest
+	(*LogEntry)(nil),                         // 88: gitops_core.v1.LogEntry
+	(*GetSessionLogsResponse)(nil),           // 89: gitops_core.v1.GetSessionLogsResponse
+	(*GetControllerLogsRequest)(nil),         // 90: gitops_core.v1.GetControllerLogsRequest
+	(*GetControllerLogsResponse)(nil),        // 91: gitops_core.v1.GetControllerLogsResponse
+	(*IsCRDAvailableRequest)(nil),            // 92: gitops_core.v1.IsCRDAvailableRequest
+	(*IsCRDAvailableResponse)(nil),           // 93: gitops_core.v1.IsCRDAvailableResponse
+	(*ListPoliciesRequest)(nil),              // 94: gitops_core.v1.ListPoliciesRequest
+	(*ListPoliciesResponse)(nil),             // 95: gitops_core.v1.ListPoliciesResponse
+	(*GetPolicyRequest)(nil),                 // 96: gitops_core.v1.GetPolicyRequest
+	(*GetPolicyResponse)(nil),                // 97: gitops_core.v1.GetPolicyResponse
+	(*PolicyObj)(nil),                        // 98: gitops_core.v1.PolicyObj
+	(*PolicyStandard)(nil),                   // 99: gitops_core.v1.PolicyStandard
+	(*PolicyParam)(nil),                      // 100: gitops_core.v1.PolicyParam
+	(*PolicyTargets)(nil),                    // 101: gitops_core.v1.PolicyTargets
+	(*PolicyTargetLabel)(nil),                // 102: gitops_core.v1.PolicyTargetLabel
+	nil,                                      // 103: gitops_core.v1.ListObjectsRequest.LabelsEntry
+	nil,                                      // 104: gitops_core.v1.WatchObjectsRequest.LabelsEntry
+	nil,                                      // 105: gitops_core.v1.GetFeatureFlagsResponse.FlagsEntry
+	nil,                                      // 106: gitops_core.v1.IsCRDAvailableResponse.ClustersEntry
+	nil,                                      // 107: gitops_core.v1.PolicyTargetLabel.ValuesEntry
+	(*InventoryEntry)(nil),                   // 108: gitops_core.v1.InventoryEntry
+	(*HealthSummary)(nil),                    // 109: gitops_core.v1.HealthSummary
+	(*DependencyNode)(nil),                   // 110: gitops_core.v1.DependencyNode
+	(*DependencyEdge)(nil),                   // 111: gitops_core.v1.DependencyEdge
+	(*DependencyCycle)(nil),                  // 112: gitops_core.v1.DependencyCycle
+	(*ObjectDiff)(nil),                       // 113: gitops_core.v1.ObjectDiff
+	(*AuditEvent)(nil),                       // 114: gitops_core.v1.AuditEvent
+	(*APIToken)(nil),                         // 115: gitops_core.v1.APIToken
+	(*Session)(nil),                          // 116: gitops_core.v1.Session
+	(*OwningAutomation)(nil),                 // 117: gitops_core.v1.OwningAutomation
+	(*ObjectRef)(nil),                        // 118: gitops_core.v1.ObjectRef
+	(*SearchResult)(nil),                     // 119: gitops_core.v1.SearchResult
+	(*ObjectDrift)(nil),                      // 120: gitops_core.v1.ObjectDrift
+	(*HelmReleaseRevision)(nil),              // 121: gitops_core.v1.HelmReleaseRevision
+	(*ArtifactFile)(nil),                     // 122: gitops_core.v1.ArtifactFile
+	(*TerraformObject)(nil),                  // 123: gitops_core.v1.TerraformObject
+	(*anypb.Any)(nil),                        // 124: google.protobuf.Any
+	(*Deployment)(nil),                       // 125: gitops_core.v1.Deployment
+	(*Crd)(nil),                              // 126: gitops_core.v1.Crd
+	(*Object)(nil),                           // 127: gitops_core.v1.Object
+	(*GroupVersionKind)(nil),                 // 128: gitops_core.v1.GroupVersionKind
+	(*Namespace)(nil),                        // 129: gitops_core.v1.Namespace
+	(*Event)(nil),                            // 130: gitops_core.v1.Event
+	(*ReconciliationRecord)(nil),             // 131: gitops_core.v1.ReconciliationRecord
+	(*ObjectResult)(nil),                     // 132: gitops_core.v1.ObjectResult
 }
 var file_api_core_core_proto_depIdxs = []int32{
-	61, // 0: gitops_core.v1.GetInventoryResponse.entries:type_name -> gitops_core.v1.InventoryEntry
-	7,  // 1: gitops_core.v1.PolicyValidation.occurrences:type_name -> gitops_core.v1.PolicyValidationOccurrence
-	8,  // 2: gitops_core.v1.PolicyValidation.parameters:type_name -> gitops_core.v1.PolicyValidationParam
-	10, // 3: gitops_core.v1.ListPolicyValidationsRequest.pagination:type_name -> gitops_core.v1.Pagination
-	2,  // 4: gitops_core.v1.ListPolicyValidationsResponse.violations:type_name -> gitops_core.v1.PolicyValidation
-	11, // 5: gitops_core.v1.ListPolicyValidationsResponse.errors:type_name -> gitops_core.v1.ListError
-	2,  // 6: gitops_core.v1.GetPolicyValidationResponse.validation:type_name -> gitops_core.v1.PolicyValidation
-	62, // 7: gitops_core.v1.PolicyValidationParam.value:type_name -> google.protobuf.Any
-	63, // 8: gitops_core.v1.ListFluxRuntimeObjectsResponse.deployments:type_name -> gitops_core.v1.Deployment
-	11, // 9: gitops_core.v1.ListFluxRuntimeObjectsResponse.errors:type_name -> gitops_core.v1.ListError
-	63, // 10: gitops_core.v1.ListRuntimeObjectsResponse.deployments:type_name -> gitops_core.v1.Deployment
-	11, // 11: gitops_core.v1.ListRuntimeObjectsResponse.errors:type_name -> gitops_core.v1.ListError
-	64, // 12: gitops_core.v1.ListFluxCrdsResponse.crds:type_name -> gitops_core.v1.Crd
-	11, // 13: gitops_core.v1.ListFluxCrdsResponse.errors:type_name -> gitops_core.v1.ListError
-	64, // 14: gitops_core.v1.ListRuntimeCrdsResponse.crds:type_name -> gitops_core.v1.Crd
-	11, // 15: gitops_core.v1.ListRuntimeCrdsResponse.errors:type_name -> gitops_core.v1.ListError
-	65, // 16: gitops_core.v1.GetObjectResponse.object:type_name -> gitops_core.v1.Object
-	57, // 17: gitops_core.v1.ListObjectsRequest.labels:type_name -> gitops_core.v1.ListObjectsRequest.LabelsEntry
-	65, // 18: gitops_core.v1.ListObjectsResponse.objects:type_name -> gitops_core.v1.Object
-	11, // 19: gitops_core.v1.ListObjectsResponse.errors:type_name -> gitops_core.v1.ListError
-	23, // 20: gitops_core.v1.ListObjectsResponse.searched_namespaces:type_name -> gitops_core.v1.ClusterNamespaceList
-	66, // 21: gitops_core.v1.GetReconciledObjectsRequest.kinds:type_name -> gitops_core.v1.GroupVersionKind
-	65, // 22: gitops_core.v1.GetReconciledObjectsResponse.objects:type_name -> gitops_core.v1.Object
-	66, // 23: gitops_core.v1.GetChildObjectsRequest.group_version_kind:type_name -> gitops_core.v1.GroupVersionKind
-	65, // 24: gitops_core.v1.GetChildObjectsResponse.objects:type_name -> gitops_core.v1.Object
-	67, // 25: gitops_core.v1.ListNamespacesResponse.namespaces:type_name -> gitops_core.v1.Namespace
-	68, // 26: gitops_core.v1.ListEventsRequest.involved_object:type_name -> gitops_core.v1.ObjectRef
-	69, // 27: gitops_core.v1.ListEventsResponse.events:type_name -> gitops_core.v1.Event
-	68, // 28: gitops_core.v1.SyncFluxObjectRequest.objects:type_name -> gitops_core.v1.ObjectRef
-	58, // 29: gitops_core.v1.GetFeatureFlagsResponse.flags:type_name -> gitops_core.v1.GetFeatureFlagsResponse.FlagsEntry
-	68, // 30: gitops_core.v1.ToggleSuspendResourceRequest.objects:type_name -> gitops_core.v1.ObjectRef
-	44, // 31: gitops_core.v1.GetSessionLogsResponse.logs:type_name -> gitops_core.v1.LogEntry
-	59, // 32: gitops_core.v1.IsCRDAvailableResponse.clusters:type_name -> gitops_core.v1.IsCRDAvailableResponse.ClustersEntry
-	10, // 33: gitops_core.v1.ListPoliciesRequest.pagination:type_name -> gitops_core.v1.Pagination
-	52, // 34: gitops_core.v1.ListPoliciesResponse.policies:type_name -> gitops_core.v1.PolicyObj
-	11, // 35: gitops_core.v1.ListPoliciesResponse.errors:type_name -> gitops_core.v1.ListError
-	52, // 36: gitops_core.v1.GetPolicyResponse.policy:type_name -> gitops_core.v1.PolicyObj
-	53, // 37: gitops_core.v1.PolicyObj.standards:type_name -> gitops_core.v1.PolicyStandard
-	54, // 38: gitops_core.v1.PolicyObj.parameters:type_name -> gitops_core.v1.PolicyParam
-	55, // 39: gitops_core.v1.PolicyObj.targets:type_name -> gitops_core.v1.PolicyTargets
-	62, // 40: gitops_core.v1.PolicyParam.value:type_name -> google.protobuf.Any
-	56, // 41: gitops_core.v1.PolicyTargets.labels:type_name -> gitops_core.v1.PolicyTargetLabel
-	60, // 42: gitops_core.v1.PolicyTargetLabel.values:type_name -> gitops_core.v1.PolicyTargetLabel.ValuesEntry
-	20, // 43: gitops_core.v1.Core.GetObject:input_type -> gitops_core.v1.GetObjectRequest
-	22, // 44: gitops_core.v1.Core.ListObjects:input_type -> gitops_core.v1.ListObjectsRequest
-	12, // 45: gitops_core.v1.Core.ListFluxRuntimeObjects:input_type -> gitops_core.v1.ListFluxRuntimeObjectsRequest
-	16, // 46: gitops_core.v1.Core.ListFluxCrds:input_type -> gitops_core.v1.ListFluxCrdsRequest
-	14, // 47: gitops_core.v1.Core.ListRuntimeObjects:input_type -> gitops_core.v1.ListRuntimeObjectsRequest
-	18, // 48: gitops_core.v1.Core.ListRuntimeCrds:input_type -> gitops_core.v1.ListRuntimeCrdsRequest
-	25, // 49: gitops_core.v1.Core.GetReconciledObjects:input_type -> gitops_core.v1.GetReconciledObjectsRequest
-	27, // 50: gitops_core.v1.Core.GetChildObjects:input_type -> gitops_core.v1.GetChildObjectsRequest
-	29, // 51: gitops_core.v1.Core.GetFluxNamespace:input_type -> gitops_core.v1.GetFluxNamespaceRequest
-	31, // 52: gitops_core.v1.Core.ListNamespaces:input_type -> gitops_core.v1.ListNamespacesRequest
-	33, // 53: gitops_core.v1.Core.ListEvents:input_type -> gitops_core.v1.ListEventsRequest
-	35, // 54: gitops_core.v1.Core.SyncFluxObject:input_type -> gitops_core.v1.SyncFluxObjectRequest
-	37, // 55: gitops_core.v1.Core.GetVersion:input_type -> gitops_core.v1.GetVersionRequest
-	39, // 56: gitops_core.v1.Core.GetFeatureFlags:input_type -> gitops_core.v1.GetFeatureFlagsRequest
-	41, // 57: gitops_core.v1.Core.ToggleSuspendResource:input_type -> gitops_core.v1.ToggleSuspendResourceRequest
-	43, // 58: gitops_core.v1.Core.GetSessionLogs:input_type -> gitops_core.v1.GetSessionLogsRequest
-	46, // 59: gitops_core.v1.Core.IsCRDAvailable:input_type -> gitops_core.v1.IsCRDAvailableRequest
-	0,  // 60: gitops_core.v1.Core.GetInventory:input_type -> gitops_core.v1.GetInventoryRequest
-	48, // 61: gitops_core.v1.Core.ListPolicies:input_type -> gitops_core.v1.ListPoliciesRequest
-	50, // 62: gitops_core.v1.Core.GetPolicy:input_type -> gitops_core.v1.GetPolicyRequest
-	3,  // 63: gitops_core.v1.Core.ListPolicyValidations:input_type -> gitops_core.v1.ListPolicyValidationsRequest
-	5,  // 64: gitops_core.v1.Core.GetPolicyValidation:input_type -> gitops_core.v1.GetPolicyValidationRequest
-	21, // 65: gitops_core.v1.Core.GetObject:output_type -> gitops_core.v1.GetObjectResponse
-	24, // 66: gitops_core.v1.Core.ListObjects:output_type -> gitops_core.v1.ListObjectsResponse
-	13, // 67: gitops_core.v1.Core.ListFluxRuntimeObjects:output_type -> gitops_core.v1.ListFluxRuntimeObjectsResponse
-	17, // 68: gitops_core.v1.Core.ListFluxCrds:output_type -> gitops_core.v1.ListFluxCrdsResponse
-	15, // 69: gitops_core.v1.Core.ListRuntimeObjects:output_type -> gitops_core.v1.ListRuntimeObjectsResponse
-	19, // 70: gitops_core.v1.Core.ListRuntimeCrds:output_type -> gitops_core.v1.ListRuntimeCrdsResponse
-	26, // 71: gitops_core.v1.Core.GetReconciledObjects:output_type -> gitops_core.v1.GetReconciledObjectsResponse
-	28, // 72: gitops_core.v1.Core.GetChildObjects:output_type -> gitops_core.v1.GetChildObjectsResponse
-	30, // 73: gitops_core.v1.Core.GetFluxNamespace:output_type -> gitops_core.v1.GetFluxNamespaceResponse
-	32, // 74: gitops_core.v1.Core.ListNamespaces:output_type -> gitops_core.v1.ListNamespacesResponse
-	34, // 75: gitops_core.v1.Core.ListEvents:output_type -> gitops_core.v1.ListEventsResponse
-	36, // 76: gitops_core.v1.Core.SyncFluxObject:output_type -> gitops_core.v1.SyncFluxObjectResponse
-	38, // 77: gitops_core.v1.Core.GetVersion:output_type -> gitops_core.v1.GetVersionResponse
-	40, // 78: gitops_core.v1.Core.GetFeatureFlags:output_type -> gitops_core.v1.GetFeatureFlagsResponse
-	42, // 79: gitops_core.v1.Core.ToggleSuspendResource:output_type -> gitops_core.v1.ToggleSuspendResourceResponse
-	45, // 80: gitops_core.v1.Core.GetSessionLogs:output_type -> gitops_core.v1.GetSessionLogsResponse
-	47, // 81: gitops_core.v1.Core.IsCRDAvailable:output_type -> gitops_core.v1.IsCRDAvailableResponse
-	1,  // 82: gitops_core.v1.Core.GetInventory:output_type -> gitops_core.v1.GetInventoryResponse
-	49, // 83: gitops_core.v1.Core.ListPolicies:output_type -> gitops_core.v1.ListPoliciesResponse
-	51, // 84: gitops_core.v1.Core.GetPolicy:output_type -> gitops_core.v1.GetPolicyResponse
-	4,  // 85: gitops_core.v1.Core.ListPolicyValidations:output_type -> gitops_core.v1.ListPolicyValidationsResponse
-	6,  // 86: gitops_core.v1.Core.GetPolicyValidation:output_type -> gitops_core.v1.GetPolicyValidationResponse
-	65, // [65:87] is the sub-list for method output_type
-	43, // [43:65] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	108, // 0: gitops_core.v1.GetInventoryResponse.entries:type_name -> gitops_core.v1.InventoryEntry
+	109, // 1: gitops_core.v1.GetInventoryResponse.health_summary:type_name -> gitops_core.v1.HealthSummary
+	109, // 2: gitops_core.v1.GetApplicationHealthResponse.summary:type_name -> gitops_core.v1.HealthSummary
+	110, // 3: gitops_core.v1.GetDependencyGraphResponse.nodes:type_name -> gitops_core.v1.DependencyNode
+	111, // 4: gitops_core.v1.GetDependencyGraphResponse.edges:type_name -> gitops_core.v1.DependencyEdge
+	112, // 5: gitops_core.v1.GetDependencyGraphResponse.cycles:type_name -> gitops_core.v1.DependencyCycle
+	51,  // 6: gitops_core.v1.GetDependencyGraphResponse.errors:type_name -> gitops_core.v1.ListError
+	113, // 7: gitops_core.v1.DiffKustomizationResponse.diffs:type_name -> gitops_core.v1.ObjectDiff
+	114, // 8: gitops_core.v1.ListAuditEventsResponse.events:type_name -> gitops_core.v1.AuditEvent
+	115, // 9: gitops_core.v1.CreateAPITokenResponse.details:type_name -> gitops_core.v1.APIToken
+	115, // 10: gitops_core.v1.ListAPITokensResponse.tokens:type_name -> gitops_core.v1.APIToken
+	116, // 11: gitops_core.v1.ListSessionsResponse.sessions:type_name -> gitops_core.v1.Session
+	117, // 12: gitops_core.v1.GetOwningAutomationResponse.owners:type_name -> gitops_core.v1.OwningAutomation
+	118, // 13: gitops_core.v1.SearchRequest.source_ref:type_name -> gitops_core.v1.ObjectRef
+	118, // 14: gitops_core.v1.SearchRequest.inventory_member:type_name -> gitops_core.v1.ObjectRef
+	119, // 15: gitops_core.v1.SearchResponse.results:type_name -> gitops_core.v1.SearchResult
+	120, // 16: gitops_core.v1.GetDriftResponse.objects:type_name -> gitops_core.v1.ObjectDrift
+	121, // 17: gitops_core.v1.ListHelmReleaseRevisionsResponse.revisions:type_name -> gitops_core.v1.HelmReleaseRevision
+	122, // 18: gitops_core.v1.ListArtifactFilesResponse.files:type_name -> gitops_core.v1.ArtifactFile
+	123, // 19: gitops_core.v1.ListTerraformObjectsResponse.objects:type_name -> gitops_core.v1.TerraformObject
+	51,  // 20: gitops_core.v1.ListTerraformObjectsResponse.errors:type_name -> gitops_core.v1.ListError
+	47,  // 21: gitops_core.v1.PolicyValidation.occurrences:type_name -> gitops_core.v1.PolicyValidationOccurrence
+	48,  // 22: gitops_core.v1.PolicyValidation.parameters:type_name -> gitops_core.v1.PolicyValidationParam
+	50,  // 23: gitops_core.v1.ListPolicyValidationsRequest.pagination:type_name -> gitops_core.v1.Pagination
+	42,  // 24: gitops_core.v1.ListPolicyValidationsResponse.violations:type_name -> gitops_core.v1.PolicyValidation
+	51,  // 25: gitops_core.v1.ListPolicyValidationsResponse.errors:type_name -> gitops_core.v1.ListError
+	42,  // 26: gitops_core.v1.GetPolicyValidationResponse.validation:type_name -> gitops_core.v1.PolicyValidation
+	124, // 27: gitops_core.v1.PolicyValidationParam.value:type_name -> google.protobuf.Any
+	125, // 28: gitops_core.v1.ListFluxRuntimeObjectsResponse.deployments:type_name -> gitops_core.v1.Deployment
+	51,  // 29: gitops_core.v1.ListFluxRuntimeObjectsResponse.errors:type_name -> gitops_core.v1.ListError
+	125, // 30: gitops_core.v1.ListRuntimeObjectsResponse.deployments:type_name -> gitops_core.v1.Deployment
+	51,  // 31: gitops_core.v1.ListRuntimeObjectsResponse.errors:type_name -> gitops_core.v1.ListError
+	126, // 32: gitops_core.v1.ListFluxCrdsResponse.crds:type_name -> gitops_core.v1.Crd
+	51,  // 33: gitops_core.v1.ListFluxCrdsResponse.errors:type_name -> gitops_core.v1.ListError
+	126, // 34: gitops_core.v1.ListRuntimeCrdsResponse.crds:type_name -> gitops_core.v1.Crd
+	51,  // 35: gitops_core.v1.ListRuntimeCrdsResponse.errors:type_name -> gitops_core.v1.ListError
+	127, // 36: gitops_core.v1.GetObjectResponse.object:type_name -> gitops_core.v1.Object
+	103, // 37: gitops_core.v1.ListObjectsRequest.labels:type_name -> gitops_core.v1.ListObjectsRequest.LabelsEntry
+	118, // 38: gitops_core.v1.ListObjectsRequest.source_ref:type_name -> gitops_core.v1.ObjectRef
+	104, // 39: gitops_core.v1.WatchObjectsRequest.labels:type_name -> gitops_core.v1.WatchObjectsRequest.LabelsEntry
+	127, // 40: gitops_core.v1.WatchObjectsResponse.object:type_name -> gitops_core.v1.Object
+	51,  // 41: gitops_core.v1.WatchObjectsResponse.error:type_name -> gitops_core.v1.ListError
+	127, // 42: gitops_core.v1.ListObjectsResponse.objects:type_name -> gitops_core.v1.Object
+	51,  // 43: gitops_core.v1.ListObjectsResponse.errors:type_name -> gitops_core.v1.ListError
+	65,  // 44: gitops_core.v1.ListObjectsResponse.searched_namespaces:type_name -> gitops_core.v1.ClusterNamespaceList
+	128, // 45: gitops_core.v1.GetReconciledObjectsRequest.kinds:type_name -> gitops_core.v1.GroupVersionKind
+	127, // 46: gitops_core.v1.GetReconciledObjectsResponse.objects:type_name -> gitops_core.v1.Object
+	128, // 47: gitops_core.v1.GetChildObjectsRequest.group_version_kind:type_name -> gitops_core.v1.GroupVersionKind
+	127, // 48: gitops_core.v1.GetChildObjectsResponse.objects:type_name -> gitops_core.v1.Object
+	129, // 49: gitops_core.v1.ListNamespacesResponse.namespaces:type_name -> gitops_core.v1.Namespace
+	118, // 50: gitops_core.v1.ListEventsRequest.involved_object:type_name -> gitops_core.v1.ObjectRef
+	130, // 51: gitops_core.v1.ListEventsResponse.events:type_name -> gitops_core.v1.Event
+	131, // 52: gitops_core.v1.GetReconciliationHistoryResponse.records:type_name -> gitops_core.v1.ReconciliationRecord
+	118, // 53: gitops_core.v1.SyncFluxObjectRequest.objects:type_name -> gitops_core.v1.ObjectRef
+	132, // 54: gitops_core.v1.SyncFluxObjectResponse.results:type_name -> gitops_core.v1.ObjectResult
+	105, // 55: gitops_core.v1.GetFeatureFlagsResponse.flags:type_name -> gitops_core.v1.GetFeatureFlagsResponse.FlagsEntry
+	118, // 56: gitops_core.v1.ToggleSuspendResourceRequest.objects:type_name -> gitops_core.v1.ObjectRef
+	132, // 57: gitops_core.v1.ToggleSuspendResourceResponse.results:type_name -> gitops_core.v1.ObjectResult
+	88,  // 58: gitops_core.v1.GetSessionLogsResponse.logs:type_name -> gitops_core.v1.LogEntry
+	88,  // 59: gitops_core.v1.GetControllerLogsResponse.entry:type_name -> gitops_core.v1.LogEntry
+	51,  // 60: gitops_core.v1.GetControllerLogsResponse.error:type_name -> gitops_core.v1.ListError
+	106, // 61: gitops_core.v1.IsCRDAvailableResponse.clusters:type_name -> gitops_core.v1.IsCRDAvailableResponse.ClustersEntry
+	50,  // 62: gitops_core.v1.ListPoliciesRequest.pagination:type_name -> gitops_core.v1.Pagination
+	98,  // 63: gitops_core.v1.ListPoliciesResponse.policies:type_name -> gitops_core.v1.PolicyObj
+	51,  // 64: gitops_core.v1.ListPoliciesResponse.errors:type_name -> gitops_core.v1.ListError
+	98,  // 65: gitops_core.v1.GetPolicyResponse.policy:type_name -> gitops_core.v1.PolicyObj
+	99,  // 66: gitops_core.v1.PolicyObj.standards:type_name -> gitops_core.v1.PolicyStandard
+	100, // 67: gitops_core.v1.PolicyObj.parameters:type_name -> gitops_core.v1.PolicyParam
+	101, // 68: gitops_core.v1.PolicyObj.targets:type_name -> gitops_core.v1.PolicyTargets
+	124, // 69: gitops_core.v1.PolicyParam.value:type_name -> google.protobuf.Any
+	102, // 70: gitops_core.v1.PolicyTargets.labels:type_name -> gitops_core.v1.PolicyTargetLabel
+	107, // 71: gitops_core.v1.PolicyTargetLabel.values:type_name -> gitops_core.v1.PolicyTargetLabel.ValuesEntry
+	60,  // 72: gitops_core.v1.Core.GetObject:input_type -> gitops_core.v1.GetObjectRequest
+	62,  // 73: gitops_core.v1.Core.ListObjects:input_type -> gitops_core.v1.ListObjectsRequest
+	63,  // 74: gitops_core.v1.Core.WatchObjects:input_type -> gitops_core.v1.WatchObjectsRequest
+	52,  // 75: gitops_core.v1.Core.ListFluxRuntimeObjects:input_type -> gitops_core.v1.ListFluxRuntimeObjectsRequest
+	56,  // 76: gitops_core.v1.Core.ListFluxCrds:input_type -> gitops_core.v1.ListFluxCrdsRequest
+	54,  // 77: gitops_core.v1.Core.ListRuntimeObjects:input_type -> gitops_core.v1.ListRuntimeObjectsRequest
+	58,  // 78: gitops_core.v1.Core.ListRuntimeCrds:input_type -> gitops_core.v1.ListRuntimeCrdsRequest
+	67,  // 79: gitops_core.v1.Core.GetReconciledObjects:input_type -> gitops_core.v1.GetReconciledObjectsRequest
+	69,  // 80: gitops_core.v1.Core.GetChildObjects:input_type -> gitops_core.v1.GetChildObjectsRequest
+	71,  // 81: gitops_core.v1.Core.GetFluxNamespace:input_type -> gitops_core.v1.GetFluxNamespaceRequest
+	73,  // 82: gitops_core.v1.Core.ListNamespaces:input_type -> gitops_core.v1.ListNamespacesRequest
+	75,  // 83: gitops_core.v1.Core.ListEvents:input_type -> gitops_core.v1.ListEventsRequest
+	77,  // 84: gitops_core.v1.Core.GetReconciliationHistory:input_type -> gitops_core.v1.GetReconciliationHistoryRequest
+	79,  // 85: gitops_core.v1.Core.SyncFluxObject:input_type -> gitops_core.v1.SyncFluxObjectRequest
+	81,  // 86: gitops_core.v1.Core.GetVersion:input_type -> gitops_core.v1.GetVersionRequest
+	83,  // 87: gitops_core.v1.Core.GetFeatureFlags:input_type -> gitops_core.v1.GetFeatureFlagsRequest
+	85,  // 88: gitops_core.v1.Core.ToggleSuspendResource:input_type -> gitops_core.v1.ToggleSuspendResourceRequest
+	87,  // 89: gitops_core.v1.Core.GetSessionLogs:input_type -> gitops_core.v1.GetSessionLogsRequest
+	90,  // 90: gitops_core.v1.Core.GetControllerLogs:input_type -> gitops_core.v1.GetControllerLogsRequest
+	92,  // 91: gitops_core.v1.Core.IsCRDAvailable:input_type -> gitops_core.v1.IsCRDAvailableRequest
+	0,   // 92: gitops_core.v1.Core.GetInventory:input_type -> gitops_core.v1.GetInventoryRequest
+	2,   // 93: gitops_core.v1.Core.GetApplicationHealth:input_type -> gitops_core.v1.GetApplicationHealthRequest
+	4,   // 94: gitops_core.v1.Core.GetDependencyGraph:input_type -> gitops_core.v1.GetDependencyGraphRequest
+	6,   // 95: gitops_core.v1.Core.DiffKustomization:input_type -> gitops_core.v1.DiffKustomizationRequest
+	8,   // 96: gitops_core.v1.Core.ListAuditEvents:input_type -> gitops_core.v1.ListAuditEventsRequest
+	10,  // 97: gitops_core.v1.Core.CreateAPIToken:input_type -> gitops_core.v1.CreateAPITokenRequest
+	12,  // 98: gitops_core.v1.Core.ListAPITokens:input_type -> gitops_core.v1.ListAPITokensRequest
+	14,  // 99: gitops_core.v1.Core.RevokeAPIToken:input_type -> gitops_core.v1.RevokeAPITokenRequest
+	16,  // 100: gitops_core.v1.Core.ListSessions:input_type -> gitops_core.v1.ListSessionsRequest
+	18,  // 101: gitops_core.v1.Core.RevokeSessions:input_type -> gitops_core.v1.RevokeSessionsRequest
+	20,  // 102: gitops_core.v1.Core.GetOwningAutomation:input_type -> gitops_core.v1.GetOwningAutomationRequest
+	22,  // 103: gitops_core.v1.Core.Search:input_type -> gitops_core.v1.SearchRequest
+	24,  // 104: gitops_core.v1.Core.GetDrift:input_type -> gitops_core.v1.GetDriftRequest
+	26,  // 105: gitops_core.v1.Core.ListHelmReleaseRevisions:input_type -> gitops_core.v1.ListHelmReleaseRevisionsRequest
+	28,  // 106: gitops_core.v1.Core.RollbackHelmRelease:input_type -> gitops_core.v1.RollbackHelmReleaseRequest
+	30,  // 107: gitops_core.v1.Core.ListArtifactFiles:input_type -> gitops_core.v1.ListArtifactFilesRequest
+	32,  // 108: gitops_core.v1.Core.GetArtifactFile:input_type -> gitops_core.v1.GetArtifactFileRequest
+	34,  // 109: gitops_core.v1.Core.ListTerraformObjects:input_type -> gitops_core.v1.ListTerraformObjectsRequest
+	36,  // 110: gitops_core.v1.Core.GetTerraformPlan:input_type -> gitops_core.v1.GetTerraformPlanRequest
+	38,  // 111: gitops_core.v1.Core.ApproveTerraformPlan:input_type -> gitops_core.v1.ApproveTerraformPlanRequest
+	40,  // 112: gitops_core.v1.Core.ReplanTerraformObject:input_type -> gitops_core.v1.ReplanTerraformObjectRequest
+	94,  // 113: gitops_core.v1.Core.ListPolicies:input_type -> gitops_core.v1.ListPoliciesRequest
+	96,  // 114: gitops_core.v1.Core.GetPolicy:input_type -> gitops_core.v1.GetPolicyRequest
+	43,  // 115: gitops_core.v1.Core.ListPolicyValidations:input_type -> gitops_core.v1.ListPolicyValidationsRequest
+	45,  // 116: gitops_core.v1.Core.GetPolicyValidation:input_type -> gitops_core.v1.GetPolicyValidationRequest
+	61,  // 117: gitops_core.v1.Core.GetObject:output_type -> gitops_core.v1.GetObjectResponse
+	66,  // 118: gitops_core.v1.Core.ListObjects:output_type -> gitops_core.v1.ListObjectsResponse
+	64,  // 119: gitops_core.v1.Core.WatchObjects:output_type -> gitops_core.v1.WatchObjectsResponse
+	53,  // 120: gitops_core.v1.Core.ListFluxRuntimeObjects:output_type -> gitops_core.v1.ListFluxRuntimeObjectsResponse
+	57,  // 121: gitops_core.v1.Core.ListFluxCrds:output_type -> gitops_core.v1.ListFluxCrdsResponse
+	55,  // 122: gitops_core.v1.Core.ListRuntimeObjects:output_type -> gitops_core.v1.ListRuntimeObjectsResponse
+	59,  // 123: gitops_core.v1.Core.ListRuntimeCrds:output_type -> gitops_core.v1.ListRuntimeCrdsResponse
+	68,  // 124: gitops_core.v1.Core.GetReconciledObjects:output_type -> gitops_core.v1.GetReconciledObjectsResponse
+	70,  // 125: gitops_core.v1.Core.GetChildObjects:output_type -> gitops_core.v1.GetChildObjectsResponse
+	72,  // 126: gitops_core.v1.Core.GetFluxNamespace:output_type -> gitops_core.v1.GetFluxNamespaceResponse
+	74,  // 127: gitops_core.v1.Core.ListNamespaces:output_type -> gitops_core.v1.ListNamespacesResponse
+	76,  // 128: gitops_core.v1.Core.ListEvents:output_type -> gitops_core.v1.ListEventsResponse
+	78,  // 129: gitops_core.v1.Core.GetReconciliationHistory:output_type -> gitops_core.v1.GetReconciliationHistoryResponse
+	80,  // 130: gitops_core.v1.Core.SyncFluxObject:output_type -> gitops_core.v1.SyncFluxObjectResponse
+	82,  // 131: gitops_core.v1.Core.GetVersion:output_type -> gitops_core.v1.GetVersionResponse
+	84,  // 132: gitops_core.v1.Core.GetFeatureFlags:output_type -> gitops_core.v1.GetFeatureFlagsResponse
+	86,  // 133: gitops_core.v1.Core.ToggleSuspendResource:output_type -> gitops_core.v1.ToggleSuspendResourceResponse
+	89,  // 134: gitops_core.v1.Core.GetSessionLogs:output_type -> gitops_core.v1.GetSessionLogsResponse
+	91,  // 135: gitops_core.v1.Core.GetControllerLogs:output_type -> gitops_core.v1.GetControllerLogsResponse
+	93,  // 136: gitops_core.v1.Core.IsCRDAvailable:output_type -> gitops_core.v1.IsCRDAvailableResponse
+	1,   // 137: gitops_core.v1.Core.GetInventory:output_type -> gitops_core.v1.GetInventoryResponse
+	3,   // 138: gitops_core.v1.Core.GetApplicationHealth:output_type -> gitops_core.v1.GetApplicationHealthResponse
+	5,   // 139: gitops_core.v1.Core.GetDependencyGraph:output_type -> gitops_core.v1.GetDependencyGraphResponse
+	7,   // 140: gitops_core.v1.Core.DiffKustomization:output_type -> gitops_core.v1.DiffKustomizationResponse
+	9,   // 141: gitops_core.v1.Core.ListAuditEvents:output_type -> gitops_core.v1.ListAuditEventsResponse
+	11,  // 142: gitops_core.v1.Core.CreateAPIToken:output_type -> gitops_core.v1.CreateAPITokenResponse
+	13,  // 143: gitops_core.v1.Core.ListAPITokens:output_type -> gitops_core.v1.ListAPITokensResponse
+	15,  // 144: gitops_core.v1.Core.RevokeAPIToken:output_type -> gitops_core.v1.RevokeAPITokenResponse
+	17,  // 145: gitops_core.v1.Core.ListSessions:output_type -> gitops_core.v1.ListSessionsResponse
+	19,  // 146: gitops_core.v1.Core.RevokeSessions:output_type -> gitops_core.v1.RevokeSessionsResponse
+	21,  // 147: gitops_core.v1.Core.GetOwningAutomation:output_type -> gitops_core.v1.GetOwningAutomationResponse
+	23,  // 148: gitops_core.v1.Core.Search:output_type -> gitops_core.v1.SearchResponse
+	25,  // 149: gitops_core.v1.Core.GetDrift:output_type -> gitops_core.v1.GetDriftResponse
+	27,  // 150: gitops_core.v1.Core.ListHelmReleaseRevisions:output_type -> gitops_core.v1.ListHelmReleaseRevisionsResponse
+	29,  // 151: gitops_core.v1.Core.RollbackHelmRelease:output_type -> gitops_core.v1.RollbackHelmReleaseResponse
+	31,  // 152: gitops_core.v1.Core.ListArtifactFiles:output_type -> gitops_core.v1.ListArtifactFilesResponse
+	33,  // 153: gitops_core.v1.Core.GetArtifactFile:output_type -> gitops_core.v1.GetArtifactFileResponse
+	35,  // 154: gitops_core.v1.Core.ListTerraformObjects:output_type -> gitops_core.v1.ListTerraformObjectsResponse
+	37,  // 155: gitops_core.v1.Core.GetTerraformPlan:output_type -> gitops_core.v1.GetTerraformPlanResponse
+	39,  // 156: gitops_core.v1.Core.ApproveTerraformPlan:output_type -> gitops_core.v1.ApproveTerraformPlanResponse
+	41,  // 157: gitops_core.v1.Core.ReplanTerraformObject:output_type -> gitops_core.v1.ReplanTerraformObjectResponse
+	95,  // 158: gitops_core.v1.Core.ListPolicies:output_type -> gitops_core.v1.ListPoliciesResponse
+	97,  // 159: gitops_core.v1.Core.GetPolicy:output_type -> gitops_core.v1.GetPolicyResponse
+	44,  // 160: gitops_core.v1.Core.ListPolicyValidations:output_type -> gitops_core.v1.ListPolicyValidationsResponse
+	46,  // 161: gitops_core.v1.Core.GetPolicyValidation:output_type -> gitops_core.v1.GetPolicyValidationResponse
+	117, // [117:162] is the sub-list for method output_type
+	72,  // [72:117] is the sub-list for method input_type
+	72,  // [72:72] is the sub-list for extension type_name
+	72,  // [72:72] is the sub-list for extension extendee
+	0,   // [0:72] is the sub-list for field type_name
 }
 
 func init() { file_api_core_core_proto_init() }
@@ -3819,7 +7143,7 @@ func file_api_core_core_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_core_core_proto_rawDesc), len(file_api_core_core_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   61,
+			NumMessages:   108,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,28 +23,51 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Core_GetObject_FullMethodName              = "/gitops_core.v1.Core/GetObject"
-	Core_ListObjects_FullMethodName            = "/gitops_core.v1.Core/ListObjects"
-	Core_ListFluxRuntimeObjects_FullMethodName = "/gitops_core.v1.Core/ListFluxRuntimeObjects"
-	Core_ListFluxCrds_FullMethodName           = "/gitops_core.v1.Core/ListFluxCrds"
-	Core_ListRuntimeObjects_FullMethodName     = "/gitops_core.v1.Core/ListRuntimeObjects"
-	Core_ListRuntimeCrds_FullMethodName        = "/gitops_core.v1.Core/ListRuntimeCrds"
-	Core_GetReconciledObjects_FullMethodName   = "/gitops_core.v1.Core/GetReconciledObjects"
-	Core_GetChildObjects_FullMethodName        = "/gitops_core.v1.Core/GetChildObjects"
-	Core_GetFluxNamespace_FullMethodName       = "/gitops_core.v1.Core/GetFluxNamespace"
-	Core_ListNamespaces_FullMethodName         = "/gitops_core.v1.Core/ListNamespaces"
-	Core_ListEvents_FullMethodName             = "/gitops_core.v1.Core/ListEvents"
-	Core_SyncFluxObject_FullMethodName         = "/gitops_core.v1.Core/SyncFluxObject"
-	Core_GetVersion_FullMethodName             = "/gitops_core.v1.Core/GetVersion"
-	Core_GetFeatureFlags_FullMethodName        = "/gitops_core.v1.Core/GetFeatureFlags"
-	Core_ToggleSuspendResource_FullMethodName  = "/gitops_core.v1.Core/ToggleSuspendResource"
-	Core_GetSessionLogs_FullMethodName         = "/gitops_core.v1.Core/GetSessionLogs"
-	Core_IsCRDAvailable_FullMethodName         = "/gitops_core.v1.Core/IsCRDAvailable"
-	Core_GetInventory_FullMethodName           = "/gitops_core.v1.Core/GetInventory"
-	Core_ListPolicies_FullMethodName           = "/gitops_core.v1.Core/ListPolicies"
-	Core_GetPolicy_FullMethodName              = "/gitops_core.v1.Core/GetPolicy"
-	Core_ListPolicyValidations_FullMethodName  = "/gitops_core.v1.Core/ListPolicyValidations"
-	Core_GetPolicyValidation_FullMethodName    = "/gitops_core.v1.Core/GetPolicyValidation"
+	Core_GetObject_FullMethodName                = "/gitops_core.v1.Core/GetObject"
+	Core_ListObjects_FullMethodName              = "/gitops_core.v1.Core/ListObjects"
+	Core_WatchObjects_FullMethodName             = "/gitops_core.v1.Core/WatchObjects"
+	Core_ListFluxRuntimeObjects_FullMethodName   = "/gitops_core.v1.Core/ListFluxRuntimeObjects"
+	Core_ListFluxCrds_FullMethodName             = "/gitops_core.v1.Core/ListFluxCrds"
+	Core_ListRuntimeObjects_FullMethodName       = "/gitops_core.v1.Core/ListRuntimeObjects"
+	Core_ListRuntimeCrds_FullMethodName          = "/gitops_core.v1.Core/ListRuntimeCrds"
+	Core_GetReconciledObjects_FullMethodName     = "/gitops_core.v1.Core/GetReconciledObjects"
+	Core_GetChildObjects_FullMethodName          = "/gitops_core.v1.Core/GetChildObjects"
+	Core_GetFluxNamespace_FullMethodName         = "/gitops_core.v1.Core/GetFluxNamespace"
+	Core_ListNamespaces_FullMethodName           = "/gitops_core.v1.Core/ListNamespaces"
+	Core_ListEvents_FullMethodName               = "/gitops_core.v1.Core/ListEvents"
+	Core_GetReconciliationHistory_FullMethodName = "/gitops_core.v1.Core/GetReconciliationHistory"
+	Core_SyncFluxObject_FullMethodName           = "/gitops_core.v1.Core/SyncFluxObject"
+	Core_GetVersion_FullMethodName               = "/gitops_core.v1.Core/GetVersion"
+	Core_GetFeatureFlags_FullMethodName          = "/gitops_core.v1.Core/GetFeatureFlags"
+	Core_ToggleSuspendResource_FullMethodName    = "/gitops_core.v1.Core/ToggleSuspendResource"
+	Core_GetSessionLogs_FullMethodName           = "/gitops_core.v1.Core/GetSessionLogs"
+	Core_GetControllerLogs_FullMethodName        = "/gitops_core.v1.Core/GetControllerLogs"
+	Core_IsCRDAvailable_FullMethodName           = "/gitops_core.v1.Core/IsCRDAvailable"
+	Core_GetInventory_FullMethodName             = "/gitops_core.v1.Core/GetInventory"
+	Core_GetApplicationHealth_FullMethodName     = "/gitops_core.v1.Core/GetApplicationHealth"
+	Core_GetDependencyGraph_FullMethodName       = "/gitops_core.v1.Core/GetDependencyGraph"
+	Core_DiffKustomization_FullMethodName        = "/gitops_core.v1.Core/DiffKustomization"
+	Core_ListAuditEvents_FullMethodName          = "/gitops_core.v1.Core/ListAuditEvents"
+	Core_CreateAPIToken_FullMethodName           = "/gitops_core.v1.Core/CreateAPIToken"
+	Core_ListAPITokens_FullMethodName            = "/gitops_core.v1.Core/ListAPITokens"
+	Core_RevokeAPIToken_FullMethodName           = "/gitops_core.v1.Core/RevokeAPIToken"
+	Core_ListSessions_FullMethodName             = "/gitops_core.v1.Core/ListSessions"
+	Core_RevokeSessions_FullMethodName           = "/gitops_core.v1.Core/RevokeSessions"
+	Core_GetOwningAutomation_FullMethodName      = "/gitops_core.v1.Core/GetOwningAutomation"
+	Core_Search_FullMethodName                   = "/gitops_core.v1.Core/Search"
+	Core_GetDrift_FullMethodName                 = "/gitops_core.v1.Core/GetDrift"
+	Core_ListHelmReleaseRevisions_FullMethodName = "/gitops_core.v1.Core/ListHelmReleaseRevisions"
+	Core_RollbackHelmRelease_FullMethodName      = "/gitops_core.v1.Core/RollbackHelmRelease"
+	Core_ListArtifactFiles_FullMethodName        = "/gitops_core.v1.Core/ListArtifactFiles"
+	Core_GetArtifactFile_FullMethodName          = "/gitops_core.v1.Core/GetArtifactFile"
+	Core_ListTerraformObjects_FullMethodName     = "/gitops_core.v1.Core/ListTerraformObjects"
+	Core_GetTerraformPlan_FullMethodName         = "/gitops_core.v1.Core/GetTerraformPlan"
+	Core_ApproveTerraformPlan_FullMethodName     = "/gitops_core.v1.Core/ApproveTerraformPlan"
+	Core_ReplanTerraformObject_FullMethodName    = "/gitops_core.v1.Core/ReplanTerraformObject"
+	Core_ListPolicies_FullMethodName             = "/gitops_core.v1.Core/ListPolicies"
+	Core_GetPolicy_FullMethodName                = "/gitops_core.v1.Core/GetPolicy"
+	Core_ListPolicyValidations_FullMethodName    = "/gitops_core.v1.Core/ListPolicyValidations"
+	Core_GetPolicyValidation_FullMethodName      = "/gitops_core.v1.Core/GetPolicyValidation"
 )
 
 // CoreClient is the client API for Core service.
@@ -58,6 +81,10 @@ type CoreClient interface {
 	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error)
 	// ListObjects gets data about primary objects.
 	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
+	// WatchObjects streams added, modified and deleted events for primary
+	// objects matching the given kind, namespace and cluster.
+	// The stream starts with an added event for every existing object.
+	WatchObjects(ctx context.Context, in *WatchObjectsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchObjectsResponse], error)
 	// ListFluxRuntimeObjects lists the flux runtime deployments from a cluster.
 	ListFluxRuntimeObjects(ctx context.Context, in *ListFluxRuntimeObjectsRequest, opts ...grpc.CallOption) (*ListFluxRuntimeObjectsResponse, error)
 	ListFluxCrds(ctx context.Context, in *ListFluxCrdsRequest, opts ...grpc.CallOption) (*ListFluxCrdsResponse, error)
@@ -85,6 +112,9 @@ type CoreClient interface {
 	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
 	// ListEvents returns with a list of events
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// GetReconciliationHistory returns the recorded reconciliation attempts
+	// of a Kustomization or HelmRelease, newest first.
+	GetReconciliationHistory(ctx context.Context, in *GetReconciliationHistoryRequest, opts ...grpc.CallOption) (*GetReconciliationHistoryResponse, error)
 	// SyncResource forces a reconciliation of a Flux resource
 	SyncFluxObject(ctx context.Context, in *SyncFluxObjectRequest, opts ...grpc.CallOption) (*SyncFluxObjectResponse, error)
 	// GetVersion returns version information about the server
@@ -92,14 +122,99 @@ type CoreClient interface {
 	// GetFeatureFlags returns configuration information about the server
 	GetFeatureFlags(ctx context.Context, in *GetFeatureFlagsRequest, opts ...grpc.CallOption) (*GetFeatureFlagsResponse, error)
 	// ToggleSuspendResource suspends or resumes a flux object.
+	// Suspensions can end at a given time, or recur in freeze windows;
+	// resuming an object removes any schedule.
 	ToggleSuspendResource(ctx context.Context, in *ToggleSuspendResourceRequest, opts ...grpc.CallOption) (*ToggleSuspendResourceResponse, error)
 	// GetSessionLogs returns the logs for a given session
 	GetSessionLogs(ctx context.Context, in *GetSessionLogsRequest, opts ...grpc.CallOption) (*GetSessionLogsResponse, error)
+	// GetControllerLogs streams the entries of the Flux controllers' logs
+	// about an object, e.g. why a Kustomization failed to reconcile.
+	// Reading the object is enough to read its log entries. It needs the
+	// server to be started with --controller-logs.
+	GetControllerLogs(ctx context.Context, in *GetControllerLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetControllerLogsResponse], error)
 	// IsCRDAvailable returns with a hashmap where the keys are the names of
 	// the clusters, and the value is a boolean indicating whether given CRD is
 	// installed or not on that cluster.
 	IsCRDAvailable(ctx context.Context, in *IsCRDAvailableRequest, opts ...grpc.CallOption) (*IsCRDAvailableResponse, error)
 	GetInventory(ctx context.Context, in *GetInventoryRequest, opts ...grpc.CallOption) (*GetInventoryResponse, error)
+	// GetApplicationHealth returns the rolled up health of a Kustomization,
+	// HelmRelease or other Flux object and its inventory.
+	GetApplicationHealth(ctx context.Context, in *GetApplicationHealthRequest, opts ...grpc.CallOption) (*GetApplicationHealthResponse, error)
+	// GetDependencyGraph returns the sources, Kustomizations and HelmReleases
+	// of a cluster with the sourceRef and dependsOn edges between them,
+	// flagging cycles and references to objects that don't exist.
+	GetDependencyGraph(ctx context.Context, in *GetDependencyGraphRequest, opts ...grpc.CallOption) (*GetDependencyGraphResponse, error)
+	// DiffKustomization builds the latest artifact of the source of a
+	// Kustomization and server-side dry-run applies it, returning the objects
+	// that would be created, updated or pruned. Only the Kustomizations of
+	// the management cluster can be diffed, as artifacts are fetched from
+	// its source-controller.
+	DiffKustomization(ctx context.Context, in *DiffKustomizationRequest, opts ...grpc.CallOption) (*DiffKustomizationResponse, error)
+	// ListAuditEvents returns the most recent actions users took through
+	// the dashboard, newest first, in the namespaces the user can access.
+	// The actions on cluster-scoped objects are only listed to the users
+	// policies allow to list the audit events of all namespaces. Only the
+	// events since the server started, up to --audit-max-events, are kept
+	// in memory to be listed: the stdout, file and Event sinks are the
+	// durable record.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// CreateAPIToken mints a long-lived token authenticating API requests as
+	// the user, for scripts and CI jobs. The token is only returned once.
+	// It acts as the user only, without their groups, which could change
+	// during the token's lifetime. It's only allowed what's bound to the
+	// user itself, so it can be refused what the user is allowed through a
+	// group. The read scope allows calling the RPCs that only read, whatever
+	// their HTTP method.
+	CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error)
+	// ListAPITokens lists the user's API tokens, without the tokens.
+	ListAPITokens(ctx context.Context, in *ListAPITokensRequest, opts ...grpc.CallOption) (*ListAPITokensResponse, error)
+	// RevokeAPIToken deletes one of the user's API tokens.
+	RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*RevokeAPITokenResponse, error)
+	// ListSessions lists the dashboard sessions of a user, or of everyone.
+	// It's only available to administrators allowed by a policy.
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// RevokeSessions signs out of a session, or all the sessions of a user.
+	// It's only available to administrators allowed by a policy.
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error)
+	// GetOwningAutomation finds the Flux automation that applied an object,
+	// then the one that applied that automation, and so on up to the source.
+	GetOwningAutomation(ctx context.Context, in *GetOwningAutomationRequest, opts ...grpc.CallOption) (*GetOwningAutomationResponse, error)
+	// Search finds objects on all clusters in the search index, best
+	// matches first, leaving out those in namespaces the user can't access.
+	// Inventory search covers Kustomizations only.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// GetDrift compares the objects a Kustomization or HelmRelease applied
+	// with their live state, and lists the fields changed outside of Flux.
+	// The drift of Kustomizations can only be detected on the management
+	// cluster, as artifacts are fetched from its source-controller.
+	GetDrift(ctx context.Context, in *GetDriftRequest, opts ...grpc.CallOption) (*GetDriftResponse, error)
+	// ListHelmReleaseRevisions lists the revisions of a HelmRelease kept in
+	// the Helm storage, latest first.
+	ListHelmReleaseRevisions(ctx context.Context, in *ListHelmReleaseRevisionsRequest, opts ...grpc.CallOption) (*ListHelmReleaseRevisionsResponse, error)
+	// RollbackHelmRelease pins the chart version and values of a HelmRelease
+	// to those of a previous revision, so it's upgraded back to it. When the
+	// HelmRelease has valuesFrom, only the chart version is pinned, as the
+	// values of the revision include those from Secrets.
+	RollbackHelmRelease(ctx context.Context, in *RollbackHelmReleaseRequest, opts ...grpc.CallOption) (*RollbackHelmReleaseResponse, error)
+	// ListArtifactFiles lists the files in the latest artifact of a
+	// GitRepository, OCIRepository or Bucket on the management cluster.
+	// The artifact is checked against its digest.
+	ListArtifactFiles(ctx context.Context, in *ListArtifactFilesRequest, opts ...grpc.CallOption) (*ListArtifactFilesResponse, error)
+	// GetArtifactFile returns the content of a file in the latest artifact
+	// of a GitRepository, OCIRepository or Bucket on the management cluster.
+	GetArtifactFile(ctx context.Context, in *GetArtifactFileRequest, opts ...grpc.CallOption) (*GetArtifactFileResponse, error)
+	// ListTerraformObjects lists the Terraform objects of tofu-controller,
+	// with their pending plans.
+	ListTerraformObjects(ctx context.Context, in *ListTerraformObjectsRequest, opts ...grpc.CallOption) (*ListTerraformObjectsResponse, error)
+	// GetTerraformPlan returns the pending plan of a Terraform object, which
+	// needs spec.storeReadablePlan to be set.
+	GetTerraformPlan(ctx context.Context, in *GetTerraformPlanRequest, opts ...grpc.CallOption) (*GetTerraformPlanResponse, error)
+	// ApproveTerraformPlan approves the pending plan of a Terraform object,
+	// which tofu-controller then applies.
+	ApproveTerraformPlan(ctx context.Context, in *ApproveTerraformPlanRequest, opts ...grpc.CallOption) (*ApproveTerraformPlanResponse, error)
+	// ReplanTerraformObject discards the pending plan of a Terraform object
+	// and has tofu-controller plan again.
+	ReplanTerraformObject(ctx context.Context, in *ReplanTerraformObjectRequest, opts ...grpc.CallOption) (*ReplanTerraformObjectResponse, error)
 	// ListPolicies list policies available on the cluster
 	ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error)
 	// GetPolicy gets a policy by name
@@ -138,6 +253,25 @@ func (c *coreClient) ListObjects(ctx context.Context, in *ListObjectsRequest, op
 	return out, nil
 }
 
+func (c *coreClient) WatchObjects(ctx context.Context, in *WatchObjectsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchObjectsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Core_ServiceDesc.Streams[0], Core_WatchObjects_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchObjectsRequest, WatchObjectsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_WatchObjectsClient = grpc.ServerStreamingClient[WatchObjectsResponse]
+
 func (c *coreClient) ListFluxRuntimeObjects(ctx context.Context, in *ListFluxRuntimeObjectsRequest, opts ...grpc.CallOption) (*ListFluxRuntimeObjectsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListFluxRuntimeObjectsResponse)
@@ -228,6 +362,16 @@ func (c *coreClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts
 	return out, nil
 }
 
+func (c *coreClient) GetReconciliationHistory(ctx context.Context, in *GetReconciliationHistoryRequest, opts ...grpc.CallOption) (*GetReconciliationHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReconciliationHistoryResponse)
+	err := c.cc.Invoke(ctx, Core_GetReconciliationHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreClient) SyncFluxObject(ctx context.Context, in *SyncFluxObjectRequest, opts ...grpc.CallOption) (*SyncFluxObjectResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SyncFluxObjectResponse)
@@ -278,6 +422,25 @@ func (c *coreClient) GetSessionLogs(ctx context.Context, in *GetSessionLogsReque
 	return out, nil
 }
 
+func (c *coreClient) GetControllerLogs(ctx context.Context, in *GetControllerLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetControllerLogsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Core_ServiceDesc.Streams[1], Core_GetControllerLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetControllerLogsRequest, GetControllerLogsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_GetControllerLogsClient = grpc.ServerStreamingClient[GetControllerLogsResponse]
+
 func (c *coreClient) IsCRDAvailable(ctx context.Context, in *IsCRDAvailableRequest, opts ...grpc.CallOption) (*IsCRDAvailableResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(IsCRDAvailableResponse)
@@ -298,6 +461,206 @@ func (c *coreClient) GetInventory(ctx context.Context, in *GetInventoryRequest,
 	return out, nil
 }
 
+func (c *coreClient) GetApplicationHealth(ctx context.Context, in *GetApplicationHealthRequest, opts ...grpc.CallOption) (*GetApplicationHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApplicationHealthResponse)
+	err := c.cc.Invoke(ctx, Core_GetApplicationHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetDependencyGraph(ctx context.Context, in *GetDependencyGraphRequest, opts ...grpc.CallOption) (*GetDependencyGraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDependencyGraphResponse)
+	err := c.cc.Invoke(ctx, Core_GetDependencyGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) DiffKustomization(ctx context.Context, in *DiffKustomizationRequest, opts ...grpc.CallOption) (*DiffKustomizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffKustomizationResponse)
+	err := c.cc.Invoke(ctx, Core_DiffKustomization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, Core_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPITokenResponse)
+	err := c.cc.Invoke(ctx, Core_CreateAPIToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListAPITokens(ctx context.Context, in *ListAPITokensRequest, opts ...grpc.CallOption) (*ListAPITokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPITokensResponse)
+	err := c.cc.Invoke(ctx, Core_ListAPITokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*RevokeAPITokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPITokenResponse)
+	err := c.cc.Invoke(ctx, Core_RevokeAPIToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, Core_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionsResponse)
+	err := c.cc.Invoke(ctx, Core_RevokeSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetOwningAutomation(ctx context.Context, in *GetOwningAutomationRequest, opts ...grpc.CallOption) (*GetOwningAutomationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOwningAutomationResponse)
+	err := c.cc.Invoke(ctx, Core_GetOwningAutomation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, Core_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetDrift(ctx context.Context, in *GetDriftRequest, opts ...grpc.CallOption) (*GetDriftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDriftResponse)
+	err := c.cc.Invoke(ctx, Core_GetDrift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListHelmReleaseRevisions(ctx context.Context, in *ListHelmReleaseRevisionsRequest, opts ...grpc.CallOption) (*ListHelmReleaseRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHelmReleaseRevisionsResponse)
+	err := c.cc.Invoke(ctx, Core_ListHelmReleaseRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RollbackHelmRelease(ctx context.Context, in *RollbackHelmReleaseRequest, opts ...grpc.CallOption) (*RollbackHelmReleaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackHelmReleaseResponse)
+	err := c.cc.Invoke(ctx, Core_RollbackHelmRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListArtifactFiles(ctx context.Context, in *ListArtifactFilesRequest, opts ...grpc.CallOption) (*ListArtifactFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListArtifactFilesResponse)
+	err := c.cc.Invoke(ctx, Core_ListArtifactFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetArtifactFile(ctx context.Context, in *GetArtifactFileRequest, opts ...grpc.CallOption) (*GetArtifactFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArtifactFileResponse)
+	err := c.cc.Invoke(ctx, Core_GetArtifactFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ListTerraformObjects(ctx context.Context, in *ListTerraformObjectsRequest, opts ...grpc.CallOption) (*ListTerraformObjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTerraformObjectsResponse)
+	err := c.cc.Invoke(ctx, Core_ListTerraformObjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetTerraformPlan(ctx context.Context, in *GetTerraformPlanRequest, opts ...grpc.CallOption) (*GetTerraformPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTerraformPlanResponse)
+	err := c.cc.Invoke(ctx, Core_GetTerraformPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ApproveTerraformPlan(ctx context.Context, in *ApproveTerraformPlanRequest, opts ...grpc.CallOption) (*ApproveTerraformPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveTerraformPlanResponse)
+	err := c.cc.Invoke(ctx, Core_ApproveTerraformPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) ReplanTerraformObject(ctx context.Context, in *ReplanTerraformObjectRequest, opts ...grpc.CallOption) (*ReplanTerraformObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplanTerraformObjectResponse)
+	err := c.cc.Invoke(ctx, Core_ReplanTerraformObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreClient) ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPoliciesResponse)
@@ -349,6 +712,10 @@ type CoreServer interface {
 	GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error)
 	// ListObjects gets data about primary objects.
 	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
+	// WatchObjects streams added, modified and deleted events for primary
+	// objects matching the given kind, namespace and cluster.
+	// The stream starts with an added event for every existing object.
+	WatchObjects(*WatchObjectsRequest, grpc.ServerStreamingServer[WatchObjectsResponse]) error
 	// ListFluxRuntimeObjects lists the flux runtime deployments from a cluster.
 	ListFluxRuntimeObjects(context.Context, *ListFluxRuntimeObjectsRequest) (*ListFluxRuntimeObjectsResponse, error)
 	ListFluxCrds(context.Context, *ListFluxCrdsRequest) (*ListFluxCrdsResponse, error)
@@ -376,6 +743,9 @@ type CoreServer interface {
 	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
 	// ListEvents returns with a list of events
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// GetReconciliationHistory returns the recorded reconciliation attempts
+	// of a Kustomization or HelmRelease, newest first.
+	GetReconciliationHistory(context.Context, *GetReconciliationHistoryRequest) (*GetReconciliationHistoryResponse, error)
 	// SyncResource forces a reconciliation of a Flux resource
 	SyncFluxObject(context.Context, *SyncFluxObjectRequest) (*SyncFluxObjectResponse, error)
 	// GetVersion returns version information about the server
@@ -383,14 +753,99 @@ type CoreServer interface {
 	// GetFeatureFlags returns configuration information about the server
 	GetFeatureFlags(context.Context, *GetFeatureFlagsRequest) (*GetFeatureFlagsResponse, error)
 	// ToggleSuspendResource suspends or resumes a flux object.
+	// Suspensions can end at a given time, or recur in freeze windows;
+	// resuming an object removes any schedule.
 	ToggleSuspendResource(context.Context, *ToggleSuspendResourceRequest) (*ToggleSuspendResourceResponse, error)
 	// GetSessionLogs returns the logs for a given session
 	GetSessionLogs(context.Context, *GetSessionLogsRequest) (*GetSessionLogsResponse, error)
+	// GetControllerLogs streams the entries of the Flux controllers' logs
+	// about an object, e.g. why a Kustomization failed to reconcile.
+	// Reading the object is enough to read its log entries. It needs the
+	// server to be started with --controller-logs.
+	GetControllerLogs(*GetControllerLogsRequest, grpc.ServerStreamingServer[GetControllerLogsResponse]) error
 	// IsCRDAvailable returns with a hashmap where the keys are the names of
 	// the clusters, and the value is a boolean indicating whether given CRD is
 	// installed or not on that cluster.
 	IsCRDAvailable(context.Context, *IsCRDAvailableRequest) (*IsCRDAvailableResponse, error)
 	GetInventory(context.Context, *GetInventoryRequest) (*GetInventoryResponse, error)
+	// GetApplicationHealth returns the rolled up health of a Kustomization,
+	// HelmRelease or other Flux object and its inventory.
+	GetApplicationHealth(context.Context, *GetApplicationHealthRequest) (*GetApplicationHealthResponse, error)
+	// GetDependencyGraph returns the sources, Kustomizations and HelmReleases
+	// of a cluster with the sourceRef and dependsOn edges between them,
+	// flagging cycles and references to objects that don't exist.
+	GetDependencyGraph(context.Context, *GetDependencyGraphRequest) (*GetDependencyGraphResponse, error)
+	// DiffKustomization builds the latest artifact of the source of a
+	// Kustomization and server-side dry-run applies it, returning the objects
+	// that would be created, updated or pruned. Only the Kustomizations of
+	// the management cluster can be diffed, as artifacts are fetched from
+	// its source-controller.
+	DiffKustomization(context.Context, *DiffKustomizationRequest) (*DiffKustomizationResponse, error)
+	// ListAuditEvents returns the most recent actions users took through
+	// the dashboard, newest first, in the namespaces the user can access.
+	// The actions on cluster-scoped objects are only listed to the users
+	// policies allow to list the audit events of all namespaces. Only the
+	// events since the server started, up to --audit-max-events, are kept
+	// in memory to be listed: the stdout, file and Event sinks are the
+	// durable record.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// CreateAPIToken mints a long-lived token authenticating API requests as
+	// the user, for scripts and CI jobs. The token is only returned once.
+	// It acts as the user only, without their groups, which could change
+	// during the token's lifetime. It's only allowed what's bound to the
+	// user itself, so it can be refused what the user is allowed through a
+	// group. The read scope allows calling the RPCs that only read, whatever
+	// their HTTP method.
+	CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenResponse, error)
+	// ListAPITokens lists the user's API tokens, without the tokens.
+	ListAPITokens(context.Context, *ListAPITokensRequest) (*ListAPITokensResponse, error)
+	// RevokeAPIToken deletes one of the user's API tokens.
+	RevokeAPIToken(context.Context, *RevokeAPITokenRequest) (*RevokeAPITokenResponse, error)
+	// ListSessions lists the dashboard sessions of a user, or of everyone.
+	// It's only available to administrators allowed by a policy.
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// RevokeSessions signs out of a session, or all the sessions of a user.
+	// It's only available to administrators allowed by a policy.
+	RevokeSessions(context.Context, *RevokeSessionsRequest) (*RevokeSessionsResponse, error)
+	// GetOwningAutomation finds the Flux automation that applied an object,
+	// then the one that applied that automation, and so on up to the source.
+	GetOwningAutomation(context.Context, *GetOwningAutomationRequest) (*GetOwningAutomationResponse, error)
+	// Search finds objects on all clusters in the search index, best
+	// matches first, leaving out those in namespaces the user can't access.
+	// Inventory search covers Kustomizations only.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// GetDrift compares the objects a Kustomization or HelmRelease applied
+	// with their live state, and lists the fields changed outside of Flux.
+	// The drift of Kustomizations can only be detected on the management
+	// cluster, as artifacts are fetched from its source-controller.
+	GetDrift(context.Context, *GetDriftRequest) (*GetDriftResponse, error)
+	// ListHelmReleaseRevisions lists the revisions of a HelmRelease kept in
+	// the Helm storage, latest first.
+	ListHelmReleaseRevisions(context.Context, *ListHelmReleaseRevisionsRequest) (*ListHelmReleaseRevisionsResponse, error)
+	// RollbackHelmRelease pins the chart version and values of a HelmRelease
+	// to those of a previous revision, so it's upgraded back to it. When the
+	// HelmRelease has valuesFrom, only the chart version is pinned, as the
+	// values of the revision include those from Secrets.
+	RollbackHelmRelease(context.Context, *RollbackHelmReleaseRequest) (*RollbackHelmReleaseResponse, error)
+	// ListArtifactFiles lists the files in the latest artifact of a
+	// GitRepository, OCIRepository or Bucket on the management cluster.
+	// The artifact is checked against its digest.
+	ListArtifactFiles(context.Context, *ListArtifactFilesRequest) (*ListArtifactFilesResponse, error)
+	// GetArtifactFile returns the content of a file in the latest artifact
+	// of a GitRepository, OCIRepository or Bucket on the management cluster.
+	GetArtifactFile(context.Context, *GetArtifactFileRequest) (*GetArtifactFileResponse, error)
+	// ListTerraformObjects lists the Terraform objects of tofu-controller,
+	// with their pending plans.
+	ListTerraformObjects(context.Context, *ListTerraformObjectsRequest) (*ListTerraformObjectsResponse, error)
+	// GetTerraformPlan returns the pending plan of a Terraform object, which
+	// needs spec.storeReadablePlan to be set.
+	GetTerraformPlan(context.Context, *GetTerraformPlanRequest) (*GetTerraformPlanResponse, error)
+	// ApproveTerraformPlan approves the pending plan of a Terraform object,
+	// which tofu-controller then applies.
+	ApproveTerraformPlan(context.Context, *ApproveTerraformPlanRequest) (*ApproveTerraformPlanResponse, error)
+	// ReplanTerraformObject discards the pending plan of a Terraform object
+	// and has tofu-controller plan again.
+	ReplanTerraformObject(context.Context, *ReplanTerraformObjectRequest) (*ReplanTerraformObjectResponse, error)
 	// ListPolicies list policies available on the cluster
 	ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error)
 	// GetPolicy gets a policy by name
@@ -415,6 +870,9 @@ func (UnimplementedCoreServer) GetObject(context.Context, *GetObjectRequest) (*G
 func (UnimplementedCoreServer) ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
 }
+func (UnimplementedCoreServer) WatchObjects(*WatchObjectsRequest, grpc.ServerStreamingServer[WatchObjectsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchObjects not implemented")
+}
 func (UnimplementedCoreServer) ListFluxRuntimeObjects(context.Context, *ListFluxRuntimeObjectsRequest) (*ListFluxRuntimeObjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFluxRuntimeObjects not implemented")
 }
@@ -442,6 +900,9 @@ func (UnimplementedCoreServer) ListNamespaces(context.Context, *ListNamespacesRe
 func (UnimplementedCoreServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
 }
+func (UnimplementedCoreServer) GetReconciliationHistory(context.Context, *GetReconciliationHistoryRequest) (*GetReconciliationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationHistory not implemented")
+}
 func (UnimplementedCoreServer) SyncFluxObject(context.Context, *SyncFluxObjectRequest) (*SyncFluxObjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncFluxObject not implemented")
 }
@@ -457,12 +918,75 @@ func (UnimplementedCoreServer) ToggleSuspendResource(context.Context, *ToggleSus
 func (UnimplementedCoreServer) GetSessionLogs(context.Context, *GetSessionLogsRequest) (*GetSessionLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSessionLogs not implemented")
 }
+func (UnimplementedCoreServer) GetControllerLogs(*GetControllerLogsRequest, grpc.ServerStreamingServer[GetControllerLogsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetControllerLogs not implemented")
+}
 func (UnimplementedCoreServer) IsCRDAvailable(context.Context, *IsCRDAvailableRequest) (*IsCRDAvailableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsCRDAvailable not implemented")
 }
 func (UnimplementedCoreServer) GetInventory(context.Context, *GetInventoryRequest) (*GetInventoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInventory not implemented")
 }
+func (UnimplementedCoreServer) GetApplicationHealth(context.Context, *GetApplicationHealthRequest) (*GetApplicationHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationHealth not implemented")
+}
+func (UnimplementedCoreServer) GetDependencyGraph(context.Context, *GetDependencyGraphRequest) (*GetDependencyGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDependencyGraph not implemented")
+}
+func (UnimplementedCoreServer) DiffKustomization(context.Context, *DiffKustomizationRequest) (*DiffKustomizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffKustomization not implemented")
+}
+func (UnimplementedCoreServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedCoreServer) CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIToken not implemented")
+}
+func (UnimplementedCoreServer) ListAPITokens(context.Context, *ListAPITokensRequest) (*ListAPITokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPITokens not implemented")
+}
+func (UnimplementedCoreServer) RevokeAPIToken(context.Context, *RevokeAPITokenRequest) (*RevokeAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIToken not implemented")
+}
+func (UnimplementedCoreServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedCoreServer) RevokeSessions(context.Context, *RevokeSessionsRequest) (*RevokeSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessions not implemented")
+}
+func (UnimplementedCoreServer) GetOwningAutomation(context.Context, *GetOwningAutomationRequest) (*GetOwningAutomationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOwningAutomation not implemented")
+}
+func (UnimplementedCoreServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedCoreServer) GetDrift(context.Context, *GetDriftRequest) (*GetDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrift not implemented")
+}
+func (UnimplementedCoreServer) ListHelmReleaseRevisions(context.Context, *ListHelmReleaseRevisionsRequest) (*ListHelmReleaseRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHelmReleaseRevisions not implemented")
+}
+func (UnimplementedCoreServer) RollbackHelmRelease(context.Context, *RollbackHelmReleaseRequest) (*RollbackHelmReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackHelmRelease not implemented")
+}
+func (UnimplementedCoreServer) ListArtifactFiles(context.Context, *ListArtifactFilesRequest) (*ListArtifactFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArtifactFiles not implemented")
+}
+func (UnimplementedCoreServer) GetArtifactFile(context.Context, *GetArtifactFileRequest) (*GetArtifactFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtifactFile not implemented")
+}
+func (UnimplementedCoreServer) ListTerraformObjects(context.Context, *ListTerraformObjectsRequest) (*ListTerraformObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTerraformObjects not implemented")
+}
+func (UnimplementedCoreServer) GetTerraformPlan(context.Context, *GetTerraformPlanRequest) (*GetTerraformPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTerraformPlan not implemented")
+}
+func (UnimplementedCoreServer) ApproveTerraformPlan(context.Context, *ApproveTerraformPlanRequest) (*ApproveTerraformPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveTerraformPlan not implemented")
+}
+func (UnimplementedCoreServer) ReplanTerraformObject(context.Context, *ReplanTerraformObjectRequest) (*ReplanTerraformObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplanTerraformObject not implemented")
+}
 func (UnimplementedCoreServer) ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPolicies not implemented")
 }
@@ -532,6 +1056,17 @@ func _Core_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Core_WatchObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchObjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServer).WatchObjects(m, &grpc.GenericServerStream[WatchObjectsRequest, WatchObjectsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_WatchObjectsServer = grpc.ServerStreamingServer[WatchObjectsResponse]
+
 func _Core_ListFluxRuntimeObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFluxRuntimeObjectsRequest)
 	if err := dec(in); err != nil {
@@ -694,6 +1229,24 @@ func _Core_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Core_GetReconciliationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetReconciliationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetReconciliationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetReconciliationHistory(ctx, req.(*GetReconciliationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Core_SyncFluxObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SyncFluxObjectRequest)
 	if err := dec(in); err != nil {
@@ -784,6 +1337,17 @@ func _Core_GetSessionLogs_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Core_GetControllerLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetControllerLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServer).GetControllerLogs(m, &grpc.GenericServerStream[GetControllerLogsRequest, GetControllerLogsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Core_GetControllerLogsServer = grpc.ServerStreamingServer[GetControllerLogsResponse]
+
 func _Core_IsCRDAvailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IsCRDAvailableRequest)
 	if err := dec(in); err != nil {
@@ -820,101 +1384,461 @@ func _Core_GetInventory_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_ListPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPoliciesRequest)
+func _Core_GetApplicationHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationHealthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).ListPolicies(ctx, in)
+		return srv.(CoreServer).GetApplicationHealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_ListPolicies_FullMethodName,
+		FullMethod: Core_GetApplicationHealth_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).ListPolicies(ctx, req.(*ListPoliciesRequest))
+		return srv.(CoreServer).GetApplicationHealth(ctx, req.(*GetApplicationHealthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPolicyRequest)
+func _Core_GetDependencyGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDependencyGraphRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetPolicy(ctx, in)
+		return srv.(CoreServer).GetDependencyGraph(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetPolicy_FullMethodName,
+		FullMethod: Core_GetDependencyGraph_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetPolicy(ctx, req.(*GetPolicyRequest))
+		return srv.(CoreServer).GetDependencyGraph(ctx, req.(*GetDependencyGraphRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_ListPolicyValidations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPolicyValidationsRequest)
+func _Core_DiffKustomization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffKustomizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).ListPolicyValidations(ctx, in)
+		return srv.(CoreServer).DiffKustomization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_ListPolicyValidations_FullMethodName,
+		FullMethod: Core_DiffKustomization_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).ListPolicyValidations(ctx, req.(*ListPolicyValidationsRequest))
+		return srv.(CoreServer).DiffKustomization(ctx, req.(*DiffKustomizationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Core_GetPolicyValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPolicyValidationRequest)
+func _Core_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreServer).GetPolicyValidation(ctx, in)
+		return srv.(CoreServer).ListAuditEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Core_GetPolicyValidation_FullMethodName,
+		FullMethod: Core_ListAuditEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreServer).GetPolicyValidation(ctx, req.(*GetPolicyValidationRequest))
+		return srv.(CoreServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Core_ServiceDesc is the grpc.ServiceDesc for Core service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Core_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "gitops_core.v1.Core",
-	HandlerType: (*CoreServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetObject",
-			Handler:    _Core_GetObject_Handler,
-		},
-		{
-			MethodName: "ListObjects",
-			Handler:    _Core_ListObjects_Handler,
-		},
-		{
-			MethodName: "ListFluxRuntimeObjects",
-			Handler:    _Core_ListFluxRuntimeObjects_Handler,
-		},
-		{
-			MethodName: "ListFluxCrds",
-			Handler:    _Core_ListFluxCrds_Handler,
-		},
+func _Core_CreateAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).CreateAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_CreateAPIToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).CreateAPIToken(ctx, req.(*CreateAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListAPITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPITokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListAPITokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ListAPITokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListAPITokens(ctx, req.(*ListAPITokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_RevokeAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).RevokeAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_RevokeAPIToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).RevokeAPIToken(ctx, req.(*RevokeAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_RevokeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).RevokeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_RevokeSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).RevokeSessions(ctx, req.(*RevokeSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetOwningAutomation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOwningAutomationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetOwningAutomation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetOwningAutomation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetOwningAutomation(ctx, req.(*GetOwningAutomationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetDrift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetDrift(ctx, req.(*GetDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListHelmReleaseRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHelmReleaseRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListHelmReleaseRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ListHelmReleaseRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListHelmReleaseRevisions(ctx, req.(*ListHelmReleaseRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_RollbackHelmRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackHelmReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).RollbackHelmRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_RollbackHelmRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).RollbackHelmRelease(ctx, req.(*RollbackHelmReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListArtifactFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArtifactFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListArtifactFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ListArtifactFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListArtifactFiles(ctx, req.(*ListArtifactFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetArtifactFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtifactFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetArtifactFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetArtifactFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetArtifactFile(ctx, req.(*GetArtifactFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListTerraformObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTerraformObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListTerraformObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ListTerraformObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListTerraformObjects(ctx, req.(*ListTerraformObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetTerraformPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTerraformPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetTerraformPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetTerraformPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetTerraformPlan(ctx, req.(*GetTerraformPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ApproveTerraformPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveTerraformPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ApproveTerraformPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ApproveTerraformPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ApproveTerraformPlan(ctx, req.(*ApproveTerraformPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ReplanTerraformObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplanTerraformObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ReplanTerraformObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ReplanTerraformObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ReplanTerraformObject(ctx, req.(*ReplanTerraformObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ListPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListPolicies(ctx, req.(*ListPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetPolicy(ctx, req.(*GetPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_ListPolicyValidations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPolicyValidationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).ListPolicyValidations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_ListPolicyValidations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).ListPolicyValidations(ctx, req.(*ListPolicyValidationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetPolicyValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPolicyValidationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetPolicyValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Core_GetPolicyValidation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetPolicyValidation(ctx, req.(*GetPolicyValidationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Core_ServiceDesc is the grpc.ServiceDesc for Core service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Core_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gitops_core.v1.Core",
+	HandlerType: (*CoreServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetObject",
+			Handler:    _Core_GetObject_Handler,
+		},
+		{
+			MethodName: "ListObjects",
+			Handler:    _Core_ListObjects_Handler,
+		},
+		{
+			MethodName: "ListFluxRuntimeObjects",
+			Handler:    _Core_ListFluxRuntimeObjects_Handler,
+		},
+		{
+			MethodName: "ListFluxCrds",
+			Handler:    _Core_ListFluxCrds_Handler,
+		},
 		{
 			MethodName: "ListRuntimeObjects",
 			Handler:    _Core_ListRuntimeObjects_Handler,
@@ -943,6 +1867,10 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEvents",
 			Handler:    _Core_ListEvents_Handler,
 		},
+		{
+			MethodName: "GetReconciliationHistory",
+			Handler:    _Core_GetReconciliationHistory_Handler,
+		},
 		{
 			MethodName: "SyncFluxObject",
 			Handler:    _Core_SyncFluxObject_Handler,
@@ -971,6 +1899,86 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInventory",
 			Handler:    _Core_GetInventory_Handler,
 		},
+		{
+			MethodName: "GetApplicationHealth",
+			Handler:    _Core_GetApplicationHealth_Handler,
+		},
+		{
+			MethodName: "GetDependencyGraph",
+			Handler:    _Core_GetDependencyGraph_Handler,
+		},
+		{
+			MethodName: "DiffKustomization",
+			Handler:    _Core_DiffKustomization_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Core_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "CreateAPIToken",
+			Handler:    _Core_CreateAPIToken_Handler,
+		},
+		{
+			MethodName: "ListAPITokens",
+			Handler:    _Core_ListAPITokens_Handler,
+		},
+		{
+			MethodName: "RevokeAPIToken",
+			Handler:    _Core_RevokeAPIToken_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Core_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSessions",
+			Handler:    _Core_RevokeSessions_Handler,
+		},
+		{
+			MethodName: "GetOwningAutomation",
+			Handler:    _Core_GetOwningAutomation_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Core_Search_Handler,
+		},
+		{
+			MethodName: "GetDrift",
+			Handler:    _Core_GetDrift_Handler,
+		},
+		{
+			MethodName: "ListHelmReleaseRevisions",
+			Handler:    _Core_ListHelmReleaseRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackHelmRelease",
+			Handler:    _Core_RollbackHelmRelease_Handler,
+		},
+		{
+			MethodName: "ListArtifactFiles",
+			Handler:    _Core_ListArtifactFiles_Handler,
+		},
+		{
+			MethodName: "GetArtifactFile",
+			Handler:    _Core_GetArtifactFile_Handler,
+		},
+		{
+			MethodName: "ListTerraformObjects",
+			Handler:    _Core_ListTerraformObjects_Handler,
+		},
+		{
+			MethodName: "GetTerraformPlan",
+			Handler:    _Core_GetTerraformPlan_Handler,
+		},
+		{
+			MethodName: "ApproveTerraformPlan",
+			Handler:    _Core_ApproveTerraformPlan_Handler,
+		},
+		{
+			MethodName: "ReplanTerraformObject",
+			Handler:    _Core_ReplanTerraformObject_Handler,
+		},
 		{
 			MethodName: "ListPolicies",
 			Handler:    _Core_ListPolicies_Handler,
@@ -988,6 +1996,17 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Core_GetPolicyValidation_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchObjects",
+			Handler:       _Core_WatchObjects_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetControllerLogs",
+			Handler:       _Core_GetControllerLogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/core/core.proto",
 }
@@ -288,6 +288,86 @@ func (x *ObjectRef) GetClusterName() string {
 	return ""
 }
 
+// ObjectResult is the outcome of an operation on one of several objects
+type ObjectResult struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Object *ObjectRef             `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	// status is either succeeded or failed
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// revision is the last applied revision, or the artifact revision of a source
+	Revision string `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	// dependent is set when the object was included by cascading
+	Dependent     bool `protobuf:"varint,5,opt,name=dependent,proto3" json:"dependent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectResult) Reset() {
+	*x = ObjectResult{}
+	mi := &file_api_core_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectResult) ProtoMessage() {}
+
+func (x *ObjectResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectResult.ProtoReflect.Descriptor instead.
+func (*ObjectResult) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ObjectResult) GetObject() *ObjectRef {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *ObjectResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ObjectResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ObjectResult) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *ObjectResult) GetDependent() bool {
+	if x != nil {
+		return x.Dependent
+	}
+	return false
+}
+
 type Condition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -301,7 +381,7 @@ type Condition struct {
 
 func (x *Condition) Reset() {
 	*x = Condition{}
-	mi := &file_api_core_types_proto_msgTypes[2]
+	mi := &file_api_core_types_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -313,7 +393,7 @@ func (x *Condition) String() string {
 func (*Condition) ProtoMessage() {}
 
 func (x *Condition) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_types_proto_msgTypes[2]
+	mi := &file_api_core_types_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,7 +406,7 @@ func (x *Condition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Condition.ProtoReflect.Descriptor instead.
 func (*Condition) Descriptor() ([]byte, []int) {
-	return file_api_core_types_proto_rawDescGZIP(), []int{2}
+	return file_api_core_types_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Condition) GetType() string {
@@ -376,7 +456,7 @@ type GitRepositoryRef struct {
 
 func (x *GitRepositoryRef) Reset() {
 	*x = GitRepositoryRef{}
-	mi := &file_api_core_types_proto_msgTypes[3]
+	mi := &file_api_core_types_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -388,7 +468,7 @@ func (x *GitRepositoryRef) String() string {
 func (*GitRepositoryRef) ProtoMessage() {}
 
 func (x *GitRepositoryRef) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_types_proto_msgTypes[3]
+	mi := &file_api_core_types_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,7 +481,7 @@ func (x *GitRepositoryRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitRepositoryRef.ProtoReflect.Descriptor instead.
 func (*GitRepositoryRef) Descriptor() ([]byte, []int) {
-	return file_api_core_types_proto_rawDescGZIP(), []int{3}
+	return file_api_core_types_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GitRepositoryRef) GetBranch() string {
@@ -444,7 +524,7 @@ type GroupVersionKind struct {
 
 func (x *GroupVersionKind) Reset() {
 	*x = GroupVersionKind{}
-	mi := &file_api_core_types_proto_msgTypes[4]
+	mi := &file_api_core_types_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -456,7 +536,7 @@ func (x *GroupVersionKind) String() string {
 func (*GroupVersionKind) ProtoMessage() {}
 
 func (x *GroupVersionKind) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_types_proto_msgTypes[4]
+	mi := &file_api_core_types_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,7 +549,7 @@ func (x *GroupVersionKind) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupVersionKind.ProtoReflect.Descriptor instead.
 func (*GroupVersionKind) Descriptor() ([]byte, []int) {
-	return file_api_core_types_proto_rawDescGZIP(), []int{4}
+	return file_api_core_types_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GroupVersionKind) GetGroup() string {
@@ -503,7 +583,7 @@ type NamespacedObjectReference struct {
 
 func (x *NamespacedObjectReference) Reset() {
 	*x = NamespacedObjectReference{}
-	mi := &file_api_core_types_proto_msgTypes[5]
+	mi := &file_api_core_types_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -515,7 +595,7 @@ func (x *NamespacedObjectReference) String() string {
 func (*NamespacedObjectReference) ProtoMessage() {}
 
 func (x *NamespacedObjectReference) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_types_proto_msgTypes[5]
+	mi := &file_api_core_types_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +608,7 @@ func (x *NamespacedObjectReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NamespacedObjectReference.ProtoReflect.Descriptor instead.
 func (*NamespacedObjectReference) Descriptor() ([]byte, []int) {
-	return file_api_core_types_proto_rawDescGZIP(), []int{5}
+	return file_api_core_types_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *NamespacedObjectReference) GetName() string {
@@ -555,7 +635,7 @@ type HealthStatus struct {
 
 func (x *HealthStatus) Reset() {
 	*x = HealthStatus{}
-	mi := &file_api_core_types_proto_msgTypes[6]
+	mi := &file_api_core_types_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -567,7 +647,7 @@ func (x *HealthStatus) String() string {
 func (*HealthStatus) ProtoMessage() {}
 
 func (x *HealthStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_types_proto_msgTypes[6]
+	mi := &file_api_core_types_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +660,7 @@ func (x *HealthStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthStatus.ProtoReflect.Descriptor instead.
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return file_api_core_types_proto_rawDescGZIP(), []int{6}
+	return file_api_core_types_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *HealthStatus) GetStatus() string {
@@ -598,19 +678,21 @@ func (x *HealthStatus) GetMessage() string {
 }
 
 type InventoryEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Payload       string                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
-	Tenant        string                 `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	ClusterName   string                 `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	Health        *HealthStatus          `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
-	Children      []*InventoryEntry      `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Payload     string                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Tenant      string                 `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	ClusterName string                 `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Health      *HealthStatus          `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"`
+	Children    []*InventoryEntry      `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
+	// rollup_health is the worst of the entry's health and its children's
+	RollupHealth  *HealthStatus `protobuf:"bytes,6,opt,name=rollup_health,json=rollupHealth,proto3" json:"rollup_health,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InventoryEntry) Reset() {
 	*x = InventoryEntry{}
-	mi := &file_api_core_types_proto_msgTypes[7]
+	mi := &file_api_core_types_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -622,7 +704,7 @@ func (x *InventoryEntry) String() string {
 func (*InventoryEntry) ProtoMessage() {}
 
 func (x *InventoryEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_types_proto_msgTypes[7]
+	mi := &file_api_core_types_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +717,7 @@ func (x *InventoryEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InventoryEntry.ProtoReflect.Descriptor instead.
 func (*InventoryEntry) Descriptor() ([]byte, []int) {
-	return file_api_core_types_proto_rawDescGZIP(), []int{7}
+	return file_api_core_types_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *InventoryEntry) GetPayload() string {
@@ -673,6 +755,130 @@ func (x *InventoryEntry) GetChildren() []*InventoryEntry {
 	return nil
 }
 
+func (x *InventoryEntry) GetRollupHealth() *HealthStatus {
+	if x != nil {
+		return x.RollupHealth
+	}
+	return nil
+}
+
+// HealthSummary rolls up the health of an application's objects
+type HealthSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status is the worst status of all the objects, from best to worst
+	// Healthy, Progressing, Unknown and Unhealthy
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// counts is the number of objects with each status
+	Counts map[string]int32 `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// offenders are the least healthy objects, worst first
+	Offenders     []*HealthOffender `protobuf:"bytes,3,rep,name=offenders,proto3" json:"offenders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthSummary) Reset() {
+	*x = HealthSummary{}
+	mi := &file_api_core_types_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthSummary) ProtoMessage() {}
+
+func (x *HealthSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthSummary.ProtoReflect.Descriptor instead.
+func (*HealthSummary) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HealthSummary) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthSummary) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *HealthSummary) GetOffenders() []*HealthOffender {
+	if x != nil {
+		return x.Offenders
+	}
+	return nil
+}
+
+type HealthOffender struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Object        *ObjectRef             `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Health        *HealthStatus          `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthOffender) Reset() {
+	*x = HealthOffender{}
+	mi := &file_api_core_types_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthOffender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthOffender) ProtoMessage() {}
+
+func (x *HealthOffender) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthOffender.ProtoReflect.Descriptor instead.
+func (*HealthOffender) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HealthOffender) GetObject() *ObjectRef {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *HealthOffender) GetHealth() *HealthStatus {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
 type Object struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Payload       string                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
@@ -688,7 +894,7 @@ type Object struct {
 
 func (x *Object) Reset() {
 	*x = Object{}
-	mi := &file_api_core_types_proto_msgTypes[8]
+	mi := &file_api_core_types_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -700,7 +906,7 @@ func (x *Object) String() string {
 func (*Object) ProtoMessage() {}
 
 func (x *Object) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_types_proto_msgTypes[8]
+	mi := &file_api_core_types_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -713,7 +919,7 @@ func (x *Object) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Object.ProtoReflect.Descriptor instead.
 func (*Object) Descriptor() ([]byte, []int) {
-	return file_api_core_types_proto_rawDescGZIP(), []int{8}
+	return file_api_core_types_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Object) GetPayload() string {
@@ -781,7 +987,7 @@ type Deployment struct {
 
 func (x *Deployment) Reset() {
 	*x = Deployment{}
-	mi := &file_api_core_types_proto_msgTypes[9]
+	mi := &file_api_core_types_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -793,7 +999,7 @@ func (x *Deployment) String() string {
 func (*Deployment) ProtoMessage() {}
 
 func (x *Deployment) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_types_proto_msgTypes[9]
+	mi := &file_api_core_types_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,7 +1012,7 @@ func (x *Deployment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Deployment.ProtoReflect.Descriptor instead.
 func (*Deployment) Descriptor() ([]byte, []int) {
-	return file_api_core_types_proto_rawDescGZIP(), []int{9}
+	return file_api_core_types_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Deployment) GetName() string {
@@ -878,7 +1084,7 @@ type Crd struct {
 
 func (x *Crd) Reset() {
 	*x = Crd{}
-	mi := &file_api_core_types_proto_msgTypes[10]
+	mi := &file_api_core_types_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -890,7 +1096,7 @@ func (x *Crd) String() string {
 func (*Crd) ProtoMessage() {}
 
 func (x *Crd) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_types_proto_msgTypes[10]
+	mi := &file_api_core_types_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,7 +1109,7 @@ func (x *Crd) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Crd.ProtoReflect.Descriptor instead.
 func (*Crd) Descriptor() ([]byte, []int) {
-	return file_api_core_types_proto_rawDescGZIP(), []int{10}
+	return file_api_core_types_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Crd) GetName() *Crd_Name {
@@ -954,7 +1160,7 @@ type Namespace struct {
 
 func (x *Namespace) Reset() {
 	*x = Namespace{}
-	mi := &file_api_core_types_proto_msgTypes[11]
+	mi := &file_api_core_types_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -966,7 +1172,7 @@ func (x *Namespace) String() string {
 func (*Namespace) ProtoMessage() {}
 
 func (x *Namespace) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_types_proto_msgTypes[11]
+	mi := &file_api_core_types_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -979,7 +1185,7 @@ func (x *Namespace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Namespace.ProtoReflect.Descriptor instead.
 func (*Namespace) Descriptor() ([]byte, []int) {
-	return file_api_core_types_proto_rawDescGZIP(), []int{11}
+	return file_api_core_types_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Namespace) GetName() string {
@@ -1017,35 +1223,40 @@ func (x *Namespace) GetClusterName() string {
 	return ""
 }
 
-type Event struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Component     string                 `protobuf:"bytes,5,opt,name=component,proto3" json:"component,omitempty"`
-	Host          string                 `protobuf:"bytes,6,opt,name=host,proto3" json:"host,omitempty"`
-	Name          string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	Uid           string                 `protobuf:"bytes,8,opt,name=uid,proto3" json:"uid,omitempty"`
+type DependencyNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is kind/namespace/name, and is used by the edges
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind        string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace   string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ClusterName string `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// ready is the status of the Ready condition: True, False or Unknown
+	Ready     string `protobuf:"bytes,6,opt,name=ready,proto3" json:"ready,omitempty"`
+	Reason    string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message   string `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	Suspended bool   `protobuf:"varint,9,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// missing is true if the object is referenced but doesn't exist
+	Missing       bool `protobuf:"varint,10,opt,name=missing,proto3" json:"missing,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
-	mi := &file_api_core_types_proto_msgTypes[12]
+func (x *DependencyNode) Reset() {
+	*x = DependencyNode{}
+	mi := &file_api_core_types_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event) String() string {
+func (x *DependencyNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*DependencyNode) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_types_proto_msgTypes[12]
+func (x *DependencyNode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1056,78 +1267,1440 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_api_core_types_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DependencyNode.ProtoReflect.Descriptor instead.
+func (*DependencyNode) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Event) GetType() string {
+func (x *DependencyNode) GetId() string {
 	if x != nil {
-		return x.Type
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Event) GetReason() string {
+func (x *DependencyNode) GetKind() string {
 	if x != nil {
-		return x.Reason
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *Event) GetMessage() string {
+func (x *DependencyNode) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Event) GetTimestamp() string {
+func (x *DependencyNode) GetNamespace() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *Event) GetComponent() string {
+func (x *DependencyNode) GetClusterName() string {
 	if x != nil {
-		return x.Component
+		return x.ClusterName
 	}
 	return ""
 }
 
-func (x *Event) GetHost() string {
+func (x *DependencyNode) GetReady() string {
 	if x != nil {
-		return x.Host
+		return x.Ready
 	}
 	return ""
 }
 
-func (x *Event) GetName() string {
+func (x *DependencyNode) GetReason() string {
 	if x != nil {
-		return x.Name
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Event) GetUid() string {
+func (x *DependencyNode) GetMessage() string {
 	if x != nil {
-		return x.Uid
+		return x.Message
 	}
 	return ""
 }
 
-type Crd_Name struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Plural        string                 `protobuf:"bytes,1,opt,name=plural,proto3" json:"plural,omitempty"`
-	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+func (x *DependencyNode) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *DependencyNode) GetMissing() bool {
+	if x != nil {
+		return x.Missing
+	}
+	return false
+}
+
+type DependencyEdge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// type is either sourceRef or dependsOn
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// dangling is true if the object the edge points to doesn't exist
+	Dangling      bool `protobuf:"varint,4,opt,name=dangling,proto3" json:"dangling,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Crd_Name) Reset() {
+func (x *DependencyEdge) Reset() {
+	*x = DependencyEdge{}
+	mi := &file_api_core_types_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyEdge) ProtoMessage() {}
+
+func (x *DependencyEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyEdge.ProtoReflect.Descriptor instead.
+func (*DependencyEdge) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DependencyEdge) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DependencyEdge) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *DependencyEdge) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DependencyEdge) GetDangling() bool {
+	if x != nil {
+		return x.Dangling
+	}
+	return false
+}
+
+type DependencyCycle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// node_ids are the ids of the nodes in the cycle, each depending on the next
+	NodeIds       []string `protobuf:"bytes,1,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependencyCycle) Reset() {
+	*x = DependencyCycle{}
+	mi := &file_api_core_types_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyCycle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyCycle) ProtoMessage() {}
+
+func (x *DependencyCycle) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyCycle.ProtoReflect.Descriptor instead.
+func (*DependencyCycle) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DependencyCycle) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+type ObjectDiff struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// action is one of created, updated, pruned or failed
+	Action     string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	ApiVersion string `protobuf:"bytes,2,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind       string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace  string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name       string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// diff is a unified diff of the object as YAML, with secret values masked
+	Diff          string `protobuf:"bytes,6,opt,name=diff,proto3" json:"diff,omitempty"`
+	Error         string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectDiff) Reset() {
+	*x = ObjectDiff{}
+	mi := &file_api_core_types_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectDiff) ProtoMessage() {}
+
+func (x *ObjectDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectDiff.ProtoReflect.Descriptor instead.
+func (*ObjectDiff) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ObjectDiff) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ObjectDiff) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *ObjectDiff) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ObjectDiff) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ObjectDiff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ObjectDiff) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *ObjectDiff) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReconciliationRecord struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Revision string                 `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// status is the status of the Ready condition after the attempt
+	Status    string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message   string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp string `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// duration is empty if it's unknown
+	Duration      string `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconciliationRecord) Reset() {
+	*x = ReconciliationRecord{}
+	mi := &file_api_core_types_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationRecord) ProtoMessage() {}
+
+func (x *ReconciliationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationRecord.ProtoReflect.Descriptor instead.
+func (*ReconciliationRecord) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReconciliationRecord) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *ReconciliationRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReconciliationRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReconciliationRecord) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReconciliationRecord) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *ReconciliationRecord) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Component     string                 `protobuf:"bytes,5,opt,name=component,proto3" json:"component,omitempty"`
+	Host          string                 `protobuf:"bytes,6,opt,name=host,proto3" json:"host,omitempty"`
+	Name          string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Uid           string                 `protobuf:"bytes,8,opt,name=uid,proto3" json:"uid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_api_core_types_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Event) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Event) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *Event) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *Event) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp   string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RequestId   string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Principal   string                 `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Groups      []string               `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
+	ClusterName string                 `protobuf:"bytes,5,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	ApiVersion  string                 `protobuf:"bytes,6,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind        string                 `protobuf:"bytes,7,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace   string                 `protobuf:"bytes,8,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name        string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`
+	// action is one of sync, suspend, resume or schedule-freeze
+	Action  string `protobuf:"bytes,10,opt,name=action,proto3" json:"action,omitempty"`
+	Comment string `protobuf:"bytes,11,opt,name=comment,proto3" json:"comment,omitempty"`
+	// outcome is either succeeded or failed
+	Outcome       string `protobuf:"bytes,12,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Error         string `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_api_core_types_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AuditEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type APIToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Owner string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// groups are empty on the tokens users create with CreateAPIToken, which
+	// act as the user only. They're only set on the tokens administrators
+	// mint with the CLI, to the groups given to it
+	Groups    []string `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
+	Scopes    []string `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt string   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt string   `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// last_used_at is empty if the token was never used
+	LastUsedAt    string `protobuf:"bytes,8,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIToken) Reset() {
+	*x = APIToken{}
+	mi := &file_api_core_types_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIToken) ProtoMessage() {}
+
+func (x *APIToken) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIToken.ProtoReflect.Descriptor instead.
+func (*APIToken) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *APIToken) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIToken) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *APIToken) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *APIToken) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIToken) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *APIToken) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *APIToken) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+type Session struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id identifies the session, and is not the session token
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	User      string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	CreatedAt string `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// expires_at is when the session ends, if it's not idle for too long
+	// before then
+	ExpiresAt     string `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_api_core_types_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Session) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// SearchResult is an object matching a search
+type SearchResult struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Kind        string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace   string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name        string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// ready is the status of the Ready condition
+	Ready     string     `protobuf:"bytes,5,opt,name=ready,proto3" json:"ready,omitempty"`
+	Message   string     `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	SourceRef *ObjectRef `protobuf:"bytes,7,opt,name=source_ref,json=sourceRef,proto3" json:"source_ref,omitempty"`
+	// score is how well the object matches the query's terms
+	Score         int32 `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_api_core_types_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchResult) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *SearchResult) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SearchResult) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SearchResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchResult) GetReady() string {
+	if x != nil {
+		return x.Ready
+	}
+	return ""
+}
+
+func (x *SearchResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SearchResult) GetSourceRef() *ObjectRef {
+	if x != nil {
+		return x.SourceRef
+	}
+	return nil
+}
+
+func (x *SearchResult) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// OwningAutomation is a Flux automation managing an object
+type OwningAutomation struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Automation *ObjectRef             `protobuf:"bytes,1,opt,name=automation,proto3" json:"automation,omitempty"`
+	// source_ref is empty if the automation no longer exists
+	SourceRef *ObjectRef `protobuf:"bytes,2,opt,name=source_ref,json=sourceRef,proto3" json:"source_ref,omitempty"`
+	// found_by is "labels" or "inventory"
+	FoundBy       string `protobuf:"bytes,3,opt,name=found_by,json=foundBy,proto3" json:"found_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwningAutomation) Reset() {
+	*x = OwningAutomation{}
+	mi := &file_api_core_types_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwningAutomation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwningAutomation) ProtoMessage() {}
+
+func (x *OwningAutomation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwningAutomation.ProtoReflect.Descriptor instead.
+func (*OwningAutomation) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OwningAutomation) GetAutomation() *ObjectRef {
+	if x != nil {
+		return x.Automation
+	}
+	return nil
+}
+
+func (x *OwningAutomation) GetSourceRef() *ObjectRef {
+	if x != nil {
+		return x.SourceRef
+	}
+	return nil
+}
+
+func (x *OwningAutomation) GetFoundBy() string {
+	if x != nil {
+		return x.FoundBy
+	}
+	return ""
+}
+
+// ObjectDrift is how a live object differs from what Flux applied
+type ObjectDrift struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersion string                 `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind       string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace  string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name       string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// deleted is true if the object no longer exists
+	Deleted bool          `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Fields  []*FieldDrift `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	// error is why the object couldn't be compared
+	Error         string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectDrift) Reset() {
+	*x = ObjectDrift{}
+	mi := &file_api_core_types_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectDrift) ProtoMessage() {}
+
+func (x *ObjectDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectDrift.ProtoReflect.Descriptor instead.
+func (*ObjectDrift) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ObjectDrift) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *ObjectDrift) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ObjectDrift) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ObjectDrift) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ObjectDrift) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *ObjectDrift) GetFields() []*FieldDrift {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ObjectDrift) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// FieldDrift is a field whose live value differs from the applied one
+type FieldDrift struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// path is e.g. spec.template.spec.containers[0].image
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// applied is the applied value as JSON, empty if it wasn't applied
+	Applied string `protobuf:"bytes,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	// live is the live value as JSON, empty if it was removed
+	Live          string `protobuf:"bytes,3,opt,name=live,proto3" json:"live,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldDrift) Reset() {
+	*x = FieldDrift{}
+	mi := &file_api_core_types_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDrift) ProtoMessage() {}
+
+func (x *FieldDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDrift.ProtoReflect.Descriptor instead.
+func (*FieldDrift) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FieldDrift) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FieldDrift) GetApplied() string {
+	if x != nil {
+		return x.Applied
+	}
+	return ""
+}
+
+func (x *FieldDrift) GetLive() string {
+	if x != nil {
+		return x.Live
+	}
+	return ""
+}
+
+// HelmReleaseRevision is a revision of a Helm release kept in the Helm storage
+type HelmReleaseRevision struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Version      int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ChartName    string                 `protobuf:"bytes,2,opt,name=chart_name,json=chartName,proto3" json:"chart_name,omitempty"`
+	ChartVersion string                 `protobuf:"bytes,3,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"`
+	AppVersion   string                 `protobuf:"bytes,4,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// status is e.g. deployed, superseded or failed
+	Status        string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Description   string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	FirstDeployed string `protobuf:"bytes,7,opt,name=first_deployed,json=firstDeployed,proto3" json:"first_deployed,omitempty"`
+	LastDeployed  string `protobuf:"bytes,8,opt,name=last_deployed,json=lastDeployed,proto3" json:"last_deployed,omitempty"`
+	// values are the values the revision was released with, as YAML
+	Values string `protobuf:"bytes,9,opt,name=values,proto3" json:"values,omitempty"`
+	// values_diff is a unified diff of the values from those of the
+	// previous revision
+	ValuesDiff    string `protobuf:"bytes,10,opt,name=values_diff,json=valuesDiff,proto3" json:"values_diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HelmReleaseRevision) Reset() {
+	*x = HelmReleaseRevision{}
+	mi := &file_api_core_types_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HelmReleaseRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HelmReleaseRevision) ProtoMessage() {}
+
+func (x *HelmReleaseRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HelmReleaseRevision.ProtoReflect.Descriptor instead.
+func (*HelmReleaseRevision) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HelmReleaseRevision) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *HelmReleaseRevision) GetChartName() string {
+	if x != nil {
+		return x.ChartName
+	}
+	return ""
+}
+
+func (x *HelmReleaseRevision) GetChartVersion() string {
+	if x != nil {
+		return x.ChartVersion
+	}
+	return ""
+}
+
+func (x *HelmReleaseRevision) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *HelmReleaseRevision) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HelmReleaseRevision) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *HelmReleaseRevision) GetFirstDeployed() string {
+	if x != nil {
+		return x.FirstDeployed
+	}
+	return ""
+}
+
+func (x *HelmReleaseRevision) GetLastDeployed() string {
+	if x != nil {
+		return x.LastDeployed
+	}
+	return ""
+}
+
+func (x *HelmReleaseRevision) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+func (x *HelmReleaseRevision) GetValuesDiff() string {
+	if x != nil {
+		return x.ValuesDiff
+	}
+	return ""
+}
+
+// ArtifactFile is a file in the artifact of a source
+type ArtifactFile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// path is relative to the root of the artifact
+	Path          string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Size          int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArtifactFile) Reset() {
+	*x = ArtifactFile{}
+	mi := &file_api_core_types_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArtifactFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactFile) ProtoMessage() {}
+
+func (x *ArtifactFile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactFile.ProtoReflect.Descriptor instead.
+func (*ArtifactFile) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ArtifactFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ArtifactFile) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// TerraformObject is a Terraform object of tofu-controller
+type TerraformObject struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Namespace   string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SourceRef   *ObjectRef             `protobuf:"bytes,4,opt,name=source_ref,json=sourceRef,proto3" json:"source_ref,omitempty"`
+	Suspended   bool                   `protobuf:"varint,5,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// ready is the status of the Ready condition
+	Ready               string `protobuf:"bytes,6,opt,name=ready,proto3" json:"ready,omitempty"`
+	Message             string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	LastAppliedRevision string `protobuf:"bytes,8,opt,name=last_applied_revision,json=lastAppliedRevision,proto3" json:"last_applied_revision,omitempty"`
+	LastPlannedRevision string `protobuf:"bytes,9,opt,name=last_planned_revision,json=lastPlannedRevision,proto3" json:"last_planned_revision,omitempty"`
+	// pending_plan is the ID of the plan waiting to be approved
+	PendingPlan string `protobuf:"bytes,10,opt,name=pending_plan,json=pendingPlan,proto3" json:"pending_plan,omitempty"`
+	// approve_plan is "auto" if plans are applied without approval
+	ApprovePlan       string `protobuf:"bytes,11,opt,name=approve_plan,json=approvePlan,proto3" json:"approve_plan,omitempty"`
+	StoreReadablePlan string `protobuf:"bytes,12,opt,name=store_readable_plan,json=storeReadablePlan,proto3" json:"store_readable_plan,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TerraformObject) Reset() {
+	*x = TerraformObject{}
+	mi := &file_api_core_types_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerraformObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerraformObject) ProtoMessage() {}
+
+func (x *TerraformObject) ProtoReflect() protoreflect.Message {
+	mi := &file_api_core_types_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerraformObject.ProtoReflect.Descriptor instead.
+func (*TerraformObject) Descriptor() ([]byte, []int) {
+	return file_api_core_types_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TerraformObject) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *TerraformObject) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *TerraformObject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TerraformObject) GetSourceRef() *ObjectRef {
+	if x != nil {
+		return x.SourceRef
+	}
+	return nil
+}
+
+func (x *TerraformObject) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *TerraformObject) GetReady() string {
+	if x != nil {
+		return x.Ready
+	}
+	return ""
+}
+
+func (x *TerraformObject) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TerraformObject) GetLastAppliedRevision() string {
+	if x != nil {
+		return x.LastAppliedRevision
+	}
+	return ""
+}
+
+func (x *TerraformObject) GetLastPlannedRevision() string {
+	if x != nil {
+		return x.LastPlannedRevision
+	}
+	return ""
+}
+
+func (x *TerraformObject) GetPendingPlan() string {
+	if x != nil {
+		return x.PendingPlan
+	}
+	return ""
+}
+
+func (x *TerraformObject) GetApprovePlan() string {
+	if x != nil {
+		return x.ApprovePlan
+	}
+	return ""
+}
+
+func (x *TerraformObject) GetStoreReadablePlan() string {
+	if x != nil {
+		return x.StoreReadablePlan
+	}
+	return ""
+}
+
+type Crd_Name struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plural        string                 `protobuf:"bytes,1,opt,name=plural,proto3" json:"plural,omitempty"`
+	Group         string                 `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Crd_Name) Reset() {
 	*x = Crd_Name{}
-	mi := &file_api_core_types_proto_msgTypes[14]
+	mi := &file_api_core_types_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1139,7 +2712,7 @@ func (x *Crd_Name) String() string {
 func (*Crd_Name) ProtoMessage() {}
 
 func (x *Crd_Name) ProtoReflect() protoreflect.Message {
-	mi := &file_api_core_types_proto_msgTypes[14]
+	mi := &file_api_core_types_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,7 +2725,7 @@ func (x *Crd_Name) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Crd_Name.ProtoReflect.Descriptor instead.
 func (*Crd_Name) Descriptor() ([]byte, []int) {
-	return file_api_core_types_proto_rawDescGZIP(), []int{10, 0}
+	return file_api_core_types_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *Crd_Name) GetPlural() string {
@@ -1182,7 +2755,13 @@ const file_api_core_types_proto_rawDesc = "" +
 	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
 	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12!\n" +
-	"\fcluster_name\x18\x04 \x01(\tR\vclusterName\"\x87\x01\n" +
+	"\fcluster_name\x18\x04 \x01(\tR\vclusterName\"\xa9\x01\n" +
+	"\fObjectResult\x121\n" +
+	"\x06object\x18\x01 \x01(\v2\x19.gitops_core.v1.ObjectRefR\x06object\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1a\n" +
+	"\brevision\x18\x04 \x01(\tR\brevision\x12\x1c\n" +
+	"\tdependent\x18\x05 \x01(\bR\tdependent\"\x87\x01\n" +
 	"\tCondition\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
@@ -1203,13 +2782,24 @@ const file_api_core_types_proto_rawDesc = "" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"@\n" +
 	"\fHealthStatus\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xd7\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x9a\x02\n" +
 	"\x0eInventoryEntry\x12\x18\n" +
 	"\apayload\x18\x01 \x01(\tR\apayload\x12\x16\n" +
 	"\x06tenant\x18\x02 \x01(\tR\x06tenant\x12!\n" +
 	"\fcluster_name\x18\x03 \x01(\tR\vclusterName\x124\n" +
 	"\x06health\x18\x04 \x01(\v2\x1c.gitops_core.v1.HealthStatusR\x06health\x12:\n" +
-	"\bchildren\x18\x05 \x03(\v2\x1e.gitops_core.v1.InventoryEntryR\bchildren\"\xf9\x01\n" +
+	"\bchildren\x18\x05 \x03(\v2\x1e.gitops_core.v1.InventoryEntryR\bchildren\x12A\n" +
+	"\rrollup_health\x18\x06 \x01(\v2\x1c.gitops_core.v1.HealthStatusR\frollupHealth\"\xe3\x01\n" +
+	"\rHealthSummary\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12A\n" +
+	"\x06counts\x18\x02 \x03(\v2).gitops_core.v1.HealthSummary.CountsEntryR\x06counts\x12<\n" +
+	"\toffenders\x18\x03 \x03(\v2\x1e.gitops_core.v1.HealthOffenderR\toffenders\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"y\n" +
+	"\x0eHealthOffender\x121\n" +
+	"\x06object\x18\x01 \x01(\v2\x19.gitops_core.v1.ObjectRefR\x06object\x124\n" +
+	"\x06health\x18\x02 \x01(\v2\x1c.gitops_core.v1.HealthStatusR\x06health\"\xf9\x01\n" +
 	"\x06Object\x12\x18\n" +
 	"\apayload\x18\x01 \x01(\tR\apayload\x12!\n" +
 	"\fcluster_name\x18\x02 \x01(\tR\vclusterName\x12\x16\n" +
@@ -1253,7 +2843,43 @@ const file_api_core_types_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc3\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x89\x02\n" +
+	"\x0eDependencyNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12!\n" +
+	"\fcluster_name\x18\x05 \x01(\tR\vclusterName\x12\x14\n" +
+	"\x05ready\x18\x06 \x01(\tR\x05ready\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\x12\x1c\n" +
+	"\tsuspended\x18\t \x01(\bR\tsuspended\x12\x18\n" +
+	"\amissing\x18\n" +
+	" \x01(\bR\amissing\"d\n" +
+	"\x0eDependencyEdge\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\bdangling\x18\x04 \x01(\bR\bdangling\",\n" +
+	"\x0fDependencyCycle\x12\x19\n" +
+	"\bnode_ids\x18\x01 \x03(\tR\anodeIds\"\xb5\x01\n" +
+	"\n" +
+	"ObjectDiff\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1f\n" +
+	"\vapi_version\x18\x02 \x01(\tR\n" +
+	"apiVersion\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x12\n" +
+	"\x04diff\x18\x06 \x01(\tR\x04diff\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"\xb6\x01\n" +
+	"\x14ReconciliationRecord\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\tR\brevision\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\tR\ttimestamp\x12\x1a\n" +
+	"\bduration\x18\x06 \x01(\tR\bduration\"\xc3\x01\n" +
 	"\x05Event\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
@@ -1262,7 +2888,108 @@ const file_api_core_types_proto_rawDesc = "" +
 	"\tcomponent\x18\x05 \x01(\tR\tcomponent\x12\x12\n" +
 	"\x04host\x18\x06 \x01(\tR\x04host\x12\x12\n" +
 	"\x04name\x18\a \x01(\tR\x04name\x12\x10\n" +
-	"\x03uid\x18\b \x01(\tR\x03uid*\xfb\x01\n" +
+	"\x03uid\x18\b \x01(\tR\x03uid\"\xeb\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x1c\n" +
+	"\tprincipal\x18\x03 \x01(\tR\tprincipal\x12\x16\n" +
+	"\x06groups\x18\x04 \x03(\tR\x06groups\x12!\n" +
+	"\fcluster_name\x18\x05 \x01(\tR\vclusterName\x12\x1f\n" +
+	"\vapi_version\x18\x06 \x01(\tR\n" +
+	"apiVersion\x12\x12\n" +
+	"\x04kind\x18\a \x01(\tR\x04kind\x12\x1c\n" +
+	"\tnamespace\x18\b \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\t \x01(\tR\x04name\x12\x16\n" +
+	"\x06action\x18\n" +
+	" \x01(\tR\x06action\x12\x18\n" +
+	"\acomment\x18\v \x01(\tR\acomment\x12\x18\n" +
+	"\aoutcome\x18\f \x01(\tR\aoutcome\x12\x14\n" +
+	"\x05error\x18\r \x01(\tR\x05error\"\xd4\x01\n" +
+	"\bAPIToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x16\n" +
+	"\x06groups\x18\x04 \x03(\tR\x06groups\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\x12 \n" +
+	"\flast_used_at\x18\b \x01(\tR\n" +
+	"lastUsedAt\"k\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\tR\x04user\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\"\xf7\x01\n" +
+	"\fSearchResult\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05ready\x18\x05 \x01(\tR\x05ready\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x128\n" +
+	"\n" +
+	"source_ref\x18\a \x01(\v2\x19.gitops_core.v1.ObjectRefR\tsourceRef\x12\x14\n" +
+	"\x05score\x18\b \x01(\x05R\x05score\"\xa2\x01\n" +
+	"\x10OwningAutomation\x129\n" +
+	"\n" +
+	"automation\x18\x01 \x01(\v2\x19.gitops_core.v1.ObjectRefR\n" +
+	"automation\x128\n" +
+	"\n" +
+	"source_ref\x18\x02 \x01(\v2\x19.gitops_core.v1.ObjectRefR\tsourceRef\x12\x19\n" +
+	"\bfound_by\x18\x03 \x01(\tR\afoundBy\"\xd8\x01\n" +
+	"\vObjectDrift\x12\x1f\n" +
+	"\vapi_version\x18\x01 \x01(\tR\n" +
+	"apiVersion\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x18\n" +
+	"\adeleted\x18\x05 \x01(\bR\adeleted\x122\n" +
+	"\x06fields\x18\x06 \x03(\v2\x1a.gitops_core.v1.FieldDriftR\x06fields\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"N\n" +
+	"\n" +
+	"FieldDrift\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\aapplied\x18\x02 \x01(\tR\aapplied\x12\x12\n" +
+	"\x04live\x18\x03 \x01(\tR\x04live\"\xd3\x02\n" +
+	"\x13HelmReleaseRevision\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"chart_name\x18\x02 \x01(\tR\tchartName\x12#\n" +
+	"\rchart_version\x18\x03 \x01(\tR\fchartVersion\x12\x1f\n" +
+	"\vapp_version\x18\x04 \x01(\tR\n" +
+	"appVersion\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12%\n" +
+	"\x0efirst_deployed\x18\a \x01(\tR\rfirstDeployed\x12#\n" +
+	"\rlast_deployed\x18\b \x01(\tR\flastDeployed\x12\x16\n" +
+	"\x06values\x18\t \x01(\tR\x06values\x12\x1f\n" +
+	"\vvalues_diff\x18\n" +
+	" \x01(\tR\n" +
+	"valuesDiff\"6\n" +
+	"\fArtifactFile\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\"\xcc\x03\n" +
+	"\x0fTerraformObject\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x128\n" +
+	"\n" +
+	"source_ref\x18\x04 \x01(\v2\x19.gitops_core.v1.ObjectRefR\tsourceRef\x12\x1c\n" +
+	"\tsuspended\x18\x05 \x01(\bR\tsuspended\x12\x14\n" +
+	"\x05ready\x18\x06 \x01(\tR\x05ready\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x122\n" +
+	"\x15last_applied_revision\x18\b \x01(\tR\x13lastAppliedRevision\x122\n" +
+	"\x15last_planned_revision\x18\t \x01(\tR\x13lastPlannedRevision\x12!\n" +
+	"\fpending_plan\x18\n" +
+	" \x01(\tR\vpendingPlan\x12!\n" +
+	"\fapprove_plan\x18\v \x01(\tR\vapprovePlan\x12.\n" +
+	"\x13store_readable_plan\x18\f \x01(\tR\x11storeReadablePlan*\xfb\x01\n" +
 	"\x04Kind\x12\x11\n" +
 	"\rGitRepository\x10\x00\x12\n" +
 	"\n" +
@@ -1299,43 +3026,73 @@ func file_api_core_types_proto_rawDescGZIP() []byte {
 }
 
 var file_api_core_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_core_types_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_api_core_types_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_api_core_types_proto_goTypes = []any{
 	(Kind)(0),                         // 0: gitops_core.v1.Kind
 	(HelmRepositoryType)(0),           // 1: gitops_core.v1.HelmRepositoryType
 	(*Interval)(nil),                  // 2: gitops_core.v1.Interval
 	(*ObjectRef)(nil),                 // 3: gitops_core.v1.ObjectRef
-	(*Condition)(nil),                 // 4: gitops_core.v1.Condition
-	(*GitRepositoryRef)(nil),          // 5: gitops_core.v1.GitRepositoryRef
-	(*GroupVersionKind)(nil),          // 6: gitops_core.v1.GroupVersionKind
-	(*NamespacedObjectReference)(nil), // 7: gitops_core.v1.NamespacedObjectReference
-	(*HealthStatus)(nil),              // 8: gitops_core.v1.HealthStatus
-	(*InventoryEntry)(nil),            // 9: gitops_core.v1.InventoryEntry
-	(*Object)(nil),                    // 10: gitops_core.v1.Object
-	(*Deployment)(nil),                // 11: gitops_core.v1.Deployment
-	(*Crd)(nil),                       // 12: gitops_core.v1.Crd
-	(*Namespace)(nil),                 // 13: gitops_core.v1.Namespace
-	(*Event)(nil),                     // 14: gitops_core.v1.Event
-	nil,                               // 15: gitops_core.v1.Deployment.LabelsEntry
-	(*Crd_Name)(nil),                  // 16: gitops_core.v1.Crd.Name
-	nil,                               // 17: gitops_core.v1.Namespace.AnnotationsEntry
-	nil,                               // 18: gitops_core.v1.Namespace.LabelsEntry
+	(*ObjectResult)(nil),              // 4: gitops_core.v1.ObjectResult
+	(*Condition)(nil),                 // 5: gitops_core.v1.Condition
+	(*GitRepositoryRef)(nil),          // 6: gitops_core.v1.GitRepositoryRef
+	(*GroupVersionKind)(nil),          // 7: gitops_core.v1.GroupVersionKind
+	(*NamespacedObjectReference)(nil), // 8: gitops_core.v1.NamespacedObjectReference
+	(*HealthStatus)(nil),              // 9: gitops_core.v1.HealthStatus
+	(*InventoryEntry)(nil),            // 10: gitops_core.v1.InventoryEntry
+	(*HealthSummary)(nil),             // 11: gitops_core.v1.HealthSummary
+	(*HealthOffender)(nil),            // 12: gitops_core.v1.HealthOffender
+	(*Object)(nil),                    // 13: gitops_core.v1.Object
+	(*Deployment)(nil),                // 14: gitops_core.v1.Deployment
+	(*Crd)(nil),                       // 15: gitops_core.v1.Crd
+	(*Namespace)(nil),                 // 16: gitops_core.v1.Namespace
+	(*DependencyNode)(nil),            // 17: gitops_core.v1.DependencyNode
+	(*DependencyEdge)(nil),            // 18: gitops_core.v1.DependencyEdge
+	(*DependencyCycle)(nil),           // 19: gitops_core.v1.DependencyCycle
+	(*ObjectDiff)(nil),                // 20: gitops_core.v1.ObjectDiff
+	(*ReconciliationRecord)(nil),      // 21: gitops_core.v1.ReconciliationRecord
+	(*Event)(nil),                     // 22: gitops_core.v1.Event
+	(*AuditEvent)(nil),                // 23: gitops_core.v1.AuditEvent
+	(*APIToken)(nil),                  // 24: gitops_core.v1.APIToken
+	(*Session)(nil),                   // 25: gitops_core.v1.Session
+	(*SearchResult)(nil),              // 26: gitops_core.v1.SearchResult
+	(*OwningAutomation)(nil),          // 27: gitops_core.v1.OwningAutomation
+	(*ObjectDrift)(nil),               // 28: gitops_core.v1.ObjectDrift
+	(*FieldDrift)(nil),                // 29: gitops_core.v1.FieldDrift
+	(*HelmReleaseRevision)(nil),       // 30: gitops_core.v1.HelmReleaseRevision
+	(*ArtifactFile)(nil),              // 31: gitops_core.v1.ArtifactFile
+	(*TerraformObject)(nil),           // 32: gitops_core.v1.TerraformObject
+	nil,                               // 33: gitops_core.v1.HealthSummary.CountsEntry
+	nil,                               // 34: gitops_core.v1.Deployment.LabelsEntry
+	(*Crd_Name)(nil),                  // 35: gitops_core.v1.Crd.Name
+	nil,                               // 36: gitops_core.v1.Namespace.AnnotationsEntry
+	nil,                               // 37: gitops_core.v1.Namespace.LabelsEntry
 }
 var file_api_core_types_proto_depIdxs = []int32{
-	8,  // 0: gitops_core.v1.InventoryEntry.health:type_name -> gitops_core.v1.HealthStatus
-	9,  // 1: gitops_core.v1.InventoryEntry.children:type_name -> gitops_core.v1.InventoryEntry
-	6,  // 2: gitops_core.v1.Object.inventory:type_name -> gitops_core.v1.GroupVersionKind
-	8,  // 3: gitops_core.v1.Object.health:type_name -> gitops_core.v1.HealthStatus
-	4,  // 4: gitops_core.v1.Deployment.conditions:type_name -> gitops_core.v1.Condition
-	15, // 5: gitops_core.v1.Deployment.labels:type_name -> gitops_core.v1.Deployment.LabelsEntry
-	16, // 6: gitops_core.v1.Crd.name:type_name -> gitops_core.v1.Crd.Name
-	17, // 7: gitops_core.v1.Namespace.annotations:type_name -> gitops_core.v1.Namespace.AnnotationsEntry
-	18, // 8: gitops_core.v1.Namespace.labels:type_name -> gitops_core.v1.Namespace.LabelsEntry
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	3,  // 0: gitops_core.v1.ObjectResult.object:type_name -> gitops_core.v1.ObjectRef
+	9,  // 1: gitops_core.v1.InventoryEntry.health:type_name -> gitops_core.v1.HealthStatus
+	10, // 2: gitops_core.v1.InventoryEntry.children:type_name -> gitops_core.v1.InventoryEntry
+	9,  // 3: gitops_core.v1.InventoryEntry.rollup_health:type_name -> gitops_core.v1.HealthStatus
+	33, // 4: gitops_core.v1.HealthSummary.counts:type_name -> gitops_core.v1.HealthSummary.CountsEntry
+	12, // 5: gitops_core.v1.HealthSummary.offenders:type_name -> gitops_core.v1.HealthOffender
+	3,  // 6: gitops_core.v1.HealthOffender.object:type_name -> gitops_core.v1.ObjectRef
+	9,  // 7: gitops_core.v1.HealthOffender.health:type_name -> gitops_core.v1.HealthStatus
+	7,  // 8: gitops_core.v1.Object.inventory:type_name -> gitops_core.v1.GroupVersionKind
+	9,  // 9: gitops_core.v1.Object.health:type_name -> gitops_core.v1.HealthStatus
+	5,  // 10: gitops_core.v1.Deployment.conditions:type_name -> gitops_core.v1.Condition
+	34, // 11: gitops_core.v1.Deployment.labels:type_name -> gitops_core.v1.Deployment.LabelsEntry
+	35, // 12: gitops_core.v1.Crd.name:type_name -> gitops_core.v1.Crd.Name
+	36, // 13: gitops_core.v1.Namespace.annotations:type_name -> gitops_core.v1.Namespace.AnnotationsEntry
+	37, // 14: gitops_core.v1.Namespace.labels:type_name -> gitops_core.v1.Namespace.LabelsEntry
+	3,  // 15: gitops_core.v1.SearchResult.source_ref:type_name -> gitops_core.v1.ObjectRef
+	3,  // 16: gitops_core.v1.OwningAutomation.automation:type_name -> gitops_core.v1.ObjectRef
+	3,  // 17: gitops_core.v1.OwningAutomation.source_ref:type_name -> gitops_core.v1.ObjectRef
+	29, // 18: gitops_core.v1.ObjectDrift.fields:type_name -> gitops_core.v1.FieldDrift
+	3,  // 19: gitops_core.v1.TerraformObject.source_ref:type_name -> gitops_core.v1.ObjectRef
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_api_core_types_proto_init() }
@@ -1349,7 +3106,7 @@ func file_api_core_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_core_types_proto_rawDesc), len(file_api_core_types_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   17,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
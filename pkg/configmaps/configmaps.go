@@ -0,0 +1,55 @@
+// Package configmaps loads configuration from ConfigMaps, and reloads it
+// periodically so it can be changed without restarting.
+package configmaps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReloadFrequency is how often Watch reloads a ConfigMap.
+const ReloadFrequency = 30 * time.Second
+
+// Loader applies the configuration held in a ConfigMap.
+type Loader interface {
+	// LoadConfigMap replaces the configuration with the one in the
+	// ConfigMap, or removes it all if the ConfigMap is nil as it doesn't
+	// exist.
+	LoadConfigMap(cm *corev1.ConfigMap) error
+}
+
+// Load gets the ConfigMap and passes it to the loader, or nil if it doesn't
+// exist.
+func Load(ctx context.Context, c client.Client, key client.ObjectKey, loader Loader) error {
+	cm := &corev1.ConfigMap{}
+
+	if err := c.Get(ctx, key, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("getting configmap %s: %w", key, err)
+		}
+
+		cm = nil
+	}
+
+	return loader.LoadConfigMap(cm)
+}
+
+// Watch loads the ConfigMap right away and then every ReloadFrequency, until
+// the context is cancelled. Errors are logged, leaving the loader's current
+// configuration in place.
+func Watch(ctx context.Context, log logr.Logger, c client.Client, key client.ObjectKey, loader Loader) {
+	_ = wait.PollUntilContextCancel(ctx, ReloadFrequency, true, func(ctx context.Context) (bool, error) {
+		if err := Load(ctx, c, key, loader); err != nil {
+			log.Error(err, "unable to load configmap", "configmap", key)
+		}
+
+		return false, nil
+	})
+}
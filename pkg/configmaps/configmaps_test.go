@@ -0,0 +1,64 @@
+package configmaps_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/weaveworks/weave-gitops/pkg/configmaps"
+)
+
+type recordingLoader struct {
+	loaded []*corev1.ConfigMap
+}
+
+func (l *recordingLoader) LoadConfigMap(cm *corev1.ConfigMap) error {
+	l.loaded = append(l.loaded, cm)
+
+	return nil
+}
+
+func TestLoad(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "flux-system"},
+		Data:       map[string]string{"config.yaml": "- name: test"},
+	}
+	c := fake.NewClientBuilder().WithObjects(cm).Build()
+	loader := &recordingLoader{}
+
+	g.Expect(configmaps.Load(ctx, c, client.ObjectKeyFromObject(cm), loader)).To(Succeed())
+	g.Expect(loader.loaded).To(HaveLen(1))
+	g.Expect(loader.loaded[0].Data).To(Equal(cm.Data))
+
+	// A missing ConfigMap is loaded as nil, to remove the configuration
+	g.Expect(c.Delete(ctx, cm)).To(Succeed())
+	g.Expect(configmaps.Load(ctx, c, client.ObjectKeyFromObject(cm), loader)).To(Succeed())
+	g.Expect(loader.loaded).To(HaveLen(2))
+	g.Expect(loader.loaded[1]).To(BeNil())
+}
+
+func TestLoadGetError(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			return errors.New("forbidden")
+		},
+	}).Build()
+	loader := &recordingLoader{}
+
+	err := configmaps.Load(context.Background(), c, client.ObjectKey{Namespace: "flux-system", Name: "config"}, loader)
+	g.Expect(err).To(MatchError(ContainSubstring("forbidden")))
+	// The loader keeps its configuration
+	g.Expect(loader.loaded).To(BeEmpty())
+}
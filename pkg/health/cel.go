@@ -0,0 +1,207 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/fluxcd/pkg/runtime/cel"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// Rule checks the health of the objects of a GroupVersionKind with CEL
+// expressions, evaluated against the whole object, e.g.
+// `status.phase == 'Healthy'`.
+//
+// When the object's status.observedGeneration differs from its
+// metadata.generation it's Progressing. Otherwise the first of Progressing,
+// Unhealthy and Healthy that's true decides the status, and Progressing if
+// none are.
+type Rule struct {
+	APIVersion  string `json:"apiVersion"`
+	Kind        string `json:"kind"`
+	Healthy     string `json:"healthy"`
+	Progressing string `json:"progressing,omitempty"`
+	Unhealthy   string `json:"unhealthy,omitempty"`
+	// Message is an expression returning the status message.
+	Message string `json:"message,omitempty"`
+}
+
+// ParseRules reads the rules from all the keys of a ConfigMap, each of which
+// holds a YAML list of rules.
+func ParseRules(cm *corev1.ConfigMap) ([]Rule, error) {
+	keys := []string{}
+	for k := range cm.Data {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	rules := []Rule{}
+
+	for _, k := range keys {
+		var keyRules []Rule
+		if err := yaml.UnmarshalStrict([]byte(cm.Data[k]), &keyRules); err != nil {
+			return nil, fmt.Errorf("parsing rules in %s: %w", k, err)
+		}
+
+		rules = append(rules, keyRules...)
+	}
+
+	return rules, nil
+}
+
+type celRule struct {
+	healthy     *cel.Expression
+	progressing *cel.Expression
+	unhealthy   *cel.Expression
+	message     *cel.Expression
+}
+
+func newCELRule(rule Rule) (*celRule, error) {
+	if rule.Healthy == "" {
+		return nil, fmt.Errorf("no healthy expression for %s %s", rule.APIVersion, rule.Kind)
+	}
+
+	r := &celRule{}
+
+	for _, e := range []struct {
+		expr string
+		dest **cel.Expression
+	}{
+		{rule.Healthy, &r.healthy},
+		{rule.Progressing, &r.progressing},
+		{rule.Unhealthy, &r.unhealthy},
+		{rule.Message, &r.message},
+	} {
+		if e.expr == "" {
+			continue
+		}
+
+		expr, err := cel.NewExpression(e.expr)
+		if err != nil {
+			return nil, fmt.Errorf("rule for %s %s: %w", rule.APIVersion, rule.Kind, err)
+		}
+
+		*e.dest = expr
+	}
+
+	return r, nil
+}
+
+func (r *celRule) check(ctx context.Context, obj unstructured.Unstructured) (HealthStatus, error) {
+	content := obj.UnstructuredContent()
+
+	message := ""
+	if r.message != nil {
+		// The message is best effort, e.g. the field may not be set yet
+		message, _ = r.message.EvaluateString(ctx, content)
+	}
+
+	observedGeneration, found, _ := unstructured.NestedInt64(content, "status", "observedGeneration")
+	if found && observedGeneration != obj.GetGeneration() {
+		return HealthStatus{Status: HealthStatusProgressing, Message: "waiting spec to be observed"}, nil
+	}
+
+	for _, e := range []struct {
+		expr   *cel.Expression
+		status HealthStatusCode
+	}{
+		{r.progressing, HealthStatusProgressing},
+		{r.unhealthy, HealthStatusUnhealthy},
+		{r.healthy, HealthStatusHealthy},
+	} {
+		if e.expr == nil {
+			continue
+		}
+
+		// Evaluation errors, e.g. fields that aren't set yet, don't fail
+		// the inventory the object is part of
+		result, err := e.expr.EvaluateBoolean(ctx, content)
+		if err != nil {
+			return HealthStatus{Status: HealthStatusUnknown, Message: err.Error()}, nil
+		}
+
+		if result {
+			return HealthStatus{Status: e.status, Message: message}, nil
+		}
+	}
+
+	return HealthStatus{Status: HealthStatusProgressing, Message: message}, nil
+}
+
+// CELHealthChecker checks objects with the CEL rules of their GroupVersionKind,
+// falling back to the built-in checks for the objects without one.
+type CELHealthChecker struct {
+	defaults HealthChecker
+
+	mu    sync.RWMutex
+	rules map[schema.GroupVersionKind]*celRule
+}
+
+// NewCELHealthChecker returns a CELHealthChecker without any rules, which
+// checks everything with the defaults.
+func NewCELHealthChecker(defaults HealthChecker) *CELHealthChecker {
+	return &CELHealthChecker{
+		defaults: defaults,
+		rules:    map[schema.GroupVersionKind]*celRule{},
+	}
+}
+
+// SetRules replaces all the rules. If any rule is invalid the current rules
+// are kept.
+func (hc *CELHealthChecker) SetRules(rules []Rule) error {
+	compiled := map[schema.GroupVersionKind]*celRule{}
+
+	for _, rule := range rules {
+		gv, err := schema.ParseGroupVersion(rule.APIVersion)
+		if err != nil {
+			return fmt.Errorf("rule for %s %s: %w", rule.APIVersion, rule.Kind, err)
+		}
+
+		r, err := newCELRule(rule)
+		if err != nil {
+			return err
+		}
+
+		compiled[gv.WithKind(rule.Kind)] = r
+	}
+
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+
+	hc.rules = compiled
+
+	return nil
+}
+
+func (hc *CELHealthChecker) Check(obj unstructured.Unstructured) (HealthStatus, error) {
+	hc.mu.RLock()
+	rule, ok := hc.rules[obj.GroupVersionKind()]
+	hc.mu.RUnlock()
+
+	if !ok {
+		return hc.defaults.Check(obj)
+	}
+
+	return rule.check(context.Background(), obj)
+}
+
+// LoadConfigMap replaces the rules with the ones in the ConfigMap, or
+// removes them all if it's nil. It's a configmaps.Loader.
+func (hc *CELHealthChecker) LoadConfigMap(cm *corev1.ConfigMap) error {
+	if cm == nil {
+		return hc.SetRules(nil)
+	}
+
+	rules, err := ParseRules(cm)
+	if err != nil {
+		return err
+	}
+
+	return hc.SetRules(rules)
+}
@@ -0,0 +1,110 @@
+package health
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	"github.com/weaveworks/weave-gitops/pkg/configmaps"
+)
+
+const rolloutRules = `
+- apiVersion: argoproj.io/v1alpha1
+  kind: Rollout
+  healthy: "status.phase == 'Healthy'"
+  progressing: "status.phase == 'Progressing'"
+  unhealthy: "status.phase == 'Degraded'"
+  message: "status.message"
+`
+
+func loadTestObject(g *WithT, path string) unstructured.Unstructured {
+	yamlBytes, err := os.ReadFile(path)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var obj unstructured.Unstructured
+	g.Expect(yaml.Unmarshal(yamlBytes, &obj)).To(Succeed())
+
+	return obj
+}
+
+func TestCELHealthCheck(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	hc := NewCELHealthChecker(NewHealthChecker())
+
+	rules, err := ParseRules(&corev1.ConfigMap{Data: map[string]string{"rollouts.yaml": rolloutRules}})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hc.SetRules(rules)).To(Succeed())
+
+	healthy := loadTestObject(g, "testdata/rollout-healthy.yaml")
+
+	status, err := hc.Check(healthy)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status.Status).To(Equal(HealthStatusHealthy))
+
+	degraded := loadTestObject(g, "testdata/rollout-degraded.yaml")
+
+	status, err = hc.Check(degraded)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status.Status).To(Equal(HealthStatusUnhealthy))
+	g.Expect(status.Message).To(ContainSubstring("ProgressDeadlineExceeded"))
+
+	// The spec hasn't been observed yet
+	degraded.SetGeneration(3)
+
+	status, err = hc.Check(degraded)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status.Status).To(Equal(HealthStatusProgressing))
+
+	// The built-in checks are used for the kinds without rules
+	status, err = hc.Check(loadTestObject(g, "testdata/deployment-unhealthy.yaml"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status.Status).To(Equal(HealthStatusUnhealthy))
+
+	// Invalid rules keep the current ones
+	g.Expect(hc.SetRules([]Rule{{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Healthy: "status.phase =="}})).NotTo(Succeed())
+
+	status, err = hc.Check(healthy)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status.Status).To(Equal(HealthStatusHealthy))
+}
+
+func TestCELHealthCheckLoadConfigMap(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "health-checks", Namespace: "flux-system"},
+		Data:       map[string]string{"rollouts.yaml": rolloutRules},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm).Build()
+
+	hc := NewCELHealthChecker(NewHealthChecker())
+	rollout := loadTestObject(g, "testdata/rollout-degraded.yaml")
+
+	g.Expect(configmaps.Load(ctx, c, client.ObjectKeyFromObject(cm), hc)).To(Succeed())
+
+	status, err := hc.Check(rollout)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status.Status).To(Equal(HealthStatusUnhealthy))
+
+	// Removing the ConfigMap removes the rules
+	g.Expect(c.Delete(ctx, cm)).To(Succeed())
+	g.Expect(configmaps.Load(ctx, c, client.ObjectKeyFromObject(cm), hc)).To(Succeed())
+
+	status, err = hc.Check(rollout)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status.Status).NotTo(Equal(HealthStatusUnhealthy))
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	tfv1alpha2 "github.com/flux-iac/tofu-controller/api/v1alpha2"
 	helmv2 "github.com/fluxcd/helm-controller/api/v2"
 	imgautomationv1 "github.com/fluxcd/image-automation-controller/api/v1beta2"
 	reflectorv1 "github.com/fluxcd/image-reflector-controller/api/v1beta2"
@@ -46,6 +47,7 @@ func CreateScheme() (*apiruntime.Scheme, error) {
 		pacv2beta2.AddToScheme,
 		reflectorv1.AddToScheme,
 		imgautomationv1.AddToScheme,
+		tfv1alpha2.AddToScheme,
 	}
 
 	err := builder.AddToScheme(scheme)
@@ -0,0 +1,186 @@
+package kustomizediff
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	sourcev1b2 "github.com/fluxcd/source-controller/api/v1beta2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxArtifactSize is the maximum size of an extracted artifact.
+const maxArtifactSize = 100 << 20
+
+// Artifact is the latest artifact of the source of a Kustomization.
+type Artifact struct {
+	URL      string
+	Revision string
+}
+
+// GetArtifact returns the latest artifact of the source the Kustomization refers to.
+func GetArtifact(ctx context.Context, c client.Client, ks *kustomizev1.Kustomization) (*Artifact, error) {
+	ref := ks.Spec.SourceRef
+
+	gv := sourcev1.GroupVersion
+	if ref.Kind == sourcev1b2.OCIRepositoryKind {
+		gv = sourcev1b2.GroupVersion
+	}
+
+	if ref.APIVersion != "" {
+		var err error
+
+		gv, err = schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source apiVersion %q: %w", ref.APIVersion, err)
+		}
+	}
+
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = ks.Namespace
+	}
+
+	source := &unstructured.Unstructured{}
+	source.SetGroupVersionKind(gv.WithKind(ref.Kind))
+
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, source); err != nil {
+		return nil, fmt.Errorf("failed to get source %s %s/%s: %w", ref.Kind, namespace, ref.Name, err)
+	}
+
+	artifactURL, _, _ := unstructured.NestedString(source.Object, "status", "artifact", "url")
+	revision, _, _ := unstructured.NestedString(source.Object, "status", "artifact", "revision")
+
+	if artifactURL == "" {
+		return nil, fmt.Errorf("source %s %s/%s has no artifact", ref.Kind, namespace, ref.Name)
+	}
+
+	return &Artifact{URL: artifactURL, Revision: revision}, nil
+}
+
+// ServiceProxyURL rewrites an artifact URL pointing at the source-controller
+// service, e.g. http://source-controller.flux-system.svc.cluster.local./path,
+// to go through the API server service proxy, so it can be fetched from outside
+// the cluster with the HTTP client for cfg.
+func ServiceProxyURL(cfg *rest.Config, artifactURL string) (string, error) {
+	u, err := url.Parse(artifactURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid artifact url %q: %w", artifactURL, err)
+	}
+
+	parts := strings.Split(u.Hostname(), ".")
+	if len(parts) < 2 {
+		return "", fmt.Errorf("artifact url %q is not a service address", artifactURL)
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "80"
+	}
+
+	host, err := url.Parse(cfg.Host)
+	if err != nil {
+		return "", fmt.Errorf("invalid API server address %q: %w", cfg.Host, err)
+	}
+
+	host.Path = path.Join(host.Path, "api/v1/namespaces", parts[1], "services", net.JoinHostPort(parts[0], port), "proxy", u.Path)
+	host.RawQuery = u.RawQuery
+
+	return host.String(), nil
+}
+
+// FetchArtifact downloads the tarball at artifactURL and extracts it into dir.
+func FetchArtifact(ctx context.Context, httpClient *http.Client, artifactURL, dir string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, artifactURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch artifact: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch artifact from %s: %s", artifactURL, res.Status)
+	}
+
+	return untar(res.Body, dir)
+}
+
+func untar(r io.Reader, dir string) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("failed to read artifact: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+
+	var total int64
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to read artifact: %w", err)
+		}
+
+		target, err := securejoin.SecureJoin(dir, header.Name)
+		if err != nil {
+			return fmt.Errorf("invalid path %q in artifact: %w", header.Name, err)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o750); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			total += header.Size
+			if total > maxArtifactSize {
+				return fmt.Errorf("artifact is larger than %d bytes", maxArtifactSize)
+			}
+
+			if err := writeFile(target, tr, header.Size); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func writeFile(target string, r io.Reader, size int64) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.CopyN(f, r, size); err != nil {
+		return fmt.Errorf("failed to extract %s: %w", target, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package kustomizediff_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/kustomizediff"
+)
+
+func TestGetArtifact(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "flux-system"},
+		Status: sourcev1.GitRepositoryStatus{
+			Artifact: &sourcev1.Artifact{
+				URL:      "http://source-controller.flux-system.svc.cluster.local./gitrepository/flux-system/podinfo/abc.tar.gz",
+				Revision: "main@sha1:abc",
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(repo).Build()
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "flux-system"},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: "podinfo"},
+		},
+	}
+
+	artifact, err := kustomizediff.GetArtifact(context.Background(), c, ks)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(artifact.URL).To(Equal(repo.Status.Artifact.URL))
+	g.Expect(artifact.Revision).To(Equal("main@sha1:abc"))
+
+	ks.Spec.SourceRef.Name = "missing"
+	_, err = kustomizediff.GetArtifact(context.Background(), c, ks)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestServiceProxyURL(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	u, err := kustomizediff.ServiceProxyURL(&rest.Config{Host: "https://127.0.0.1:6443"},
+		"http://source-controller.flux-system.svc.cluster.local./gitrepository/flux-system/podinfo/abc.tar.gz")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(u).To(Equal("https://127.0.0.1:6443/api/v1/namespaces/flux-system/services/source-controller:80/proxy/gitrepository/flux-system/podinfo/abc.tar.gz"))
+
+	_, err = kustomizediff.ServiceProxyURL(&rest.Config{Host: "https://127.0.0.1:6443"}, "http://localhost/abc.tar.gz")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestFetchArtifact(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	artifact := makeTarball(g, map[string]string{
+		"deploy/configmap.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\n",
+		"../escape.yaml":        "outside",
+	})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/artifact.tar.gz" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write(artifact)
+	}))
+	defer srv.Close()
+
+	dir := filepath.Join(t.TempDir(), "artifact")
+
+	g.Expect(kustomizediff.FetchArtifact(context.Background(), srv.Client(), srv.URL+"/artifact.tar.gz", dir)).To(Succeed())
+	g.Expect(filepath.Join(dir, "deploy", "configmap.yaml")).To(BeARegularFile())
+	// Paths are kept inside the directory
+	g.Expect(filepath.Join(dir, "escape.yaml")).To(BeARegularFile())
+	g.Expect(filepath.Join(dir, "..", "escape.yaml")).NotTo(BeAnExistingFile())
+
+	g.Expect(kustomizediff.FetchArtifact(context.Background(), srv.Client(), srv.URL+"/missing.tar.gz", dir)).NotTo(Succeed())
+}
+
+func makeTarball(g *WithT, files map[string]string) []byte {
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for name, content := range files {
+		g.Expect(tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})).To(Succeed())
+
+		_, err := tw.Write([]byte(content))
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+
+	g.Expect(tw.Close()).To(Succeed())
+	g.Expect(gz.Close()).To(Succeed())
+
+	return buf.Bytes()
+}
+
+func writeFiles(g *WithT, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		g.Expect(os.MkdirAll(filepath.Dir(path), 0o750)).To(Succeed())
+		g.Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+	}
+}
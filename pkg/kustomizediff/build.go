@@ -0,0 +1,280 @@
+package kustomizediff
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/yaml"
+)
+
+// Labels kustomize-controller adds to the objects it applies.
+const (
+	nameLabel      = "kustomize.toolkit.fluxcd.io/name"
+	namespaceLabel = "kustomize.toolkit.fluxcd.io/namespace"
+)
+
+// artifactRoot is where the artifact is copied to in the in-memory
+// filesystem the manifests are built in. The overlay is written above it.
+const artifactRoot = "/artifact"
+
+// Build builds the manifests of the Kustomization from an extracted artifact in
+// dir, the same way kustomize-controller does. Post build variable substitution and
+// decryption are not supported.
+//
+// The manifests are built from a copy of the artifact in memory, with
+// kustomize restricted to loading files below each kustomization, so the
+// artifact can't read files of the machine building it.
+func Build(ks *kustomizev1.Kustomization, dir string) ([]*unstructured.Unstructured, error) {
+	fSys, err := loadArtifact(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// Joining to / first cleans the path so it can't leave the artifact
+	root := filepath.Join(artifactRoot, filepath.Join("/", ks.Spec.Path))
+
+	if !fSys.Exists(root) {
+		return nil, fmt.Errorf("path %q not found in artifact", ks.Spec.Path)
+	}
+
+	// The path is included as a whole if it has a kustomization file,
+	// otherwise all the manifests below it are.
+	resources := []string{"."}
+
+	if !hasKustomization(fSys, root) {
+		resources, err = findResources(fSys, root)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The overlay can't be below root, kustomize would see a cycle.
+	overlay := "/"
+
+	if err := writeOverlay(fSys, ks, overlay, root, resources); err != nil {
+		return nil, err
+	}
+
+	k := krusty.MakeKustomizer(&krusty.Options{
+		LoadRestrictions: types.LoadRestrictionsRootOnly,
+		PluginConfig:     types.DisabledPluginConfig(),
+	})
+
+	resMap, err := k.Run(fSys, overlay)
+	if err != nil {
+		return nil, fmt.Errorf("kustomize build failed: %w", err)
+	}
+
+	objects := []*unstructured.Unstructured{}
+
+	for _, res := range resMap.Resources() {
+		m, err := res.Map()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", res.CurId(), err)
+		}
+
+		objects = append(objects, &unstructured.Unstructured{Object: m})
+	}
+
+	return objects, nil
+}
+
+// loadArtifact copies the files in dir to artifactRoot in an in-memory
+// filesystem. Symlinks are resolved within dir, and left out if they point
+// to something else than a file.
+func loadArtifact(dir string) (filesys.FileSystem, error) {
+	fSys := filesys.MakeFsInMemory()
+
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(artifactRoot, rel)
+
+		if d.IsDir() {
+			return fSys.MkdirAll(target)
+		}
+
+		source := path
+
+		if d.Type()&os.ModeSymlink != 0 {
+			source, err = securejoin.SecureJoin(dir, rel)
+			if err != nil {
+				return fmt.Errorf("invalid symlink %q in artifact: %w", rel, err)
+			}
+
+			if info, err := os.Stat(source); err != nil || !info.Mode().IsRegular() {
+				return nil
+			}
+		} else if !d.Type().IsRegular() {
+			return nil
+		}
+
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return err
+		}
+
+		return fSys.WriteFile(target, data)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact: %w", err)
+	}
+
+	return fSys, nil
+}
+
+func hasKustomization(fSys filesys.FileSystem, dir string) bool {
+	for _, name := range konfig.RecognizedKustomizationFileNames() {
+		if fSys.Exists(filepath.Join(dir, name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findResources returns the manifests below dir, relative to it, like the
+// kustomization kustomize-controller generates. Directories that have a
+// kustomization file of their own are included as a whole.
+func findResources(fSys filesys.FileSystem, dir string) ([]string, error) {
+	resources := []string{}
+
+	err := fSys.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if path != dir && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+
+			if path != dir && hasKustomization(fSys, path) {
+				rel, err := filepath.Rel(dir, path)
+				if err != nil {
+					return err
+				}
+
+				resources = append(resources, filepath.ToSlash(rel))
+
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		ext := filepath.Ext(path)
+		if ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		resources = append(resources, filepath.ToSlash(rel))
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find manifests: %w", err)
+	}
+
+	return resources, nil
+}
+
+// writeOverlay writes a kustomization to overlay that applies the
+// transformations from the Kustomization spec to the resources in root.
+// Nothing is written to root, so the artifact's own kustomization is kept.
+func writeOverlay(fSys filesys.FileSystem, ks *kustomizev1.Kustomization, overlay, root string, resources []string) error {
+	rel, err := filepath.Rel(overlay, root)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{}
+	for _, r := range resources {
+		paths = append(paths, filepath.ToSlash(filepath.Join(rel, r)))
+	}
+
+	labels := map[string]string{
+		nameLabel:      ks.Name,
+		namespaceLabel: ks.Namespace,
+	}
+
+	kus := map[string]any{
+		"apiVersion": types.KustomizationVersion,
+		"kind":       types.KustomizationKind,
+		"resources":  paths,
+	}
+
+	if ks.Spec.TargetNamespace != "" {
+		kus["namespace"] = ks.Spec.TargetNamespace
+	}
+
+	if ks.Spec.NamePrefix != "" {
+		kus["namePrefix"] = ks.Spec.NamePrefix
+	}
+
+	if ks.Spec.NameSuffix != "" {
+		kus["nameSuffix"] = ks.Spec.NameSuffix
+	}
+
+	if len(ks.Spec.Patches) > 0 {
+		kus["patches"] = ks.Spec.Patches
+	}
+
+	if len(ks.Spec.Images) > 0 {
+		kus["images"] = ks.Spec.Images
+	}
+
+	if len(ks.Spec.Components) > 0 {
+		components := []string{}
+
+		for _, c := range ks.Spec.Components {
+			components = append(components, filepath.ToSlash(filepath.Join(rel, c)))
+		}
+
+		kus["components"] = components
+	}
+
+	if ks.Spec.CommonMetadata != nil {
+		for k, v := range ks.Spec.CommonMetadata.Labels {
+			labels[k] = v
+		}
+
+		if len(ks.Spec.CommonMetadata.Annotations) > 0 {
+			kus["commonAnnotations"] = ks.Spec.CommonMetadata.Annotations
+		}
+	}
+
+	kus["labels"] = []map[string]any{{"pairs": labels}}
+
+	return writeKustomization(fSys, overlay, kus)
+}
+
+func writeKustomization(fSys filesys.FileSystem, dir string, kus map[string]any) error {
+	data, err := yaml.Marshal(kus)
+	if err != nil {
+		return fmt.Errorf("failed to marshal kustomization: %w", err)
+	}
+
+	return fSys.WriteFile(filepath.Join(dir, konfig.DefaultKustomizationFileName()), data)
+}
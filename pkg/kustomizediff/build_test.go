@@ -0,0 +1,155 @@
+package kustomizediff_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/kustomize"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/weave-gitops/pkg/kustomizediff"
+)
+
+const testConfigMap = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  key: value
+`
+
+const testDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: podinfo
+spec:
+  template:
+    spec:
+      containers:
+      - name: podinfo
+        image: ghcr.io/stefanprodan/podinfo:6.0.0
+`
+
+func TestBuildGeneratesKustomization(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir := t.TempDir()
+	writeFiles(g, dir, map[string]string{
+		"apps/configmap.yaml":                   testConfigMap,
+		"apps/podinfo/deployment.yaml":          testDeployment,
+		"apps/podinfo/kustomization.yaml":       "resources:\n- deployment.yaml\n",
+		"apps/podinfo/ignored-by-kustomization": "not: included",
+	})
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: "flux-system"},
+		Spec: kustomizev1.KustomizationSpec{
+			Path:            "./apps",
+			TargetNamespace: "podinfo",
+			NamePrefix:      "dev-",
+			Images: []kustomize.Image{{
+				Name:   "ghcr.io/stefanprodan/podinfo",
+				NewTag: "6.1.0",
+			}},
+		},
+	}
+
+	objects, err := kustomizediff.Build(ks, dir)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objects).To(HaveLen(2))
+
+	names := []string{}
+
+	for _, obj := range objects {
+		names = append(names, obj.GetKind()+"/"+obj.GetName())
+
+		g.Expect(obj.GetNamespace()).To(Equal("podinfo"))
+		g.Expect(obj.GetLabels()).To(HaveKeyWithValue("kustomize.toolkit.fluxcd.io/name", "apps"))
+		g.Expect(obj.GetLabels()).To(HaveKeyWithValue("kustomize.toolkit.fluxcd.io/namespace", "flux-system"))
+
+		if obj.GetKind() == "Deployment" {
+			g.Expect(obj.Object).To(HaveKeyWithValue("spec", HaveKeyWithValue("template", HaveKeyWithValue("spec",
+				HaveKeyWithValue("containers", ContainElement(HaveKeyWithValue("image", "ghcr.io/stefanprodan/podinfo:6.1.0")))))))
+		}
+	}
+
+	g.Expect(names).To(ConsistOf("ConfigMap/dev-config", "Deployment/dev-podinfo"))
+	// The directory may be a local checkout, so nothing is written to it
+	g.Expect(filepath.Join(dir, "apps", "kustomization.yaml")).NotTo(BeAnExistingFile())
+}
+
+func TestBuildPatches(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir := t.TempDir()
+	writeFiles(g, dir, map[string]string{
+		"kustomization.yaml": "resources:\n- configmap.yaml\n",
+		"configmap.yaml":     testConfigMap,
+	})
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "flux-system"},
+		Spec: kustomizev1.KustomizationSpec{
+			Patches: []kustomize.Patch{{
+				Patch:  `[{"op": "replace", "path": "/data/key", "value": "patched"}]`,
+				Target: &kustomize.Selector{Kind: "ConfigMap"},
+			}},
+		},
+	}
+
+	objects, err := kustomizediff.Build(ks, dir)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objects).To(HaveLen(1))
+	g.Expect(objects[0].Object).To(HaveKeyWithValue("data", HaveKeyWithValue("key", "patched")))
+}
+
+func TestBuildMissingPath(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ks := &kustomizev1.Kustomization{
+		Spec: kustomizev1.KustomizationSpec{Path: "./missing"},
+	}
+
+	_, err := kustomizediff.Build(ks, t.TempDir())
+	g.Expect(err).To(MatchError(ContainSubstring("not found in artifact")))
+}
+
+func TestBuildOutsideArtifact(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "artifact")
+	secret := filepath.Join(parent, "token")
+
+	writeFiles(g, parent, map[string]string{
+		"token":       "secret",
+		"secret.yaml": testConfigMap,
+	})
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: "flux-system"},
+	}
+
+	for _, kustomization := range []string{
+		// Files outside the artifact can't be read, by absolute path
+		"configMapGenerator:\n- name: token\n  files:\n  - " + secret + "\n",
+		// nor relative to it
+		"configMapGenerator:\n- name: token\n  files:\n  - ../token\n",
+		"resources:\n- ../secret.yaml\n",
+	} {
+		writeFiles(g, dir, map[string]string{"kustomization.yaml": kustomization})
+
+		_, err := kustomizediff.Build(ks, dir)
+		g.Expect(err).To(MatchError(ContainSubstring("kustomize build failed")), kustomization)
+	}
+
+	// Symlinks to files outside the artifact are left out
+	g.Expect(os.Symlink(secret, filepath.Join(dir, "token"))).To(Succeed())
+	writeFiles(g, dir, map[string]string{"kustomization.yaml": "configMapGenerator:\n- name: token\n  files:\n  - token\n"})
+
+	_, err := kustomizediff.Build(ks, dir)
+	g.Expect(err).To(MatchError(ContainSubstring("kustomize build failed")))
+}
@@ -0,0 +1,217 @@
+// Package kustomizediff previews what applying a revision of a Kustomization
+// would change, by building its manifests and server-side dry-run applying them.
+package kustomizediff
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/fluxcd/cli-utils/pkg/object"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/ssa"
+	"github.com/fluxcd/pkg/ssa/normalize"
+	"github.com/fluxcd/pkg/ssa/utils"
+	"github.com/pmezard/go-difflib/difflib"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// Action is what applying the Kustomization would do to an object.
+type Action string
+
+const (
+	Created Action = "created"
+	Updated Action = "updated"
+	Pruned  Action = "pruned"
+	// Failed means the dry-run failed, the error says why.
+	Failed Action = "failed"
+)
+
+const (
+	pruneAnnotation = "kustomize.toolkit.fluxcd.io/prune"
+	pruneDisabled   = "disabled"
+	maskedValue     = "***"
+)
+
+// ObjectDiff is the change to a single object.
+type ObjectDiff struct {
+	Action     Action
+	APIVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+	// Diff is a unified diff of the object as YAML. Secret values are masked.
+	Diff  string
+	Error string
+}
+
+// Diff dry-run applies objects as kustomize-controller would and returns the
+// objects that would change. If the Kustomization prunes, the objects in its
+// inventory that aren't in objects are returned as pruned.
+// Objects that are unchanged are left out.
+func Diff(ctx context.Context, c client.Client, ks *kustomizev1.Kustomization, objects []*unstructured.Unstructured) ([]ObjectDiff, error) {
+	if err := normalize.UnstructuredList(objects); err != nil {
+		return nil, fmt.Errorf("failed to normalize objects: %w", err)
+	}
+
+	sort.Sort(ssa.SortableUnstructureds(objects))
+
+	manager := ssa.NewResourceManager(c, nil, ssa.Owner{
+		Field: "kustomize-controller",
+		Group: kustomizev1.GroupVersion.Group,
+	})
+
+	diffs := []ObjectDiff{}
+	applied := map[string]bool{}
+
+	for _, obj := range objects {
+		applied[object.UnstructuredToObjMetadata(obj).String()] = true
+
+		d := newObjectDiff(obj)
+
+		entry, live, merged, err := manager.Diff(ctx, obj, ssa.DefaultDiffOptions())
+		if err != nil {
+			d.Action = Failed
+			d.Error = err.Error()
+			diffs = append(diffs, d)
+
+			continue
+		}
+
+		switch entry.Action {
+		case ssa.CreatedAction:
+			d.Action = Created
+			d.Diff, err = unifiedDiff(nil, obj)
+		case ssa.ConfiguredAction:
+			d.Action = Updated
+			d.Diff, err = unifiedDiff(live, merged)
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		diffs = append(diffs, d)
+	}
+
+	if !ks.Spec.Prune || ks.Status.Inventory == nil {
+		return diffs, nil
+	}
+
+	for _, entry := range ks.Status.Inventory.Entries {
+		if applied[entry.ID] {
+			continue
+		}
+
+		meta, err := object.ParseObjMetadata(entry.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid inventory entry %q: %w", entry.ID, err)
+		}
+
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(meta.GroupKind.WithVersion(entry.Version))
+
+		if err := c.Get(ctx, client.ObjectKey{Namespace: meta.Namespace, Name: meta.Name}, live); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to get %s: %w", entry.ID, err)
+		}
+
+		if live.GetAnnotations()[pruneAnnotation] == pruneDisabled {
+			continue
+		}
+
+		d := newObjectDiff(live)
+		d.Action = Pruned
+
+		d.Diff, err = unifiedDiff(live, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		diffs = append(diffs, d)
+	}
+
+	return diffs, nil
+}
+
+func newObjectDiff(obj *unstructured.Unstructured) ObjectDiff {
+	return ObjectDiff{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+func unifiedDiff(from, to *unstructured.Unstructured) (string, error) {
+	a, err := toYAML(from)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := toYAML(to)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(a),
+		B:        difflib.SplitLines(b),
+		FromFile: "live",
+		ToFile:   "merged",
+		Context:  3,
+	})
+}
+
+func toYAML(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+
+	obj = obj.DeepCopy()
+	unstructured.RemoveNestedField(obj.Object, "metadata", "managedFields")
+
+	// Updated secrets are masked by the dry-run, mask the others too.
+	if utils.IsSecret(obj) {
+		maskSecret(obj)
+	}
+
+	data, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %s: %w", obj.GetName(), err)
+	}
+
+	return string(data), nil
+}
+
+func maskSecret(obj *unstructured.Unstructured) {
+	for _, field := range []string{"data", "stringData"} {
+		values, ok, _ := unstructured.NestedMap(obj.Object, field)
+		if !ok {
+			continue
+		}
+
+		for k, v := range values {
+			// The dry-run masks changed values with its own placeholders
+			if s, ok := v.(string); ok && isMasked(s) {
+				continue
+			}
+
+			values[k] = maskedValue
+		}
+
+		_ = unstructured.SetNestedMap(obj.Object, values, field)
+	}
+}
+
+func isMasked(s string) bool {
+	return s == maskedValue || s == "*** (before)" || s == "*** (after)"
+}
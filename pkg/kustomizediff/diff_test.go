@@ -0,0 +1,66 @@
+package kustomizediff_test
+
+import (
+	"context"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/kustomizediff"
+)
+
+func TestDiffPruned(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "removed", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("hunter2")},
+	}
+	kept := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "kept",
+			Namespace:   "default",
+			Annotations: map[string]string{"kustomize.toolkit.fluxcd.io/prune": "disabled"},
+		},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret, kept).Build()
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: "flux-system"},
+		Spec:       kustomizev1.KustomizationSpec{Prune: true},
+		Status: kustomizev1.KustomizationStatus{
+			Inventory: &kustomizev1.ResourceInventory{
+				Entries: []kustomizev1.ResourceRef{
+					{ID: "default_removed__Secret", Version: "v1"},
+					{ID: "default_kept__ConfigMap", Version: "v1"},
+					{ID: "default_already-gone__ConfigMap", Version: "v1"},
+				},
+			},
+		},
+	}
+
+	diffs, err := kustomizediff.Diff(context.Background(), c, ks, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(diffs).To(HaveLen(1))
+	g.Expect(diffs[0].Action).To(Equal(kustomizediff.Pruned))
+	g.Expect(diffs[0].Kind).To(Equal("Secret"))
+	g.Expect(diffs[0].Name).To(Equal("removed"))
+	g.Expect(diffs[0].Diff).To(ContainSubstring("-  password: '***'"))
+	g.Expect(diffs[0].Diff).NotTo(ContainSubstring("aHVudGVyMg=="))
+
+	// Nothing is pruned if the Kustomization doesn't prune
+	ks.Spec.Prune = false
+
+	diffs, err = kustomizediff.Diff(context.Background(), c, ks, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(diffs).To(BeEmpty())
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+)
+
+// apiTokenRoute is the route of an RPC, and the scope an API token needs to
+// call it.
+type apiTokenRoute struct {
+	rpc    string
+	method string
+	path   string
+	scope  string
+}
+
+// apiTokenRoutes lists the routes of the core RPCs. Whether an RPC only
+// reads doesn't follow from its HTTP method, as the RPCs taking queries in
+// their body are POSTs. Routes that aren't listed need the write scope.
+var apiTokenRoutes = []apiTokenRoute{
+	{"GetObject", http.MethodGet, "/v1/object/{name}", APITokenScopeRead},
+	{"ListObjects", http.MethodPost, "/v1/objects", APITokenScopeRead},
+	{"WatchObjects", http.MethodPost, "/v1/watch_objects", APITokenScopeRead},
+	{"ListFluxRuntimeObjects", http.MethodGet, "/v1/flux_runtime_objects", APITokenScopeRead},
+	{"ListFluxCrds", http.MethodGet, "/v1/flux_crds", APITokenScopeRead},
+	{"ListRuntimeObjects", http.MethodGet, "/v1/runtime_objects", APITokenScopeRead},
+	{"ListRuntimeCrds", http.MethodGet, "/v1/runtime_crds", APITokenScopeRead},
+	{"GetReconciledObjects", http.MethodPost, "/v1/reconciled_objects", APITokenScopeRead},
+	{"GetChildObjects", http.MethodPost, "/v1/child_objects", APITokenScopeRead},
+	{"GetFluxNamespace", http.MethodPost, "/v1/namespace/flux", APITokenScopeRead},
+	{"ListNamespaces", http.MethodGet, "/v1/namespaces", APITokenScopeRead},
+	{"ListEvents", http.MethodGet, "/v1/events", APITokenScopeRead},
+	{"GetReconciliationHistory", http.MethodGet, "/v1/reconciliation_history", APITokenScopeRead},
+	{"SyncFluxObject", http.MethodPost, "/v1/sync", APITokenScopeWrite},
+	{"GetVersion", http.MethodGet, "/v1/version", APITokenScopeRead},
+	{"GetFeatureFlags", http.MethodGet, "/v1/featureflags", APITokenScopeRead},
+	{"ToggleSuspendResource", http.MethodPost, "/v1/suspend", APITokenScopeWrite},
+	{"GetSessionLogs", http.MethodPost, "/v1/session_logs", APITokenScopeRead},
+	{"GetControllerLogs", http.MethodPost, "/v1/controller_logs", APITokenScopeRead},
+	{"IsCRDAvailable", http.MethodGet, "/v1/crd/is_available", APITokenScopeRead},
+	{"GetInventory", http.MethodGet, "/v1/inventory", APITokenScopeRead},
+	{"GetApplicationHealth", http.MethodGet, "/v1/application_health", APITokenScopeRead},
+	{"GetDependencyGraph", http.MethodGet, "/v1/dependency_graph", APITokenScopeRead},
+	{"DiffKustomization", http.MethodPost, "/v1/diff_kustomization", APITokenScopeRead},
+	{"ListAuditEvents", http.MethodGet, "/v1/audit_events", APITokenScopeRead},
+	{"CreateAPIToken", http.MethodPost, "/v1/api_tokens", APITokenScopeWrite},
+	{"ListAPITokens", http.MethodGet, "/v1/api_tokens", APITokenScopeRead},
+	{"RevokeAPIToken", http.MethodPost, "/v1/api_tokens/revoke", APITokenScopeWrite},
+	{"ListSessions", http.MethodGet, "/v1/sessions", APITokenScopeRead},
+	{"RevokeSessions", http.MethodPost, "/v1/sessions/revoke", APITokenScopeWrite},
+	{"GetOwningAutomation", http.MethodGet, "/v1/owning_automation", APITokenScopeRead},
+	{"Search", http.MethodPost, "/v1/search", APITokenScopeRead},
+	{"GetDrift", http.MethodGet, "/v1/drift", APITokenScopeRead},
+	{"ListHelmReleaseRevisions", http.MethodGet, "/v1/helmrelease/revisions", APITokenScopeRead},
+	{"RollbackHelmRelease", http.MethodPost, "/v1/helmrelease/rollback", APITokenScopeWrite},
+	{"ListArtifactFiles", http.MethodGet, "/v1/artifact/files", APITokenScopeRead},
+	{"GetArtifactFile", http.MethodGet, "/v1/artifact/file", APITokenScopeRead},
+	{"ListTerraformObjects", http.MethodGet, "/v1/terraform_objects", APITokenScopeRead},
+	{"GetTerraformPlan", http.MethodGet, "/v1/terraform_objects/plan", APITokenScopeRead},
+	{"ApproveTerraformPlan", http.MethodPost, "/v1/terraform_objects/approve", APITokenScopeWrite},
+	{"ReplanTerraformObject", http.MethodPost, "/v1/terraform_objects/replan", APITokenScopeWrite},
+	{"ListPolicies", http.MethodGet, "/v1/policies", APITokenScopeRead},
+	{"GetPolicy", http.MethodGet, "/v1/policies/{policy_name}", APITokenScopeRead},
+	{"ListPolicyValidations", http.MethodPost, "/v1/policyvalidations", APITokenScopeRead},
+	{"GetPolicyValidation", http.MethodGet, "/v1/policyvalidations/{validation_id}", APITokenScopeRead},
+}
+
+// RequiredAPITokenScope returns the scope an API token needs for the
+// request, write unless the route is of an RPC that only reads.
+func RequiredAPITokenScope(method, path string) string {
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+
+	for _, route := range apiTokenRoutes {
+		if route.method == method && routeMatches(route.path, path) {
+			return route.scope
+		}
+	}
+
+	return APITokenScopeWrite
+}
+
+// routeMatches matches the path against the route, where {param} segments
+// match any single segment.
+func routeMatches(route, path string) bool {
+	routeSegments := strings.Split(strings.Trim(route, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+
+	if len(routeSegments) != len(pathSegments) {
+		return false
+	}
+
+	for i, segment := range routeSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if pathSegments[i] == "" {
+				return false
+			}
+
+			continue
+		}
+
+		if segment != pathSegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
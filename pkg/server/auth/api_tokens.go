@@ -0,0 +1,442 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/core/logger"
+)
+
+const (
+	// APITokensSecretName is the Secret in the server's namespace holding the
+	// hashes of the API tokens.
+	APITokensSecretName = "gitops-api-tokens" // #nosec G101
+
+	// APITokenScopeRead only allows calling the RPCs that read.
+	APITokenScopeRead = "read"
+	// APITokenScopeWrite allows any request.
+	APITokenScopeWrite = "write"
+
+	// DefaultAPITokenTTL is how long API tokens last by default.
+	DefaultAPITokenTTL = 30 * 24 * time.Hour
+	// MaxAPITokenTTL is the longest an API token can last.
+	MaxAPITokenTTL = 365 * 24 * time.Hour
+
+	apiTokenPrefix = "gitops_"
+	// lastUsedPrecision limits how often using a token updates the Secret.
+	lastUsedPrecision = time.Minute
+	// apiTokensCacheTTL is how long the tokens read from the Secret are used
+	// to authenticate requests, so a token revoked on another replica can
+	// still be used for this long.
+	apiTokensCacheTTL = 10 * time.Second
+)
+
+var (
+	// ErrAPITokenNotFound is returned for tokens that don't exist, or that
+	// belong to another user.
+	ErrAPITokenNotFound = errors.New("API token not found")
+	// ErrInsufficientScope is returned for requests the API token is not
+	// scoped for.
+	ErrInsufficientScope = errors.New("API token scopes do not allow this request")
+)
+
+// APIToken is a long-lived credential that authenticates requests as its
+// owner. Only the hash of the token is stored.
+//
+// The tokens users create act as the user only: they don't keep the groups
+// their owner had when they were created, as the owner may be removed from
+// them afterwards. Only the groups an administrator gives a token when
+// minting it with the CLI are used.
+type APIToken struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Owner      string     `json:"owner"`
+	Groups     []string   `json:"groups,omitempty"`
+	Scopes     []string   `json:"scopes"`
+	Hash       string     `json:"hash"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+// Expired is true once the token can't be used anymore.
+func (t APIToken) Expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// Allows is true if the token's scopes allow the request, the write scope
+// allowing any request.
+func (t APIToken) Allows(method, path string) bool {
+	required := RequiredAPITokenScope(method, path)
+
+	for _, scope := range t.Scopes {
+		if scope == APITokenScopeWrite || scope == required {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateAPITokenScopes checks the scopes are known, and that there is at
+// least one.
+func ValidateAPITokenScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return errors.New("API tokens need at least one scope")
+	}
+
+	for _, scope := range scopes {
+		if scope != APITokenScopeRead && scope != APITokenScopeWrite {
+			return fmt.Errorf("unknown API token scope %q, valid scopes are %s,%s", scope, APITokenScopeRead, APITokenScopeWrite)
+		}
+	}
+
+	return nil
+}
+
+// APITokenStore keeps the API tokens in a Secret.
+type APITokenStore struct {
+	log    logr.Logger
+	client ctrlclient.Client
+	key    ctrlclient.ObjectKey
+	now    func() time.Time
+
+	// The tokens are cached to authenticate requests without reading the
+	// Secret each time
+	cacheMu  sync.Mutex
+	cached   map[string]APIToken
+	cachedAt time.Time
+}
+
+// NewAPITokenStore returns a store keeping the API tokens in the
+// APITokensSecretName Secret of the namespace.
+func NewAPITokenStore(log logr.Logger, client ctrlclient.Client, namespace string) *APITokenStore {
+	return &APITokenStore{
+		log:    log.WithName("api-tokens"),
+		client: client,
+		key:    ctrlclient.ObjectKey{Namespace: namespace, Name: APITokensSecretName},
+		now:    time.Now,
+	}
+}
+
+// Create mints a token for the principal, with the principal's groups,
+// returning it with its details. The token itself can't be retrieved again.
+func (s *APITokenStore) Create(ctx context.Context, principal *UserPrincipal, name string, scopes []string, ttl time.Duration) (string, *APIToken, error) {
+	if principal == nil || principal.ID == "" {
+		return "", nil, errors.New("API tokens need an owner")
+	}
+
+	if err := ValidateAPITokenScopes(scopes); err != nil {
+		return "", nil, err
+	}
+
+	if ttl == 0 {
+		ttl = DefaultAPITokenTTL
+	}
+
+	if ttl < 0 || ttl > MaxAPITokenTTL {
+		return "", nil, fmt.Errorf("API tokens can last up to %s", MaxAPITokenTTL)
+	}
+
+	id, err := randomString(8, hex.EncodeToString)
+	if err != nil {
+		return "", nil, err
+	}
+
+	secret, err := randomString(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return "", nil, err
+	}
+
+	raw := apiTokenPrefix + id + "_" + secret
+	now := s.now().UTC().Truncate(time.Second)
+
+	token := &APIToken{
+		ID:        id,
+		Name:      name,
+		Owner:     principal.ID,
+		Groups:    principal.Groups,
+		Scopes:    scopes,
+		Hash:      hashAPIToken(raw),
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+
+	err = s.update(ctx, func(tokens map[string]APIToken) error {
+		tokens[id] = *token
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return raw, token, nil
+}
+
+// List returns the owner's tokens, or everyone's if the owner is empty,
+// oldest first.
+func (s *APITokenStore) List(ctx context.Context, owner string) ([]APIToken, error) {
+	tokens, _, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []APIToken{}
+
+	for _, t := range tokens {
+		if owner == "" || t.Owner == owner {
+			result = append(result, t)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
+
+// Revoke deletes the owner's token, or anyone's if the owner is empty.
+func (s *APITokenStore) Revoke(ctx context.Context, owner, id string) error {
+	return s.update(ctx, func(tokens map[string]APIToken) error {
+		t, ok := tokens[id]
+		if !ok || (owner != "" && t.Owner != owner) {
+			return ErrAPITokenNotFound
+		}
+
+		delete(tokens, id)
+
+		return nil
+	})
+}
+
+// Authenticate returns the details of the token if it's valid. When it was
+// last used is recorded, to the minute.
+func (s *APITokenStore) Authenticate(ctx context.Context, raw string) (*APIToken, error) {
+	id, ok := apiTokenID(raw)
+	if !ok {
+		return nil, ErrAPITokenNotFound
+	}
+
+	tokens, err := s.cachedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, ok := tokens[id]
+	if !ok || subtle.ConstantTimeCompare([]byte(t.Hash), []byte(hashAPIToken(raw))) != 1 {
+		return nil, ErrAPITokenNotFound
+	}
+
+	now := s.now().UTC()
+	if t.Expired(now) {
+		return nil, fmt.Errorf("API token %s expired at %s", id, t.ExpiresAt.Format(time.RFC3339))
+	}
+
+	if t.LastUsedAt == nil || now.Sub(*t.LastUsedAt) >= lastUsedPrecision {
+		lastUsed := now.Truncate(lastUsedPrecision)
+		t.LastUsedAt = &lastUsed
+
+		// Failing to record the use doesn't stop the token being used
+		err := s.update(ctx, func(tokens map[string]APIToken) error {
+			if current, ok := tokens[id]; ok {
+				current.LastUsedAt = &lastUsed
+				tokens[id] = current
+			}
+
+			return nil
+		})
+		if err != nil {
+			s.log.Error(err, "unable to record API token use", "id", id)
+		}
+	}
+
+	return &t, nil
+}
+
+// cachedTokens returns the tokens, reading the Secret again once the cache
+// expires.
+func (s *APITokenStore) cachedTokens(ctx context.Context) (map[string]APIToken, error) {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+
+	if s.cached != nil && s.now().Sub(s.cachedAt) < apiTokensCacheTTL {
+		return s.cached, nil
+	}
+
+	tokens, _, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cached = tokens
+	s.cachedAt = s.now()
+
+	return tokens, nil
+}
+
+// invalidateCache makes the next authentication read the Secret.
+func (s *APITokenStore) invalidateCache() {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+
+	s.cached = nil
+}
+
+func (s *APITokenStore) load(ctx context.Context) (map[string]APIToken, *corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	tokens := map[string]APIToken{}
+
+	if err := s.client.Get(ctx, s.key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return tokens, nil, nil
+		}
+
+		return nil, nil, fmt.Errorf("getting API tokens: %w", err)
+	}
+
+	for id, data := range secret.Data {
+		var t APIToken
+		if err := json.Unmarshal(data, &t); err != nil {
+			return nil, nil, fmt.Errorf("parsing API token %s: %w", id, err)
+		}
+
+		tokens[id] = t
+	}
+
+	return tokens, secret, nil
+}
+
+// update changes the tokens and saves them, retrying on conflicts, and when
+// another update created the Secret first. Expired tokens are removed.
+func (s *APITokenStore) update(ctx context.Context, change func(map[string]APIToken) error) error {
+	defer s.invalidateCache()
+
+	retriable := func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}
+
+	return retry.OnError(retry.DefaultRetry, retriable, func() error {
+		tokens, secret, err := s.load(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := change(tokens); err != nil {
+			return err
+		}
+
+		data := map[string][]byte{}
+		now := s.now()
+
+		for id, t := range tokens {
+			if t.Expired(now) {
+				continue
+			}
+
+			b, err := json.Marshal(t)
+			if err != nil {
+				return err
+			}
+
+			data[id] = b
+		}
+
+		if secret == nil {
+			return s.client.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: s.key.Name, Namespace: s.key.Namespace},
+				Type:       corev1.SecretTypeOpaque,
+				Data:       data,
+			})
+		}
+
+		secret.Data = data
+
+		return s.client.Update(ctx, secret)
+	})
+}
+
+func hashAPIToken(raw string) string {
+	hash := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(hash[:])
+}
+
+// apiTokenID returns the ID part of a gitops_<id>_<secret> token.
+func apiTokenID(raw string) (string, bool) {
+	if !strings.HasPrefix(raw, apiTokenPrefix) {
+		return "", false
+	}
+
+	id, _, ok := strings.Cut(strings.TrimPrefix(raw, apiTokenPrefix), "_")
+
+	return id, ok && id != ""
+}
+
+func randomString(size int, encode func([]byte) string) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return encode(b), nil
+}
+
+// APITokenPrincipalGetter inspects the Authorization header for an API
+// token, and returns its owner as the principal.
+type APITokenPrincipalGetter struct {
+	log   logr.Logger
+	store *APITokenStore
+}
+
+// NewAPITokenPrincipalGetter creates a PrincipalGetter for the API tokens in
+// the store. Bearer tokens that aren't API tokens are left to the other
+// getters.
+func NewAPITokenPrincipalGetter(log logr.Logger, store *APITokenStore) PrincipalGetter {
+	return &APITokenPrincipalGetter{
+		log:   log,
+		store: store,
+	}
+}
+
+func (pg *APITokenPrincipalGetter) Principal(r *http.Request) (*UserPrincipal, error) {
+	raw := extractToken(r.Header.Get(AuthorizationTokenHeaderName))
+	if !strings.HasPrefix(raw, apiTokenPrefix) {
+		return nil, nil
+	}
+
+	token, err := pg.store.Authenticate(r.Context(), raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.Allows(r.Method, r.URL.Path) {
+		return nil, fmt.Errorf("%w: %s %s", ErrInsufficientScope, r.Method, r.URL.Path)
+	}
+
+	pg.log.V(logger.LogLevelDebug).Info("authenticated with API token", "id", token.ID, "user", token.Owner)
+
+	return &UserPrincipal{ID: token.Owner, Groups: token.Groups, APITokenID: token.ID}, nil
+}
@@ -0,0 +1,239 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+func TestAPITokenStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	fakeClient := fake.NewClientBuilder().Build()
+	store := NewAPITokenStore(logr.Discard(), fakeClient, "flux-system")
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	alice := &UserPrincipal{ID: "alice@example.com", Groups: []string{"ops"}}
+
+	raw, token, err := store.Create(ctx, alice, "ci", []string{APITokenScopeRead}, time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(raw).To(HavePrefix("gitops_" + token.ID + "_"))
+	g.Expect(token.ExpiresAt).To(Equal(now.Add(time.Hour)))
+
+	// Only the hash is stored
+	secret := &corev1.Secret{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "flux-system", Name: APITokensSecretName}, secret)).To(Succeed())
+	g.Expect(string(secret.Data[token.ID])).NotTo(ContainSubstring(strings.TrimPrefix(raw, "gitops_"+token.ID+"_")))
+
+	authenticated, err := store.Authenticate(ctx, raw)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(authenticated.Owner).To(Equal(alice.ID))
+	g.Expect(authenticated.Groups).To(Equal(alice.Groups))
+
+	tokens, err := store.List(ctx, alice.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tokens).To(HaveLen(1))
+	g.Expect(tokens[0].LastUsedAt).NotTo(BeNil())
+	g.Expect(*tokens[0].LastUsedAt).To(Equal(now))
+
+	_, err = store.Authenticate(ctx, raw+"x")
+	g.Expect(err).To(MatchError(ErrAPITokenNotFound))
+
+	_, _, err = store.Create(ctx, alice, "forever", []string{APITokenScopeRead}, 2*MaxAPITokenTTL)
+	g.Expect(err).To(HaveOccurred())
+
+	_, _, err = store.Create(ctx, alice, "admin", []string{"admin"}, time.Hour)
+	g.Expect(err).To(HaveOccurred())
+
+	// Other users can't see or revoke the token
+	tokens, err = store.List(ctx, "bob@example.com")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tokens).To(BeEmpty())
+	g.Expect(store.Revoke(ctx, "bob@example.com", token.ID)).To(MatchError(ErrAPITokenNotFound))
+
+	now = now.Add(2 * time.Hour)
+	_, err = store.Authenticate(ctx, raw)
+	g.Expect(err).To(MatchError(ContainSubstring("expired")))
+
+	raw, token, err = store.Create(ctx, alice, "ci", []string{APITokenScopeRead}, time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// The expired token was removed when the new one was stored
+	tokens, err = store.List(ctx, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tokens).To(HaveLen(1))
+
+	g.Expect(store.Revoke(ctx, alice.ID, token.ID)).To(Succeed())
+
+	_, err = store.Authenticate(ctx, raw)
+	g.Expect(err).To(MatchError(ErrAPITokenNotFound))
+}
+
+func TestAPITokenPrincipalGetter(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	store := NewAPITokenStore(logr.Discard(), fake.NewClientBuilder().Build(), "flux-system")
+	getter := NewAPITokenPrincipalGetter(logr.Discard(), store)
+
+	raw, token, err := store.Create(ctx, &UserPrincipal{ID: "alice@example.com", Groups: []string{"ops"}}, "ci", []string{APITokenScopeRead}, time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	request := func(method, path, token string) *http.Request {
+		r := httptest.NewRequest(method, "https://example.com"+path, nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+
+		return r
+	}
+
+	p, err := getter.Principal(request(http.MethodGet, "/v1/object/podinfo", raw))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(p.ID).To(Equal("alice@example.com"))
+	g.Expect(p.Groups).To(Equal([]string{"ops"}))
+	g.Expect(p.APITokenID).To(Equal(token.ID))
+
+	// A read token can call the RPCs that read with a POST
+	p, err = getter.Principal(request(http.MethodPost, "/v1/objects", raw))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(p.ID).To(Equal("alice@example.com"))
+
+	// but can't make changes
+	_, err = getter.Principal(request(http.MethodPost, "/v1/sync", raw))
+	g.Expect(errors.Is(err, ErrInsufficientScope)).To(BeTrue())
+
+	// or call routes that aren't known to only read
+	_, err = getter.Principal(request(http.MethodPost, "/v1/unknown", raw))
+	g.Expect(errors.Is(err, ErrInsufficientScope)).To(BeTrue())
+
+	writeRaw, _, err := store.Create(ctx, &UserPrincipal{ID: "alice@example.com"}, "deploy", []string{APITokenScopeWrite}, time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = getter.Principal(request(http.MethodPost, "/v1/sync", writeRaw))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// Other bearer tokens are left to the other getters
+	p, err = getter.Principal(request(http.MethodGet, "/v1/objects", "eyJhbGciOiJSUzI1NiJ9"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(p).To(BeNil())
+}
+
+func TestAPITokenStoreConcurrentCreate(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	store := NewAPITokenStore(logr.Discard(), fake.NewClientBuilder().Build(), "flux-system")
+	alice := &UserPrincipal{ID: "alice@example.com"}
+
+	// The first tokens race to create the Secret
+	var wg sync.WaitGroup
+
+	errs := make(chan error, 5)
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, _, err := store.Create(ctx, alice, "ci", []string{APITokenScopeRead}, time.Hour)
+			errs <- err
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+
+	tokens, err := store.List(ctx, alice.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tokens).To(HaveLen(5))
+}
+
+func TestAPITokenAuthenticateCache(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	fakeClient := fake.NewClientBuilder().Build()
+	store := NewAPITokenStore(logr.Discard(), fakeClient, "flux-system")
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	raw, token, err := store.Create(ctx, &UserPrincipal{ID: "alice@example.com"}, "ci", []string{APITokenScopeRead}, time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// The first use is recorded in the Secret, the second is authenticated
+	// from the cache
+	for i := 0; i < 2; i++ {
+		_, err = store.Authenticate(ctx, raw)
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+
+	// Revoked on another replica, the cached token is used until the cache
+	// expires
+	other := NewAPITokenStore(logr.Discard(), fakeClient, "flux-system")
+	g.Expect(other.Revoke(ctx, "", token.ID)).To(Succeed())
+
+	_, err = store.Authenticate(ctx, raw)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	now = now.Add(apiTokensCacheTTL)
+
+	_, err = store.Authenticate(ctx, raw)
+	g.Expect(err).To(MatchError(ErrAPITokenNotFound))
+}
+
+// Every RPC of the core service is in the table of routes, with the route
+// of its HTTP rule.
+func TestAPITokenRoutesCoverRPCs(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	routes := map[string]apiTokenRoute{}
+	for _, route := range apiTokenRoutes {
+		routes[route.rpc] = route
+	}
+
+	methods := pb.File_api_core_core_proto.Services().ByName("Core").Methods()
+	g.Expect(routes).To(HaveLen(methods.Len()))
+
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+
+		route, ok := routes[string(method.Name())]
+		g.Expect(ok).To(BeTrue(), "%s isn't in the API token routes", method.Name())
+
+		rule := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+
+		switch pattern := rule.Pattern.(type) {
+		case *annotations.HttpRule_Get:
+			g.Expect(route.method).To(Equal(http.MethodGet), string(method.Name()))
+			g.Expect(route.path).To(Equal(pattern.Get), string(method.Name()))
+		case *annotations.HttpRule_Post:
+			g.Expect(route.method).To(Equal(http.MethodPost), string(method.Name()))
+			g.Expect(route.path).To(Equal(pattern.Post), string(method.Name()))
+		default:
+			t.Errorf("unexpected HTTP rule of %s", method.Name())
+		}
+	}
+}
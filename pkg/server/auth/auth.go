@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -82,7 +83,10 @@ func Principal(ctx context.Context) *UserPrincipal {
 type UserPrincipal struct {
 	ID     string   `json:"id"`
 	Groups []string `json:"groups"`
-	token  *string  `json:"-"`
+	// APITokenID is the ID of the API token that authenticated the user, if
+	// any.
+	APITokenID string  `json:"-"`
+	token      *string `json:"-"`
 }
 
 // Token returns the private access token for this principal.
@@ -162,7 +166,9 @@ func WithAPIAuth(next http.Handler, srv *AuthServer, publicRoutes []string, sm S
 
 	// FIXME: currently the order must be OIDC last, or it'll "shadow" the other
 	// methods so they don't work.
-	methods := []AuthMethod{UserAccount, TokenPassthrough, OIDC, Anonymous}
+	// API tokens are bearer tokens too, so they go before OIDC and
+	// TokenPassthrough, which would reject them.
+	methods := []AuthMethod{APITokenAuth, UserAccount, TokenPassthrough, OIDC, Anonymous}
 	for _, method := range methods {
 		enabled, ok := srv.authMethods[method]
 		if !ok {
@@ -195,6 +201,9 @@ func WithAPIAuth(next http.Handler, srv *AuthServer, publicRoutes []string, sm S
 				multi.Getters = append(multi.Getters, adminAuth)
 			}
 
+		case APITokenAuth:
+			multi.Getters = append(multi.Getters, NewAPITokenPrincipalGetter(srv.Log, srv.apiTokens))
+
 		case TokenPassthrough:
 			tokenAuth := NewBearerTokenPassthroughPrincipalGetter(srv.Log, nil, AuthorizationTokenHeaderName, srv.kubernetesClient)
 			multi.Getters = append(multi.Getters, tokenAuth)
@@ -229,6 +238,16 @@ func (a *authenticatedMiddleware) ServeHTTP(rw http.ResponseWriter, r *http.Requ
 
 	principal, err := a.principalGetter.Principal(r)
 
+	if errors.Is(err, ErrInsufficientScope) {
+		JSONError(a.srv.Log, rw, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if (err != nil || principal == nil) && a.canRefresh(r) {
+		a.srv.Log.V(logger.LogLevelDebug).Info("renewing session with refresh token", "err", err)
+		principal, err = a.srv.Refresh(rw, r)
+	}
+
 	if err != nil || principal == nil {
 		JSONError(a.srv.Log, rw, "Authentication required", http.StatusUnauthorized)
 		return
@@ -237,6 +256,14 @@ func (a *authenticatedMiddleware) ServeHTTP(rw http.ResponseWriter, r *http.Requ
 	a.next.ServeHTTP(rw, r.Clone(WithPrincipal(r.Context(), principal)))
 }
 
+// canRefresh is true if the ID token in the session can be renewed with the
+// refresh token, as it would be by the UI calling /oauth2/refresh.
+func (a *authenticatedMiddleware) canRefresh(r *http.Request) bool {
+	return a.srv.oidcEnabled() &&
+		r.Header.Get(AuthorizationTokenHeaderName) == "" &&
+		a.sm.GetString(r.Context(), RefreshTokenCookieName) != ""
+}
+
 func generateNonce() (string, error) {
 	b := make([]byte, 32)
 
@@ -20,6 +20,8 @@ const (
 
 	// Anonymous
 	Anonymous
+	// Long-lived API tokens for scripted access
+	APITokenAuth
 )
 
 // This is a function to mimic a const slice
@@ -31,7 +33,7 @@ func DefaultAuthMethods() []AuthMethod {
 // auth-methods flag. `Anonymous` is not included as it is configured via another
 // --insecure-no-auth flag
 func AllUserAuthMethods() []string {
-	allUserAuthMethods := []AuthMethod{UserAccount, OIDC, TokenPassthrough}
+	allUserAuthMethods := []AuthMethod{UserAccount, OIDC, TokenPassthrough, APITokenAuth}
 	res := []string{}
 	for _, method := range allUserAuthMethods {
 		res = append(res, method.String())
@@ -82,6 +84,8 @@ func (am *AuthMethod) String() string {
 		return "token-passthrough"
 	case Anonymous:
 		return "anonymous"
+	case APITokenAuth:
+		return "api-token"
 	default:
 		return fmt.Sprintf("AuthMethod(%d)", am)
 	}
@@ -98,6 +102,8 @@ func (am *AuthMethod) UnmarshalText(text []byte) error {
 		*am = TokenPassthrough
 	case "anonymous":
 		*am = Anonymous
+	case "api-token":
+		*am = APITokenAuth
 	default:
 		return fmt.Errorf("unknown auth method '%q'", text)
 	}
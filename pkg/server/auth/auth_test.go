@@ -301,6 +301,98 @@ func TestIsPublicRoute(t *testing.T) {
 	g.Expect(auth.IsPublicRoute(&url.URL{Path: "/foob"}, []string{"/foo"})).To(BeFalse())
 }
 
+func TestWithAPIAuthAPITokens(t *testing.T) {
+	g := NewGomegaWithT(t)
+	sm := scs.New()
+
+	tokenSignerVerifier, err := auth.NewHMACTokenSignerVerifier(5 * time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	fakeKubernetesClient := ctrlclient.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: auth.ClusterUserAuthSecretName, Namespace: testNamespace},
+	}).Build()
+
+	authMethods := map[auth.AuthMethod]bool{auth.UserAccount: true, auth.APITokenAuth: true}
+
+	authCfg, err := auth.NewAuthServerConfig(logr.Discard(), auth.OIDCConfig{}, fakeKubernetesClient, tokenSignerVerifier, testNamespace, authMethods, "", sm)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	srv, err := auth.NewAuthServer(context.Background(), authCfg)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(srv.APITokens()).NotTo(BeNil())
+
+	token, _, err := srv.APITokens().Create(context.Background(), &auth.UserPrincipal{ID: "ci@example.com", Groups: []string{"ci"}}, "ci", []string{auth.APITokenScopeRead}, time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var principal *auth.UserPrincipal
+
+	handler := sm.LoadAndSave(auth.WithAPIAuth(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		principal = auth.Principal(r.Context())
+	}), srv, nil, sm))
+
+	request := func(method, path, token string) *httptest.ResponseRecorder {
+		res := httptest.NewRecorder()
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		handler.ServeHTTP(res, req)
+
+		return res
+	}
+
+	g.Expect(request(http.MethodPost, "/v1/objects", token)).To(HaveHTTPStatus(http.StatusOK))
+	g.Expect(principal.ID).To(Equal("ci@example.com"))
+	g.Expect(principal.Groups).To(Equal([]string{"ci"}))
+
+	g.Expect(request(http.MethodPost, "/v1/sync", token)).To(HaveHTTPStatus(http.StatusForbidden))
+	g.Expect(request(http.MethodPost, "/v1/objects", token+"x")).To(HaveHTTPStatus(http.StatusUnauthorized))
+}
+
+func TestWithAPIAuthRefreshesExpiredSessions(t *testing.T) {
+	g := NewGomegaWithT(t)
+	sm := &fakeSessionManager{}
+
+	tokenSignerVerifier, err := auth.NewHMACTokenSignerVerifier(5 * time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	srv, m := makeAuthServer(t, nil, tokenSignerVerifier, []auth.AuthMethod{auth.OIDC}, sm)
+
+	tokens := getVerifyTokens(t, m)
+
+	var principal *auth.UserPrincipal
+
+	handler := auth.WithAPIAuth(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		principal = auth.Principal(r.Context())
+	}), srv, nil, sm)
+
+	request := func(values map[string]any) *httptest.ResponseRecorder {
+		res := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/v1/objects", nil).WithContext(contextWithSessionValues(values))
+		handler.ServeHTTP(res, req)
+
+		return res
+	}
+
+	// The expired ID token is renewed with the refresh token
+	g.Expect(request(map[string]any{
+		auth.IDTokenCookieName:      "expired",
+		auth.RefreshTokenCookieName: tokens["refresh_token"].(string),
+	})).To(HaveHTTPStatus(http.StatusOK))
+	g.Expect(principal.ID).To(Equal("jane.doe@example.com"))
+	g.Expect(sm.PutValues).To(HaveKey(auth.IDTokenCookieName))
+
+	_, err = m.Keypair.VerifyJWT(sm.stringValue(auth.IDTokenCookieName), nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(request(map[string]any{
+		auth.IDTokenCookieName:      "expired",
+		auth.RefreshTokenCookieName: "invalid",
+	})).To(HaveHTTPStatus(http.StatusUnauthorized))
+
+	g.Expect(request(map[string]any{
+		auth.IDTokenCookieName: "expired",
+	})).To(HaveHTTPStatus(http.StatusUnauthorized))
+}
+
 func TestRateLimit(t *testing.T) {
 	g := NewGomegaWithT(t)
 	sm := &fakeSessionManager{}
@@ -1,20 +1,99 @@
 package auth
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 )
 
 // ClaimsConfig provides the keys to extract the details for a Principal
 // from set of JWT claims.
+//
+// The keys can be paths to nested claims, separated by dots, e.g.
+// `realm_access.roles`.
 type ClaimsConfig struct {
 	Username string
 	Groups   string
+	// GroupsFilter is a regular expression that the groups must match to be
+	// kept, if it's set.
+	GroupsFilter string
+	// GroupsMappings rename the groups that are kept. Each one is
+	// `pattern=replacement`, where the replacement can refer to the
+	// pattern's submatches, e.g. `^team-(.*)$=$1`. The first mapping whose
+	// pattern matches a group is used, and groups mapped to "" are dropped.
+	GroupsMappings []string
+	// UserInfo adds the claims from the provider's userinfo endpoint that
+	// the ID token doesn't have, for providers that leave the groups out of
+	// ID tokens.
+	UserInfo bool
+
+	userInfo *userInfoClaims
+
+	// The groups filter and mappings, compiled by Validate
+	rulesCompiled  bool
+	groupsFilter   *regexp.Regexp
+	groupsMappings []groupsMapping
 }
 
 type claimsToken interface {
 	Claims(v interface{}) error
 }
 
+type groupsMapping struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// Validate checks the groups filter and mappings are valid, and compiles
+// them once for all the principals.
+func (c *ClaimsConfig) Validate() error {
+	filter, mappings, err := c.groupsRules()
+	if err != nil || c == nil {
+		return err
+	}
+
+	c.groupsFilter, c.groupsMappings, c.rulesCompiled = filter, mappings, true
+
+	return nil
+}
+
+func (c *ClaimsConfig) groupsRules() (*regexp.Regexp, []groupsMapping, error) {
+	if c == nil {
+		return nil, nil, nil
+	}
+
+	var filter *regexp.Regexp
+
+	if c.GroupsFilter != "" {
+		var err error
+
+		filter, err = regexp.Compile(c.GroupsFilter)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid groups filter %q: %w", c.GroupsFilter, err)
+		}
+	}
+
+	mappings := []groupsMapping{}
+
+	for _, m := range c.GroupsMappings {
+		i := strings.LastIndex(m, "=")
+		if i < 1 {
+			return nil, nil, fmt.Errorf("invalid groups mapping %q, it should be pattern=replacement", m)
+		}
+
+		pattern, err := regexp.Compile(m[:i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid groups mapping %q: %w", m, err)
+		}
+
+		mappings = append(mappings, groupsMapping{pattern: pattern, replacement: m[i+1:]})
+	}
+
+	return filter, mappings, nil
+}
+
 // PrincipalFromClaims takes a token and parses the claims using the
 // configuration and returns a configured UserPrincipal with the details in the
 // claims.
@@ -37,14 +116,14 @@ func (c *ClaimsConfig) PrincipalFromClaims(token claimsToken) (*UserPrincipal, e
 		groupsKey = c.Groups
 	}
 
-	id, ok := claims[idKey].(string)
+	id, ok := lookupClaim(claims, idKey).(string)
 	if !ok {
 		return nil, fmt.Errorf("missing %q claim in response", idKey)
 	}
 
 	groups := []string{}
 
-	if v, ok := claims[groupsKey]; ok {
+	if v := lookupClaim(claims, groupsKey); v != nil {
 		gv, ok := v.([]interface{})
 
 		if ok {
@@ -64,5 +143,130 @@ func (c *ClaimsConfig) PrincipalFromClaims(token claimsToken) (*UserPrincipal, e
 		}
 	}
 
-	return &UserPrincipal{ID: id, Groups: groups}, nil
+	var (
+		filter   *regexp.Regexp
+		mappings []groupsMapping
+	)
+
+	if c != nil && c.rulesCompiled {
+		filter, mappings = c.groupsFilter, c.groupsMappings
+	} else {
+		// The config wasn't validated
+		var err error
+
+		filter, mappings, err = c.groupsRules()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &UserPrincipal{ID: id, Groups: mapGroups(groups, filter, mappings)}, nil
+}
+
+// principalFromTokens is PrincipalFromClaims, with the userinfo claims the
+// ID token doesn't have added to it when they're enabled and there's an
+// access token to fetch them with.
+func (c *ClaimsConfig) principalFromTokens(ctx context.Context, idToken claimsToken, accessToken string) (*UserPrincipal, error) {
+	if c != nil && c.userInfo != nil && accessToken != "" {
+		extra, err := c.userInfo.claims(ctx, accessToken)
+		if err != nil {
+			return nil, err
+		}
+
+		merged, err := mergeUserInfoClaims(idToken, extra)
+		if err != nil {
+			return nil, err
+		}
+
+		idToken = merged
+	}
+
+	return c.PrincipalFromClaims(idToken)
+}
+
+// lookupClaim returns the claim with the key, or follows the key as a path
+// through nested claims. Keys containing dots, like the URLs some providers
+// use, are matched before paths.
+func lookupClaim(claims map[string]interface{}, key string) interface{} {
+	if v, ok := claims[key]; ok {
+		return v
+	}
+
+	for i := strings.Index(key, "."); i >= 0; {
+		if nested, ok := claims[key[:i]].(map[string]interface{}); ok {
+			if v := lookupClaim(nested, key[i+1:]); v != nil {
+				return v
+			}
+		}
+
+		next := strings.Index(key[i+1:], ".")
+		if next < 0 {
+			break
+		}
+
+		i += next + 1
+	}
+
+	return nil
+}
+
+func mapGroups(groups []string, filter *regexp.Regexp, mappings []groupsMapping) []string {
+	result := []string{}
+	seen := map[string]bool{}
+
+	for _, g := range groups {
+		if filter != nil && !filter.MatchString(g) {
+			continue
+		}
+
+		for _, m := range mappings {
+			if m.pattern.MatchString(g) {
+				g = m.pattern.ReplaceAllString(g, m.replacement)
+				break
+			}
+		}
+
+		if g != "" && !seen[g] {
+			seen[g] = true
+			result = append(result, g)
+		}
+	}
+
+	return result
+}
+
+// mergeUserInfoClaims adds the userinfo claims the ID token doesn't have to
+// its claims, so the userinfo response can't change who the user is. As
+// OpenID Connect Core 5.3.2 requires, the response is rejected if it's about
+// another subject than the ID token.
+func mergeUserInfoClaims(idToken claimsToken, userInfo map[string]interface{}) (claimsToken, error) {
+	claims := map[string]interface{}{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("failed to parse claims from the JWT token: %w", err)
+	}
+
+	sub, ok := claims["sub"].(string)
+	if userInfoSub, _ := userInfo["sub"].(string); !ok || userInfoSub != sub {
+		return nil, fmt.Errorf("the userinfo subject %q doesn't match the ID token's %q", userInfoSub, sub)
+	}
+
+	for k, v := range userInfo {
+		if _, ok := claims[k]; !ok {
+			claims[k] = v
+		}
+	}
+
+	return mapClaims(claims), nil
+}
+
+// mapClaims are parsed claims.
+type mapClaims map[string]interface{}
+
+func (m mapClaims) Claims(v interface{}) error {
+	b, err := json.Marshal(map[string]interface{}(m))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
 }
@@ -2,6 +2,7 @@ package auth_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/coreos/go-oidc/v3/oidc"
@@ -51,6 +52,33 @@ func TestPrincipalFromClaims(t *testing.T) {
 			config: &auth.ClaimsConfig{Groups: "test_groups"},
 			want:   &auth.UserPrincipal{ID: "example@example.com", Groups: []string{"single-group"}},
 		},
+		{
+			name: "nested claims",
+			token: testutils.MakeJWToken(t, privKey, "example@example.com", func(m map[string]any) {
+				m["realm_access"] = map[string]any{"roles": []string{"role1", "role2"}}
+			}),
+			config: &auth.ClaimsConfig{Groups: "realm_access.roles"},
+			want:   &auth.UserPrincipal{ID: "example@example.com", Groups: []string{"role1", "role2"}},
+		},
+		{
+			name: "claims with dots in their names",
+			token: testutils.MakeJWToken(t, privKey, "example@example.com", func(m map[string]any) {
+				m["https://example.com/groups"] = []string{"group1"}
+			}),
+			config: &auth.ClaimsConfig{Groups: "https://example.com/groups"},
+			want:   &auth.UserPrincipal{ID: "example@example.com", Groups: []string{"group1"}},
+		},
+		{
+			name: "filtered and mapped groups",
+			token: testutils.MakeJWToken(t, privKey, "example@example.com", func(m map[string]any) {
+				m["groups"] = []string{"gitops-team-a", "gitops-admins", "gitops-ignored", "sales"}
+			}),
+			config: &auth.ClaimsConfig{
+				GroupsFilter:   "^gitops-",
+				GroupsMappings: []string{"^gitops-ignored$=", "^gitops-admins$=admins", "^gitops-(.*)$=$1"},
+			},
+			want: &auth.UserPrincipal{ID: "example@example.com", Groups: []string{"team-a", "admins"}},
+		},
 	}
 
 	srv := testutils.MakeKeysetServer(t, privKey)
@@ -74,3 +102,30 @@ func TestPrincipalFromClaims(t *testing.T) {
 		})
 	}
 }
+
+func TestClaimsConfigValidate(t *testing.T) {
+	validateTests := []struct {
+		name   string
+		config *auth.ClaimsConfig
+		errMsg string
+	}{
+		{name: "no config"},
+		{name: "valid", config: &auth.ClaimsConfig{GroupsFilter: "^a", GroupsMappings: []string{"^a-(.*)$=$1"}}},
+		{name: "invalid filter", config: &auth.ClaimsConfig{GroupsFilter: "("}, errMsg: "invalid groups filter"},
+		{name: "mapping without replacement", config: &auth.ClaimsConfig{GroupsMappings: []string{"^a"}}, errMsg: "should be pattern=replacement"},
+		{name: "invalid mapping", config: &auth.ClaimsConfig{GroupsMappings: []string{"(=a"}}, errMsg: "invalid groups mapping"},
+	}
+
+	for _, tt := range validateTests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.errMsg == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.errMsg != "" && (err == nil || !strings.Contains(err.Error(), tt.errMsg)) {
+				t.Fatalf("got error %v, want %q", err, tt.errMsg)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/alexedwards/scs/v2"
 	"github.com/go-logr/logr"
@@ -24,6 +25,12 @@ type AuthParams struct {
 	NoAuthUser        string
 	Namespace         string
 	SessionManager    *scs.SessionManager
+	// SessionLifetime is how long sessions last, or the token duration if
+	// it's not set.
+	SessionLifetime time.Duration
+	// SessionIdleTimeout ends sessions that aren't used for this long, if
+	// it's set.
+	SessionIdleTimeout time.Duration
 }
 
 // InitAuthServer creates a new AuthServer and configures it for the correct
@@ -91,6 +98,10 @@ func InitAuthServer(ctx context.Context, log logr.Logger, rawKubernetesClient ct
 		if _, err := url.Parse(oidcConfig.RedirectURL); err != nil {
 			return nil, fmt.Errorf("invalid redirect URL: %w", err)
 		}
+
+		if err := oidcConfig.ClaimsConfig.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid OIDC claims configuration: %w", err)
+		}
 	} else {
 		// Make sure there is no OIDC config if it's not an enabled authorization method
 		// the TokenDuration needs to be set so cookies can use it
@@ -123,6 +134,11 @@ func InitAuthServer(ctx context.Context, log logr.Logger, rawKubernetesClient ct
 	}
 
 	authParams.SessionManager.Lifetime = oidcConfig.TokenDuration
+	if authParams.SessionLifetime > 0 {
+		authParams.SessionManager.Lifetime = authParams.SessionLifetime
+	}
+
+	authParams.SessionManager.IdleTimeout = authParams.SessionIdleTimeout
 
 	return authServer, err
 }
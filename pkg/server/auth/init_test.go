@@ -3,6 +3,7 @@ package auth_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/alexedwards/scs/v2"
 	"github.com/go-logr/logr"
@@ -152,6 +153,38 @@ func TestInitAuthServer(t *testing.T) {
 	}
 }
 
+func TestInitAuthServerSessionLifetime(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	featureflags.SetBoolean(auth.FeatureFlagClusterUser, false)
+	featureflags.SetBoolean(auth.FeatureFlagOIDCAuth, false)
+	featureflags.SetBoolean(auth.FeatureFlagAnonymousAuth, false)
+
+	fakeKubernetesClient := ctrlclient.NewClientBuilder().
+		WithObjects(makeClusterUserSecret("my-secret-password", auth.ClusterUserAuthSecretName)).
+		Build()
+
+	for _, tt := range []struct {
+		lifetime time.Duration
+		want     time.Duration
+	}{
+		// Sessions last as long as the tokens by default
+		{lifetime: 0, want: time.Hour},
+		{lifetime: 8 * time.Hour, want: 8 * time.Hour},
+	} {
+		sessionManager := scs.New()
+
+		_, err := auth.InitAuthServer(context.Background(), logr.Discard(), fakeKubernetesClient, auth.AuthParams{
+			AuthMethodStrings: []string{"user-account"},
+			Namespace:         "test-namespace",
+			SessionManager:    sessionManager,
+			SessionLifetime:   tt.lifetime,
+		})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(sessionManager.Lifetime).To(gomega.Equal(tt.want))
+	}
+}
+
 func makeOIDCSecret(oidcConfig *mockoidc.Config, secretName string) *corev1.Secret {
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -57,7 +57,7 @@ func (pg *JWTCookiePrincipalGetter) Principal(r *http.Request) (*UserPrincipal,
 
 	pg.log.V(logger.LogLevelDebug).Info("parsing cookie JWT token", "claimsConfig", pg.claimsConfig)
 
-	return parseJWTToken(r.Context(), pg.verifier, cookieValue, pg.claimsConfig, pg.log)
+	return parseJWTToken(r.Context(), pg.verifier, cookieValue, pg.sm.GetString(r.Context(), AccessTokenCookieName), pg.claimsConfig, pg.log)
 }
 
 // JWTAuthorizationHeaderPrincipalGetter inspects the Authorization
@@ -87,7 +87,7 @@ func (pg *JWTAuthorizationHeaderPrincipalGetter) Principal(r *http.Request) (*Us
 
 	pg.log.V(logger.LogLevelDebug).Info("parsing authorization header JWT token", "claimsConfig", pg.claimsConfig)
 
-	return parseJWTToken(r.Context(), pg.verifier, extractToken(header), pg.claimsConfig, pg.log)
+	return parseJWTToken(r.Context(), pg.verifier, extractToken(header), "", pg.claimsConfig, pg.log)
 }
 
 func extractToken(s string) string {
@@ -103,14 +103,16 @@ func extractToken(s string) string {
 	return strings.TrimSpace(parts[1])
 }
 
-func parseJWTToken(ctx context.Context, verifier tokenVerifier, rawIDToken string, cc *ClaimsConfig, log logr.Logger) (*UserPrincipal, error) {
+// parseJWTToken verifies the ID token and returns its principal. The access
+// token, if any, is used to fetch the userinfo claims when they're enabled.
+func parseJWTToken(ctx context.Context, verifier tokenVerifier, rawIDToken, accessToken string, cc *ClaimsConfig, log logr.Logger) (*UserPrincipal, error) {
 	token, err := verifier.Verify(ctx, rawIDToken)
 	if err != nil {
 		return nil, fmt.Errorf("failed to verify JWT token: %w", err)
 	}
 	log.V(logger.LogLevelDebug).Info("parsed JWT token", "expires", token.Expiry)
 
-	return cc.PrincipalFromClaims(token)
+	return cc.principalFromTokens(ctx, token, accessToken)
 }
 
 type JWTAdminCookiePrincipalGetter struct {
@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// The actions that are gated by policies, named after the RPCs.
+const (
+	ActionSyncFluxObject        = "SyncFluxObject"
+	ActionToggleSuspendResource = "ToggleSuspendResource"
+	ActionGetSessionLogs        = "GetSessionLogs"
+	ActionListAuditEvents       = "ListAuditEvents"
+	ActionListSessions          = "ListSessions"
+	ActionRevokeSessions        = "RevokeSessions"
+	ActionRollbackHelmRelease   = "RollbackHelmRelease"
+	ActionApproveTerraformPlan  = "ApproveTerraformPlan"
+	ActionReplanTerraformObject = "ReplanTerraformObject"
+	ActionGetControllerLogs     = "GetControllerLogs"
+)
+
+// Authorizer decides whether a user may take an action in a namespace of a
+// cluster, on top of what impersonating them allows. An empty cluster or
+// namespace is all of them.
+type Authorizer interface {
+	Authorize(principal *UserPrincipal, action, cluster, namespace string) error
+}
+
+// Policy allows the users and members of the groups to take the actions in
+// the clusters and namespaces. Each of them is a list of patterns, as
+// matched by path.Match, e.g. `team-*`. Empty clusters or namespaces match
+// all of them.
+type Policy struct {
+	Name       string   `json:"name"`
+	Users      []string `json:"users,omitempty"`
+	Groups     []string `json:"groups,omitempty"`
+	Actions    []string `json:"actions"`
+	Clusters   []string `json:"clusters,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// ParsePolicies reads the policies from all the keys of a ConfigMap, each of
+// which holds a YAML list of policies.
+func ParsePolicies(cm *corev1.ConfigMap) ([]Policy, error) {
+	keys := []string{}
+	for k := range cm.Data {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	policies := []Policy{}
+
+	for _, k := range keys {
+		var keyPolicies []Policy
+		if err := yaml.UnmarshalStrict([]byte(cm.Data[k]), &keyPolicies); err != nil {
+			return nil, fmt.Errorf("parsing policies in %s: %w", k, err)
+		}
+
+		policies = append(policies, keyPolicies...)
+	}
+
+	return policies, nil
+}
+
+func (p Policy) validate() error {
+	if len(p.Users) == 0 && len(p.Groups) == 0 {
+		return fmt.Errorf("policy %q has no users or groups", p.Name)
+	}
+
+	if len(p.Actions) == 0 {
+		return fmt.Errorf("policy %q has no actions", p.Name)
+	}
+
+	for _, patterns := range [][]string{p.Users, p.Groups, p.Actions, p.Clusters, p.Namespaces} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("policy %q: invalid pattern %q: %w", p.Name, pattern, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p Policy) allows(principal *UserPrincipal, action, cluster, namespace string) bool {
+	if !matchesAny(p.Users, principal.ID) && !matchesAnyOf(p.Groups, principal.Groups) {
+		return false
+	}
+
+	return matchesAny(p.Actions, action) &&
+		(len(p.Clusters) == 0 || matchesAny(p.Clusters, cluster)) &&
+		(len(p.Namespaces) == 0 || matchesAny(p.Namespaces, namespace))
+}
+
+func matchesAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, value); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesAnyOf(patterns, values []string) bool {
+	for _, value := range values {
+		if matchesAny(patterns, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PolicyAuthorizer only allows the actions that one of its policies allows,
+// so it denies everything until policies are set.
+type PolicyAuthorizer struct {
+	mu       sync.RWMutex
+	policies []Policy
+}
+
+// NewPolicyAuthorizer returns a PolicyAuthorizer without any policies.
+func NewPolicyAuthorizer() *PolicyAuthorizer {
+	return &PolicyAuthorizer{}
+}
+
+// SetPolicies replaces all the policies. If any policy is invalid the
+// current policies are kept.
+func (a *PolicyAuthorizer) SetPolicies(policies []Policy) error {
+	for _, p := range policies {
+		if err := p.validate(); err != nil {
+			return err
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.policies = policies
+
+	return nil
+}
+
+// Authorize returns a PermissionDenied error unless a policy allows the
+// action.
+func (a *PolicyAuthorizer) Authorize(principal *UserPrincipal, action, cluster, namespace string) error {
+	if principal == nil {
+		return status.Error(codes.PermissionDenied, "no user to authorize")
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	for _, p := range a.policies {
+		if p.allows(principal, action, cluster, namespace) {
+			return nil
+		}
+	}
+
+	where := "all clusters"
+	if cluster != "" {
+		where = fmt.Sprintf("cluster %q", cluster)
+	}
+
+	if namespace != "" {
+		where = fmt.Sprintf("namespace %q of %s", namespace, where)
+	}
+
+	return status.Errorf(codes.PermissionDenied, "user %q is not allowed to %s in %s", principal.ID, action, where)
+}
+
+// LoadConfigMap replaces the policies with the ones in the ConfigMap, or
+// removes them all if it's nil. It's a configmaps.Loader.
+func (a *PolicyAuthorizer) LoadConfigMap(cm *corev1.ConfigMap) error {
+	if cm == nil {
+		return a.SetPolicies(nil)
+	}
+
+	policies, err := ParsePolicies(cm)
+	if err != nil {
+		return err
+	}
+
+	return a.SetPolicies(policies)
+}
@@ -0,0 +1,99 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/weaveworks/weave-gitops/pkg/configmaps"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+const testPolicies = `
+- name: ops
+  groups: [ops]
+  actions: ["*"]
+- name: team-sync
+  groups: [team-a]
+  users: [bob@example.com]
+  actions: [SyncFluxObject]
+  clusters: [Default]
+  namespaces: [team-a-*]
+`
+
+func TestPolicyAuthorizer(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "policies", Namespace: "flux-system"},
+		Data:       map[string]string{"policies.yaml": testPolicies},
+	}
+
+	authorizer := auth.NewPolicyAuthorizer()
+
+	alice := &auth.UserPrincipal{ID: "alice@example.com", Groups: []string{"team-a"}}
+	bob := &auth.UserPrincipal{ID: "bob@example.com"}
+	carol := &auth.UserPrincipal{ID: "carol@example.com", Groups: []string{"ops"}}
+
+	// Everything is denied until there are policies
+	err := authorizer.Authorize(carol, auth.ActionSyncFluxObject, "Default", "flux-system")
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+	fakeClient := fake.NewClientBuilder().WithObjects(cm).Build()
+	g.Expect(configmaps.Load(context.Background(), fakeClient, client.ObjectKeyFromObject(cm), authorizer)).To(Succeed())
+
+	tests := []struct {
+		name      string
+		principal *auth.UserPrincipal
+		action    string
+		cluster   string
+		namespace string
+		allowed   bool
+	}{
+		{"group member in matching namespace", alice, auth.ActionSyncFluxObject, "Default", "team-a-apps", true},
+		{"user in matching namespace", bob, auth.ActionSyncFluxObject, "Default", "team-a-infra", true},
+		{"action not allowed", alice, auth.ActionToggleSuspendResource, "Default", "team-a-apps", false},
+		{"namespace not allowed", alice, auth.ActionSyncFluxObject, "Default", "team-b", false},
+		{"all namespaces not allowed", alice, auth.ActionSyncFluxObject, "Default", "", false},
+		{"cluster not allowed", alice, auth.ActionSyncFluxObject, "prod", "team-a-apps", false},
+		{"any action anywhere", carol, auth.ActionListAuditEvents, "", "", true},
+		{"no user", nil, auth.ActionSyncFluxObject, "Default", "team-a-apps", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			err := authorizer.Authorize(tt.principal, tt.action, tt.cluster, tt.namespace)
+			if tt.allowed {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			}
+		})
+	}
+
+	// Invalid policies don't replace the current ones
+	g.Expect(authorizer.SetPolicies([]auth.Policy{{Name: "nobody", Actions: []string{"*"}}})).NotTo(Succeed())
+	g.Expect(authorizer.SetPolicies([]auth.Policy{{Name: "bad", Groups: []string{"["}, Actions: []string{"*"}}})).NotTo(Succeed())
+	g.Expect(authorizer.Authorize(carol, auth.ActionSyncFluxObject, "Default", "flux-system")).To(Succeed())
+
+	// The policies are removed with the ConfigMap
+	g.Expect(fakeClient.Delete(context.Background(), cm)).To(Succeed())
+	g.Expect(configmaps.Load(context.Background(), fakeClient, client.ObjectKeyFromObject(cm), authorizer)).To(Succeed())
+	g.Expect(authorizer.Authorize(carol, auth.ActionSyncFluxObject, "Default", "flux-system")).NotTo(Succeed())
+}
+
+func TestParsePolicies(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	_, err := auth.ParsePolicies(&corev1.ConfigMap{Data: map[string]string{"policies.yaml": "- name: ops\n  group: [ops]\n"}})
+	g.Expect(err).To(MatchError(ContainSubstring("policies.yaml")))
+}
@@ -83,8 +83,9 @@ type AuthServerConfig struct {
 // AuthServer interacts with an OIDC issuer to handle the OAuth2 process flow.
 type AuthServer struct {
 	AuthServerConfig
-	provider *oidc.Provider
-	sm       SessionManager
+	provider  *oidc.Provider
+	sm        SessionManager
+	apiTokens *APITokenStore
 }
 
 // LoginRequest represents the data submitted by client when the auth flow (non-OIDC) is used.
@@ -112,6 +113,9 @@ type UserInfo struct {
 // - tokenDuration - defaults to 1 hour.
 // - claimUsername - defaults to "email"
 // - claimGroups - defaults to "groups"
+// - claimGroupsUserInfo - "true" to add the claims the ID token lacks from the userinfo endpoint
+// - groupsFilter - a regular expression the groups must match
+// - groupsMappings - pattern=replacement rules renaming groups, one per line
 // - customScopes - defaults to "openid","offline_access","email","groups"
 func NewOIDCConfigFromSecret(secret corev1.Secret) OIDCConfig {
 	cfg := OIDCConfig{
@@ -154,6 +158,18 @@ func splitAndTrim(s string) []string {
 	return result
 }
 
+func splitLines(s string) []string {
+	var result []string
+
+	for _, line := range strings.Split(s, "\n") {
+		if v := strings.TrimSpace(line); v != "" {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
 func claimsConfigFromSecret(secret corev1.Secret) *ClaimsConfig {
 	claimUsername, ok := secret.Data["claimUsername"]
 	if !ok {
@@ -167,8 +183,11 @@ func claimsConfigFromSecret(secret corev1.Secret) *ClaimsConfig {
 
 	if len(claimUsername) > 0 && len(claimGroups) > 0 {
 		return &ClaimsConfig{
-			Username: string(claimUsername),
-			Groups:   string(claimGroups),
+			Username:       string(claimUsername),
+			Groups:         string(claimGroups),
+			GroupsFilter:   strings.TrimSpace(string(secret.Data["groupsFilter"])),
+			GroupsMappings: splitLines(string(secret.Data["groupsMappings"])),
+			UserInfo:       string(secret.Data["claimGroupsUserInfo"]) == "true",
 		}
 	}
 
@@ -223,6 +242,12 @@ func NewAuthServer(ctx context.Context, cfg *AuthServerConfig) (*AuthServer, err
 			return nil, fmt.Errorf("could not create provider: %w", err)
 		}
 		featureflags.SetBoolean(FeatureFlagOIDCAuth, true)
+
+		if cc := cfg.OIDCConfig.ClaimsConfig; cc != nil && cc.UserInfo {
+			withUserInfo := *cc
+			withUserInfo.userInfo = newUserInfoClaims(provider, cfg.client)
+			cfg.OIDCConfig.ClaimsConfig = &withUserInfo
+		}
 	}
 
 	if cfg.authMethods[Anonymous] {
@@ -235,7 +260,18 @@ func NewAuthServer(ctx context.Context, cfg *AuthServerConfig) (*AuthServer, err
 		return nil, fmt.Errorf("OIDC auth, local auth or anonymous mode must be enabled, can't start")
 	}
 
-	return &AuthServer{*cfg, provider, cfg.SessionManager}, nil
+	var apiTokens *APITokenStore
+	if cfg.authMethods[APITokenAuth] {
+		apiTokens = NewAPITokenStore(cfg.Log, cfg.kubernetesClient, cfg.namespace)
+	}
+
+	return &AuthServer{*cfg, provider, cfg.SessionManager, apiTokens}, nil
+}
+
+// APITokens returns the store of the API tokens, or nil if they're not an
+// enabled auth method.
+func (s *AuthServer) APITokens() *APITokenStore {
+	return s.apiTokens
 }
 
 // SetRedirectURL is used to set the redirect URL. This is meant to be used
@@ -356,13 +392,20 @@ func (s *AuthServer) Callback(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = s.verifier().Verify(r.Context(), rawIDToken)
+	idToken, err := s.verifier().Verify(r.Context(), rawIDToken)
 	if err != nil {
 		JSONError(s.Log, rw, fmt.Sprintf("failed to verify ID token: %v", err), http.StatusInternalServerError)
 		return
 	}
 
+	principal, err := s.OIDCConfig.ClaimsConfig.principalFromTokens(ctx, idToken, token.AccessToken)
+	if err != nil {
+		JSONError(s.Log, rw, fmt.Sprintf("failed to parse ID token claims: %v", err), http.StatusInternalServerError)
+		return
+	}
+
 	s.setCookies(r.Context(), rawIDToken, token.AccessToken, token.RefreshToken)
+	startSession(r.Context(), s.SessionManager, principal.ID)
 	// Clear state cookie
 	s.SessionManager.Remove(r.Context(), StateCookieName)
 
@@ -434,6 +477,7 @@ func (s *AuthServer) SignIn() http.HandlerFunc {
 		}
 
 		s.SessionManager.Put(r.Context(), IDTokenCookieName, signed)
+		startSession(r.Context(), s.SessionManager, loginRequest.Username)
 		rw.WriteHeader(http.StatusOK)
 	}
 }
@@ -492,7 +536,7 @@ func (s *AuthServer) UserInfo(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userPrincipal, err := s.OIDCConfig.ClaimsConfig.PrincipalFromClaims(info)
+	userPrincipal, err := s.OIDCConfig.ClaimsConfig.principalFromTokens(r.Context(), info, s.SessionManager.GetString(r.Context(), AccessTokenCookieName))
 	if err != nil {
 		s.Log.Error(err, "failed to parse user info")
 		JSONError(s.Log, rw, fmt.Sprintf("failed to query user info endpoint: %v", err), http.StatusUnauthorized)
@@ -552,7 +596,7 @@ func (s *AuthServer) Refresh(rw http.ResponseWriter, r *http.Request) (*UserPrin
 
 	s.setCookies(r.Context(), rawIDToken, token.AccessToken, token.RefreshToken)
 
-	return parseJWTToken(ctx, s.verifier(), rawIDToken, s.OIDCConfig.ClaimsConfig, s.Log)
+	return parseJWTToken(ctx, s.verifier(), rawIDToken, token.AccessToken, s.OIDCConfig.ClaimsConfig, s.Log)
 }
 
 func toJSON(rw http.ResponseWriter, ui UserInfo, log logr.Logger) {
@@ -17,6 +17,7 @@ import (
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/oauth2-proxy/mockoidc"
 	. "github.com/onsi/gomega"
 	"golang.org/x/crypto/bcrypt"
@@ -1058,6 +1059,25 @@ func TestNewOIDCConfigFromSecret(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "groups from userinfo, filtered and mapped",
+			data: map[string][]byte{
+				"claimGroupsUserInfo": []byte("true"),
+				"groupsFilter":        []byte("^gitops-"),
+				"groupsMappings":      []byte("^gitops-(.*)$=$1\n\n^gitops-admins$=admins\n"),
+			},
+			want: auth.OIDCConfig{
+				TokenDuration: time.Hour * 1,
+				Scopes:        []string{oidc.ScopeOpenID, oidc.ScopeOfflineAccess, auth.ScopeEmail, auth.ScopeGroups},
+				ClaimsConfig: &auth.ClaimsConfig{
+					Username:       "email",
+					Groups:         "groups",
+					GroupsFilter:   "^gitops-",
+					GroupsMappings: []string{"^gitops-(.*)$=$1", "^gitops-admins$=admins"},
+					UserInfo:       true,
+				},
+			},
+		},
 		{
 			name: "overridden scopes",
 			data: map[string][]byte{
@@ -1078,7 +1098,7 @@ func TestNewOIDCConfigFromSecret(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := auth.NewOIDCConfigFromSecret(corev1.Secret{Data: tt.data})
 
-			if diff := cmp.Diff(tt.want, cfg); diff != "" {
+			if diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(auth.ClaimsConfig{})); diff != "" {
 				t.Fatalf("failed to parse config from secret:\n%s", diff)
 			}
 		})
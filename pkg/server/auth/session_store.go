@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// SessionSecretLabel marks the Secrets holding sessions.
+	SessionSecretLabel = "weave.works/gitops-session"
+
+	sessionSecretPrefix = "gitops-session-"
+	sessionTokenKey     = "token"
+	sessionDataKey      = "data"
+	sessionExpiryKey    = "expiry"
+
+	cleanupSessionsFrequency = 5 * time.Minute
+)
+
+// SecretSessionStore keeps sessions in Secrets, one per session, so they
+// survive the server restarting and are shared by its replicas. It
+// implements scs.CtxStore and scs.IterableCtxStore.
+type SecretSessionStore struct {
+	client    ctrlclient.Client
+	namespace string
+	now       func() time.Time
+}
+
+// NewSecretSessionStore returns a store keeping sessions in Secrets in the
+// namespace.
+func NewSecretSessionStore(client ctrlclient.Client, namespace string) *SecretSessionStore {
+	return &SecretSessionStore{
+		client:    client,
+		namespace: namespace,
+		now:       time.Now,
+	}
+}
+
+// FindCtx returns the data of the session, unless it doesn't exist or has
+// expired.
+func (s *SecretSessionStore) FindCtx(ctx context.Context, token string) ([]byte, bool, error) {
+	secret := &corev1.Secret{}
+
+	if err := s.client.Get(ctx, s.key(token), secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, fmt.Errorf("getting session: %w", err)
+	}
+
+	if string(secret.Data[sessionTokenKey]) != token || s.expired(secret) {
+		return nil, false, nil
+	}
+
+	return secret.Data[sessionDataKey], true, nil
+}
+
+// CommitCtx saves the session's data until it expires.
+func (s *SecretSessionStore) CommitCtx(ctx context.Context, token string, b []byte, expiry time.Time) error {
+	data := map[string][]byte{
+		sessionTokenKey:  []byte(token),
+		sessionDataKey:   b,
+		sessionExpiryKey: []byte(expiry.UTC().Format(time.RFC3339Nano)),
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret := &corev1.Secret{}
+
+		err := s.client.Get(ctx, s.key(token), secret)
+		if apierrors.IsNotFound(err) {
+			key := s.key(token)
+
+			return s.client.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+					Labels:    map[string]string{SessionSecretLabel: "true"},
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: data,
+			})
+		}
+
+		if err != nil {
+			return fmt.Errorf("getting session: %w", err)
+		}
+
+		secret.Data = data
+
+		return s.client.Update(ctx, secret)
+	})
+}
+
+// DeleteCtx removes the session, if it exists.
+func (s *SecretSessionStore) DeleteCtx(ctx context.Context, token string) error {
+	key := s.key(token)
+
+	err := s.client.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("deleting session: %w", err)
+	}
+
+	return nil
+}
+
+// AllCtx returns the data of all the sessions that haven't expired, by
+// token.
+func (s *SecretSessionStore) AllCtx(ctx context.Context) (map[string][]byte, error) {
+	secrets, err := s.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := map[string][]byte{}
+
+	for _, secret := range secrets {
+		if !s.expired(&secret) {
+			sessions[string(secret.Data[sessionTokenKey])] = secret.Data[sessionDataKey]
+		}
+	}
+
+	return sessions, nil
+}
+
+// Find is FindCtx without a context.
+func (s *SecretSessionStore) Find(token string) ([]byte, bool, error) {
+	return s.FindCtx(context.Background(), token)
+}
+
+// Commit is CommitCtx without a context.
+func (s *SecretSessionStore) Commit(token string, b []byte, expiry time.Time) error {
+	return s.CommitCtx(context.Background(), token, b, expiry)
+}
+
+// Delete is DeleteCtx without a context.
+func (s *SecretSessionStore) Delete(token string) error {
+	return s.DeleteCtx(context.Background(), token)
+}
+
+// All is AllCtx without a context.
+func (s *SecretSessionStore) All() (map[string][]byte, error) {
+	return s.AllCtx(context.Background())
+}
+
+// DeleteExpired removes the sessions that have expired.
+func (s *SecretSessionStore) DeleteExpired(ctx context.Context) error {
+	secrets, err := s.list(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range secrets {
+		if !s.expired(&secrets[i]) {
+			continue
+		}
+
+		if err := s.client.Delete(ctx, &secrets[i]); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting expired session: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// StartCleanup deletes expired sessions periodically, until the context is
+// cancelled.
+func (s *SecretSessionStore) StartCleanup(ctx context.Context, log logr.Logger) {
+	_ = wait.PollUntilContextCancel(ctx, cleanupSessionsFrequency, false, func(ctx context.Context) (bool, error) {
+		if err := s.DeleteExpired(ctx); err != nil {
+			log.Error(err, "unable to delete expired sessions")
+		}
+
+		return false, nil
+	})
+}
+
+func (s *SecretSessionStore) list(ctx context.Context) ([]corev1.Secret, error) {
+	list := &corev1.SecretList{}
+
+	if err := s.client.List(ctx, list, ctrlclient.InNamespace(s.namespace), ctrlclient.HasLabels{SessionSecretLabel}); err != nil {
+		return nil, fmt.Errorf("listing sessions: %w", err)
+	}
+
+	return list.Items, nil
+}
+
+// key names the Secret after the session's ID, as tokens aren't valid
+// names.
+func (s *SecretSessionStore) key(token string) ctrlclient.ObjectKey {
+	return ctrlclient.ObjectKey{Namespace: s.namespace, Name: sessionSecretPrefix + SessionID(token)}
+}
+
+func (s *SecretSessionStore) expired(secret *corev1.Secret) bool {
+	expiry, err := time.Parse(time.RFC3339Nano, string(secret.Data[sessionExpiryKey]))
+
+	return err != nil || !s.now().Before(expiry)
+}
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSecretSessionStore(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	fakeClient := fake.NewClientBuilder().Build()
+	store := NewSecretSessionStore(fakeClient, "flux-system")
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	_, found, err := store.FindCtx(ctx, "missing")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(found).To(BeFalse())
+
+	g.Expect(store.CommitCtx(ctx, "token-1", []byte("one"), now.Add(time.Hour))).To(Succeed())
+	g.Expect(store.CommitCtx(ctx, "token-2", []byte("two"), now.Add(time.Minute))).To(Succeed())
+	// Committing again updates the session
+	g.Expect(store.CommitCtx(ctx, "token-1", []byte("uno"), now.Add(time.Hour))).To(Succeed())
+
+	b, found, err := store.FindCtx(ctx, "token-1")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(found).To(BeTrue())
+	g.Expect(string(b)).To(Equal("uno"))
+
+	secrets := &corev1.SecretList{}
+	g.Expect(fakeClient.List(ctx, secrets, client.HasLabels{SessionSecretLabel})).To(Succeed())
+	g.Expect(secrets.Items).To(HaveLen(2))
+	g.Expect(secrets.Items[0].Name).To(HavePrefix(sessionSecretPrefix))
+
+	all, err := store.AllCtx(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(all).To(HaveLen(2))
+
+	now = now.Add(10 * time.Minute)
+
+	_, found, err = store.FindCtx(ctx, "token-2")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(found).To(BeFalse())
+
+	all, err = store.AllCtx(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(all).To(HaveKey("token-1"))
+	g.Expect(all).NotTo(HaveKey("token-2"))
+
+	g.Expect(store.DeleteExpired(ctx)).To(Succeed())
+	g.Expect(fakeClient.List(ctx, secrets, client.HasLabels{SessionSecretLabel})).To(Succeed())
+	g.Expect(secrets.Items).To(HaveLen(1))
+
+	g.Expect(store.DeleteCtx(ctx, "token-1")).To(Succeed())
+	g.Expect(store.DeleteCtx(ctx, "token-1")).To(Succeed())
+
+	_, found, err = store.FindCtx(ctx, "token-1")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(found).To(BeFalse())
+}
+
+func TestSecretSessionStore_SurvivesRestarts(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	fakeClient := fake.NewClientBuilder().Build()
+
+	sm := scs.New()
+	sm.Store = NewSecretSessionStore(fakeClient, "flux-system")
+
+	sessionCtx, err := sm.Load(ctx, "")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	startSession(sessionCtx, sm, "alice@example.com")
+
+	token, _, err := sm.Commit(sessionCtx)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// A new server finds the session, and can revoke it
+	restarted := scs.New()
+	restarted.Store = NewSecretSessionStore(fakeClient, "flux-system")
+
+	sessionCtx, err = restarted.Load(ctx, token)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(restarted.GetString(sessionCtx, sessionUserKey)).To(Equal("alice@example.com"))
+
+	revoked, err := NewSessionAdmin(restarted).Revoke(ctx, "alice@example.com", "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(revoked).To(Equal(1))
+
+	sessionCtx, err = restarted.Load(ctx, token)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(restarted.GetString(sessionCtx, sessionUserKey)).To(BeEmpty())
+}
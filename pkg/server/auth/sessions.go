@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/alexedwards/scs/v2"
+)
+
+const (
+	// The session keys recording who the session is for, and when they
+	// signed in.
+	sessionUserKey      = "user"
+	sessionCreatedAtKey = "created_at"
+)
+
+// ErrSessionNotFound is returned when revoking a session that doesn't exist.
+var ErrSessionNotFound = errors.New("session not found")
+
+// Session describes a signed in user's session, without the session token.
+type Session struct {
+	ID        string
+	User      string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// SessionID identifies a session without revealing its token, which would
+// allow anyone to use the session.
+func SessionID(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:8])
+}
+
+// SessionAdmin lists and revokes the sessions of a SessionManager, whose
+// store must support iteration.
+type SessionAdmin struct {
+	sm *scs.SessionManager
+}
+
+// NewSessionAdmin returns a SessionAdmin for the SessionManager's sessions.
+func NewSessionAdmin(sm *scs.SessionManager) *SessionAdmin {
+	return &SessionAdmin{sm: sm}
+}
+
+// List returns the sessions of the user, or everyone's if the user is empty,
+// newest first. Sessions that aren't signed in yet are left out.
+func (a *SessionAdmin) List(ctx context.Context, user string) ([]Session, error) {
+	sessions := []Session{}
+
+	err := a.sm.Iterate(ctx, func(ctx context.Context) error {
+		if s, ok := a.session(ctx); ok && (user == "" || s.User == user) {
+			sessions = append(sessions, s)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		if !sessions[i].CreatedAt.Equal(sessions[j].CreatedAt) {
+			return sessions[i].CreatedAt.After(sessions[j].CreatedAt)
+		}
+
+		return sessions[i].ID < sessions[j].ID
+	})
+
+	return sessions, nil
+}
+
+// Revoke ends the session with the ID, or all the user's sessions if the ID
+// is empty, returning how many sessions were ended. If both are set the
+// session must belong to the user.
+func (a *SessionAdmin) Revoke(ctx context.Context, user, id string) (int, error) {
+	if user == "" && id == "" {
+		return 0, errors.New("a user or a session ID is required")
+	}
+
+	revoked := 0
+
+	err := a.sm.Iterate(ctx, func(ctx context.Context) error {
+		s, ok := a.session(ctx)
+		if !ok || (user != "" && s.User != user) || (id != "" && s.ID != id) {
+			return nil
+		}
+
+		if err := a.sm.Destroy(ctx); err != nil {
+			return err
+		}
+
+		revoked++
+
+		return nil
+	})
+	if err != nil {
+		return revoked, err
+	}
+
+	if id != "" && revoked == 0 {
+		return 0, ErrSessionNotFound
+	}
+
+	return revoked, nil
+}
+
+func (a *SessionAdmin) session(ctx context.Context) (Session, bool) {
+	user := a.sm.GetString(ctx, sessionUserKey)
+	if user == "" {
+		return Session{}, false
+	}
+
+	// Sessions created before users were recorded have no creation time
+	createdAt, _ := time.Parse(time.RFC3339, a.sm.GetString(ctx, sessionCreatedAtKey))
+
+	return Session{
+		ID:        SessionID(a.sm.Token(ctx)),
+		User:      user,
+		CreatedAt: createdAt,
+		ExpiresAt: a.sm.Deadline(ctx),
+	}, true
+}
+
+// startSession records who the session is for, so it can be found and
+// revoked.
+func startSession(ctx context.Context, sm SessionManager, user string) {
+	sm.Put(ctx, sessionUserKey, user)
+	sm.Put(ctx, sessionCreatedAtKey, time.Now().UTC().Format(time.RFC3339))
+}
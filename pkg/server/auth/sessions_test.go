@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexedwards/scs/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSessionAdmin(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	sm := scs.New()
+	admin := NewSessionAdmin(sm)
+
+	newSession := func(user string) string {
+		ctx, err := sm.Load(ctx, "")
+		g.Expect(err).NotTo(HaveOccurred())
+
+		sm.Put(ctx, IDTokenCookieName, "token")
+
+		if user != "" {
+			startSession(ctx, sm, user)
+		}
+
+		token, _, err := sm.Commit(ctx)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		return SessionID(token)
+	}
+
+	alice1 := newSession("alice@example.com")
+	alice2 := newSession("alice@example.com")
+	bob := newSession("bob@example.com")
+	// Not signed in, so it's not listed
+	newSession("")
+
+	sessions, err := admin.List(ctx, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sessions).To(HaveLen(3))
+	g.Expect(sessions[0].CreatedAt.IsZero()).To(BeFalse())
+	g.Expect(sessions[0].ExpiresAt.After(sessions[0].CreatedAt)).To(BeTrue())
+
+	sessions, err = admin.List(ctx, "alice@example.com")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sessionIDs(sessions)).To(ConsistOf(alice1, alice2))
+
+	_, err = admin.Revoke(ctx, "", "")
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = admin.Revoke(ctx, "bob@example.com", alice1)
+	g.Expect(err).To(MatchError(ErrSessionNotFound))
+
+	revoked, err := admin.Revoke(ctx, "", alice1)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(revoked).To(Equal(1))
+
+	sessions, err = admin.List(ctx, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sessionIDs(sessions)).To(ConsistOf(alice2, bob))
+
+	revoked, err = admin.Revoke(ctx, "alice@example.com", "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(revoked).To(Equal(1))
+
+	sessions, err = admin.List(ctx, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(sessionIDs(sessions)).To(ConsistOf(bob))
+}
+
+func sessionIDs(sessions []Session) []string {
+	ids := []string{}
+	for _, s := range sessions {
+		ids = append(ids, s.ID)
+	}
+
+	return ids
+}
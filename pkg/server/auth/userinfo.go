@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cheshir/ttlcache"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// userInfoCacheTTL is how long the userinfo claims of an access token
+	// are used before they're fetched again.
+	userInfoCacheTTL        = 5 * time.Minute
+	userInfoCacheResolution = 30 * time.Second
+)
+
+// userInfoClaims fetches the claims from the provider's userinfo endpoint,
+// caching them per access token.
+type userInfoClaims struct {
+	provider *oidc.Provider
+	client   *http.Client
+	cache    *ttlcache.Cache
+}
+
+type cachedUserInfo struct {
+	// tokenHash guards against the cache's keys colliding, which would
+	// give a user someone else's claims.
+	tokenHash string
+	claims    map[string]interface{}
+}
+
+func newUserInfoClaims(provider *oidc.Provider, client *http.Client) *userInfoClaims {
+	return &userInfoClaims{
+		provider: provider,
+		client:   client,
+		cache:    ttlcache.New(userInfoCacheResolution),
+	}
+}
+
+func (u *userInfoClaims) claims(ctx context.Context, accessToken string) (map[string]interface{}, error) {
+	hash := sha256.Sum256([]byte(accessToken))
+	tokenHash := hex.EncodeToString(hash[:])
+	key := ttlcache.StringKey(tokenHash)
+
+	if v, ok := u.cache.Get(key); ok {
+		if cached, ok := v.(cachedUserInfo); ok && cached.tokenHash == tokenHash {
+			return cached.claims, nil
+		}
+	}
+
+	info, err := u.provider.UserInfo(oidc.ClientContext(ctx, u.client), oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the userinfo endpoint: %w", err)
+	}
+
+	claims := map[string]interface{}{}
+	if err := info.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("failed to parse userinfo claims: %w", err)
+	}
+
+	u.cache.Set(key, cachedUserInfo{tokenHash: tokenHash, claims: claims}, userInfoCacheTTL)
+
+	return claims, nil
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	. "github.com/onsi/gomega"
+)
+
+type fakeIDToken map[string]interface{}
+
+func (t fakeIDToken) Claims(v interface{}) error {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+func TestPrincipalFromTokensWithUserInfo(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	calls := 0
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(rw http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(rw, `{"issuer": %q, "userinfo_endpoint": %q}`, srv.URL, srv.URL+"/userinfo")
+	})
+	mux.HandleFunc("/userinfo", func(rw http.ResponseWriter, r *http.Request) {
+		calls++
+
+		if r.Header.Get("Authorization") != "Bearer access-token" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		fmt.Fprint(rw, `{"sub": "jane", "email": "admin@example.com", "groups": ["gitops-team-a", "sales"]}`)
+	})
+
+	provider, err := oidc.NewProvider(ctx, srv.URL)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	cc := &ClaimsConfig{
+		GroupsFilter:   "^gitops-",
+		GroupsMappings: []string{"^gitops-(.*)$=$1"},
+		userInfo:       newUserInfoClaims(provider, srv.Client()),
+	}
+	g.Expect(cc.Validate()).To(Succeed())
+
+	// The ID token has no groups, and its email isn't overridden by the
+	// userinfo one
+	idToken := fakeIDToken{"sub": "jane", "email": "jane@example.com"}
+
+	principal, err := cc.principalFromTokens(ctx, idToken, "access-token")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(principal.ID).To(Equal("jane@example.com"))
+	g.Expect(principal.Groups).To(Equal([]string{"team-a"}))
+
+	// The userinfo claims are cached per access token
+	_, err = cc.principalFromTokens(ctx, idToken, "access-token")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(calls).To(Equal(1))
+
+	_, err = cc.principalFromTokens(ctx, idToken, "other-token")
+	g.Expect(err).To(MatchError(ContainSubstring("userinfo")))
+	g.Expect(calls).To(Equal(2))
+
+	// The userinfo response must be about the ID token's subject
+	_, err = cc.principalFromTokens(ctx, fakeIDToken{"sub": "john", "email": "john@example.com"}, "access-token")
+	g.Expect(err).To(MatchError(ContainSubstring("doesn't match")))
+
+	// Without an access token only the ID token is used
+	principal, err = cc.principalFromTokens(ctx, idToken, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(principal.Groups).To(BeEmpty())
+}
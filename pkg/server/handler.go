@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	core "github.com/weaveworks/weave-gitops/core/server"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
@@ -25,7 +26,7 @@ type Config struct {
 // NewHandlers creates and returns a new server configured to serve the core
 // application.
 func NewHandlers(ctx context.Context, log logr.Logger, cfg *Config, sm auth.SessionManager) (http.Handler, error) {
-	mux := runtime.NewServeMux(middleware.WithGrpcErrorLogging(log))
+	mux := runtime.NewServeMux(middleware.WithGrpcErrorLogging(log), middleware.WithRequestIDMetadata(), middleware.WithRouteSpanNames())
 
 	if err := core.Hydrate(ctx, mux, cfg.CoreServerConfig); err != nil {
 		return nil, fmt.Errorf("could not start up core servers: %w", err)
@@ -33,5 +34,9 @@ func NewHandlers(ctx context.Context, log logr.Logger, cfg *Config, sm auth.Sess
 
 	httpHandler := auth.WithAPIAuth(mux, cfg.AuthServer, PublicRoutes, sm)
 
+	// Each request gets a span, continuing the trace of the incoming
+	// headers. It's a no-op unless a tracer provider has been set up.
+	httpHandler = otelhttp.NewHandler(httpHandler, "gitops-server")
+
 	return httpHandler, nil
 }
@@ -7,7 +7,10 @@ import (
 	"strings"
 
 	"github.com/go-logr/logr"
+	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc/metadata"
 
@@ -25,6 +28,16 @@ func (r *statusRecorder) WriteHeader(status int) {
 	r.ResponseWriter.WriteHeader(status)
 }
 
+// Flush lets streaming responses be flushed through the recorder.
+func (r *statusRecorder) Flush() {
+	_ = http.NewResponseController(r.ResponseWriter).Flush()
+}
+
+// Unwrap returns the underlying ResponseWriter, for use by http.ResponseController.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
 var (
 	RequestOkText    = "request success"
 	RequestErrorText = "request error"
@@ -44,6 +57,52 @@ func WithGrpcErrorLogging(log logr.Logger) runtime.ServeMuxOption {
 	})
 }
 
+// WithRequestID gives requests without an X-Request-Id header a new ID, and
+// returns the ID in the response.
+func WithRequestID(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if id == "" {
+			id = uuid.NewString()
+			r.Header.Set(RequestIDHeader, id)
+		}
+
+		w.Header().Set(RequestIDHeader, id)
+		h.ServeHTTP(w, r)
+	})
+}
+
+// WithRequestIDMetadata passes the X-Request-Id header to the server RPC
+// handlers in the gRPC metadata, along with the headers passed by default.
+func WithRequestIDMetadata() runtime.ServeMuxOption {
+	return runtime.WithIncomingHeaderMatcher(func(header string) (string, bool) {
+		if http.CanonicalHeaderKey(header) == RequestIDHeader {
+			return RequestIDHeader, true
+		}
+
+		return runtime.DefaultHeaderMatcher(header)
+	})
+}
+
+// WithRouteSpanNames names the span of each request handled by the
+// ServeMux after the route it matched, e.g. GET /v1/object/{name=*}, rather
+// than its path, so the spans of an RPC can be grouped together.
+func WithRouteSpanNames() runtime.ServeMuxOption {
+	return runtime.WithMiddlewares(func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			if pattern, ok := runtime.HTTPPattern(r.Context()); ok {
+				route := pattern.String()
+
+				span := trace.SpanFromContext(r.Context())
+				span.SetName(r.Method + " " + route)
+				span.SetAttributes(semconv.HTTPRoute(route))
+			}
+
+			next(w, r, pathParams)
+		}
+	})
+}
+
 // WithLogging adds basic logging for HTTP requests.
 func WithLogging(log logr.Logger, h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -79,6 +138,9 @@ const (
 	tokenKey               key = iota
 	GRPCAuthMetadataKey        = "grpc-auth"
 	GitProviderTokenHeader     = "Git-Provider-Token"
+	// RequestIDHeader holds the ID of a request, so what it did can be
+	// traced through the logs.
+	RequestIDHeader = "X-Request-Id"
 )
 
 // Injects the token into the request context to be retrieved later.
@@ -0,0 +1,39 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
+)
+
+func TestWithRouteSpanNames(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	mux := runtime.NewServeMux(middleware.WithRouteSpanNames())
+	g.Expect(mux.HandlePath(http.MethodGet, "/v1/object/{name}", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		w.WriteHeader(http.StatusOK)
+	})).To(Succeed())
+
+	handler := otelhttp.NewHandler(mux, "test", otelhttp.WithTracerProvider(provider))
+
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/v1/object/podinfo", nil))
+	g.Expect(res.Code).To(Equal(http.StatusOK))
+
+	spans := recorder.Ended()
+	g.Expect(spans).To(HaveLen(1))
+	g.Expect(spans[0].Name()).To(Equal("GET /v1/object/{name=*}"))
+	g.Expect(spans[0].Attributes()).To(ContainElement(attribute.String("http.route", "/v1/object/{name=*}")))
+}
@@ -0,0 +1,153 @@
+// Package tracing sets up the OpenTelemetry tracing of the server, exporting
+// the spans of requests and of the calls to the clusters.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Exporters
+const (
+	ExporterNone     = "none"
+	ExporterOTLPGRPC = "otlp-grpc"
+	ExporterOTLPHTTP = "otlp-http"
+	ExporterStdout   = "stdout"
+	ExporterFile     = "file"
+)
+
+// ServiceName is the name of the service the spans are recorded for.
+const ServiceName = "weave-gitops"
+
+// AllExporters returns the names of the exporters.
+func AllExporters() []string {
+	return []string{ExporterNone, ExporterOTLPGRPC, ExporterOTLPHTTP, ExporterStdout, ExporterFile}
+}
+
+// Options configures where the spans are exported.
+type Options struct {
+	// Exporter is one of the exporters, tracing is disabled if empty or
+	// none.
+	Exporter string
+	// Endpoint is the URL of the OTLP collector, e.g.
+	// http://otel-collector:4318. The OTEL_EXPORTER_OTLP_* environment
+	// variables are used if empty.
+	Endpoint string
+	// File is the path of the file the spans are appended to, as JSON lines,
+	// with the file exporter.
+	File string
+}
+
+// Validate returns an error if the options are invalid.
+func (o Options) Validate() error {
+	switch o.Exporter {
+	case "", ExporterNone, ExporterOTLPGRPC, ExporterOTLPHTTP, ExporterStdout:
+	case ExporterFile:
+		if o.File == "" {
+			return errors.New("the file tracing exporter needs a file to be set")
+		}
+	default:
+		return fmt.Errorf("invalid tracing exporter %q, valid values are %s", o.Exporter, strings.Join(AllExporters(), ","))
+	}
+
+	return nil
+}
+
+// Setup sets the global tracer provider to one exporting the spans as
+// configured, and the propagator of the W3C trace context and baggage. The
+// returned function flushes the remaining spans and shuts the exporter down.
+// When tracing is disabled, the global no-op tracer provider is left in
+// place.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	noop := func(context.Context) error { return nil }
+
+	if opts.Exporter == "" || opts.Exporter == ExporterNone {
+		return noop, nil
+	}
+
+	exporter, closer, err := newExporter(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s tracing exporter: %w", opts.Exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(ServiceName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closer != nil {
+			err = errors.Join(err, closer.Close())
+		}
+
+		return err
+	}, nil
+}
+
+func newExporter(ctx context.Context, opts Options) (sdktrace.SpanExporter, io.Closer, error) {
+	switch opts.Exporter {
+	case ExporterOTLPGRPC:
+		var grpcOpts []otlptracegrpc.Option
+		if opts.Endpoint != "" {
+			grpcOpts = append(grpcOpts, otlptracegrpc.WithEndpointURL(opts.Endpoint))
+		}
+
+		exporter, err := otlptracegrpc.New(ctx, grpcOpts...)
+
+		return exporter, nil, err
+	case ExporterOTLPHTTP:
+		var httpOpts []otlptracehttp.Option
+		if opts.Endpoint != "" {
+			httpOpts = append(httpOpts, otlptracehttp.WithEndpointURL(opts.Endpoint))
+		}
+
+		exporter, err := otlptracehttp.New(ctx, httpOpts...)
+
+		return exporter, nil, err
+	case ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+
+		return exporter, nil, err
+	case ExporterFile:
+		f, err := os.OpenFile(opts.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, nil, err
+		}
+
+		return exporter, f, nil
+	}
+
+	return nil, nil, fmt.Errorf("unknown exporter %q", opts.Exporter)
+}
@@ -0,0 +1,88 @@
+package tracing_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/weaveworks/weave-gitops/pkg/tracing"
+)
+
+func TestSetupFileExporter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	file := filepath.Join(t.TempDir(), "traces.json")
+
+	shutdown, err := tracing.Setup(context.Background(), tracing.Options{Exporter: tracing.ExporterFile, File: file})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// The trace context of incoming requests is continued
+	carrier := propagation.HeaderCarrier{}
+	carrier.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+
+	_, span := otel.Tracer("test").Start(ctx, "test-span")
+	span.End()
+
+	g.Expect(shutdown(context.Background())).To(Succeed())
+
+	data, err := os.ReadFile(file)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(data)).To(ContainSubstring(`"Name":"test-span"`))
+	g.Expect(string(data)).To(ContainSubstring(`"TraceID":"4bf92f3577b34da6a3ce929d0e0e4736"`))
+	g.Expect(string(data)).To(ContainSubstring(tracing.ServiceName))
+}
+
+func TestSetupDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	shutdown, err := tracing.Setup(context.Background(), tracing.Options{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(shutdown(context.Background())).To(Succeed())
+}
+
+func TestOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    tracing.Options
+		wantErr string
+	}{
+		{
+			name: "disabled",
+			opts: tracing.Options{},
+		},
+		{
+			name: "otlp",
+			opts: tracing.Options{Exporter: tracing.ExporterOTLPHTTP, Endpoint: "http://localhost:4318"},
+		},
+		{
+			name:    "file without a path",
+			opts:    tracing.Options{Exporter: tracing.ExporterFile},
+			wantErr: "needs a file",
+		},
+		{
+			name:    "unknown exporter",
+			opts:    tracing.Options{Exporter: "jaeger"},
+			wantErr: `invalid tracing exporter "jaeger"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			err := tt.opts.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
@@ -15,7 +15,11 @@ import {
   RequestError,
   SearchedNamespaces,
 } from "../lib/types";
-import { notifyError, notifySuccess } from "../lib/utils";
+import {
+  failedResultsMessage,
+  notifyError,
+  notifySuccess,
+} from "../lib/utils";
 import { convertResponse } from "./objects";
 
 type Res = {
@@ -78,8 +82,14 @@ export function useSyncFluxObject(objs: ObjectRef[]) {
     RequestError,
     SyncFluxObjectRequest
   >({
-    mutationFn: ({ withSource }) =>
-      api.SyncFluxObject({ objects: objs, withSource }),
+    mutationFn: async ({ withSource }) => {
+      const res = await api.SyncFluxObject({ objects: objs, withSource });
+      const failed = failedResultsMessage(res.results);
+      if (failed) {
+        throw new Error(failed);
+      }
+      return res;
+    },
     onSuccess: () => notifySuccess("Sync request successful!"),
     onError: (error) => notifyError(error.message),
   });
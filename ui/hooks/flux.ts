@@ -17,7 +17,11 @@ import {
   ReactQueryOptions,
   RequestError,
 } from "../lib/types";
-import { notifyError, notifySuccess } from "../lib/utils";
+import {
+  failedResultsMessage,
+  notifyError,
+  notifySuccess,
+} from "../lib/utils";
 export function useListFluxRuntimeObjects(
   clusterName = DefaultCluster,
   namespace = NoNamespace,
@@ -132,7 +136,14 @@ export function useToggleSuspend(
   const { api } = useContext(CoreClientContext);
   const queryClient = useQueryClient();
   const mutation = useMutation<ToggleSuspendResourceResponse, RequestError>({
-    mutationFn: () => api.ToggleSuspendResource(req),
+    mutationFn: async () => {
+      const res = await api.ToggleSuspendResource(req);
+      const failed = failedResultsMessage(res.results);
+      if (failed) {
+        throw new Error(failed);
+      }
+      return res;
+    },
     onSuccess: () => {
       const suspend = req.suspend ? "Suspend" : "Resume";
       notifySuccess(`${suspend} request successful!`);
@@ -6,6 +6,7 @@ import {
   convertGitURLToGitProvider,
   convertImage,
   createYamlCommand,
+  failedResultsMessage,
   formatLogTimestamp,
   formatMetadataKey,
   getAppVersion,
@@ -77,6 +78,34 @@ describe("utils lib", () => {
       expect(isHTTP("//.com")).toEqual(false);
     });
   });
+  describe("failedResultsMessage", () => {
+    it("lists the failed objects", () => {
+      expect(
+        failedResultsMessage([
+          {
+            object: {
+              kind: "Kustomization",
+              namespace: "flux-system",
+              name: "a",
+            },
+            status: "succeeded",
+          },
+          {
+            object: {
+              kind: "Kustomization",
+              namespace: "flux-system",
+              name: "b",
+            },
+            status: "failed",
+            error: "not found",
+          },
+        ]),
+      ).toEqual("Kustomization flux-system/b: not found");
+    });
+    it("is empty when nothing failed", () => {
+      expect(failedResultsMessage(undefined)).toEqual("");
+    });
+  });
   describe("isAllowedLink", () => {
     it("allows http", () => {
       expect(isAllowedLink("http://www.google.com")).toEqual(true);
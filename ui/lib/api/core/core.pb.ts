@@ -17,6 +17,239 @@ export type GetInventoryRequest = {
 
 export type GetInventoryResponse = {
   entries?: Gitops_coreV1Types.InventoryEntry[]
+  healthSummary?: Gitops_coreV1Types.HealthSummary
+}
+
+export type GetApplicationHealthRequest = {
+  kind?: string
+  name?: string
+  namespace?: string
+  clusterName?: string
+  withChildren?: boolean
+}
+
+export type GetApplicationHealthResponse = {
+  summary?: Gitops_coreV1Types.HealthSummary
+}
+
+export type GetDependencyGraphRequest = {
+  clusterName?: string
+  namespace?: string
+}
+
+export type GetDependencyGraphResponse = {
+  nodes?: Gitops_coreV1Types.DependencyNode[]
+  edges?: Gitops_coreV1Types.DependencyEdge[]
+  cycles?: Gitops_coreV1Types.DependencyCycle[]
+  errors?: ListError[]
+}
+
+export type DiffKustomizationRequest = {
+  name?: string
+  namespace?: string
+  clusterName?: string
+}
+
+export type DiffKustomizationResponse = {
+  revision?: string
+  diffs?: Gitops_coreV1Types.ObjectDiff[]
+}
+
+export type ListAuditEventsRequest = {
+  clusterName?: string
+  namespace?: string
+  kind?: string
+  name?: string
+  principal?: string
+  limit?: number
+}
+
+export type ListAuditEventsResponse = {
+  events?: Gitops_coreV1Types.AuditEvent[]
+}
+
+export type CreateAPITokenRequest = {
+  name?: string
+  scopes?: string[]
+  ttl?: string
+}
+
+export type CreateAPITokenResponse = {
+  token?: string
+  details?: Gitops_coreV1Types.APIToken
+}
+
+export type ListAPITokensRequest = {
+}
+
+export type ListAPITokensResponse = {
+  tokens?: Gitops_coreV1Types.APIToken[]
+}
+
+export type RevokeAPITokenRequest = {
+  id?: string
+}
+
+export type RevokeAPITokenResponse = {
+}
+
+export type ListSessionsRequest = {
+  user?: string
+}
+
+export type ListSessionsResponse = {
+  sessions?: Gitops_coreV1Types.Session[]
+}
+
+export type RevokeSessionsRequest = {
+  user?: string
+  id?: string
+}
+
+export type RevokeSessionsResponse = {
+  revoked?: number
+}
+
+export type GetOwningAutomationRequest = {
+  clusterName?: string
+  kind?: string
+  name?: string
+  namespace?: string
+  apiVersion?: string
+}
+
+export type GetOwningAutomationResponse = {
+  owners?: Gitops_coreV1Types.OwningAutomation[]
+  warnings?: string[]
+}
+
+export type SearchRequest = {
+  query?: string
+  kind?: string
+  name?: string
+  labelSelector?: string
+  annotations?: string[]
+  sourceRef?: Gitops_coreV1Types.ObjectRef
+  message?: string
+  inventoryMember?: Gitops_coreV1Types.ObjectRef
+  clusterName?: string
+  limit?: number
+}
+
+export type SearchResponse = {
+  results?: Gitops_coreV1Types.SearchResult[]
+  total?: number
+  indexedAt?: string
+}
+
+export type GetDriftRequest = {
+  clusterName?: string
+  kind?: string
+  name?: string
+  namespace?: string
+}
+
+export type GetDriftResponse = {
+  revision?: string
+  checked?: number
+  objects?: Gitops_coreV1Types.ObjectDrift[]
+  warnings?: string[]
+}
+
+export type ListHelmReleaseRevisionsRequest = {
+  clusterName?: string
+  name?: string
+  namespace?: string
+}
+
+export type ListHelmReleaseRevisionsResponse = {
+  revisions?: Gitops_coreV1Types.HelmReleaseRevision[]
+}
+
+export type RollbackHelmReleaseRequest = {
+  clusterName?: string
+  name?: string
+  namespace?: string
+  version?: number
+  comment?: string
+}
+
+export type RollbackHelmReleaseResponse = {
+  chartVersion?: string
+  warnings?: string[]
+}
+
+export type ListArtifactFilesRequest = {
+  clusterName?: string
+  kind?: string
+  name?: string
+  namespace?: string
+  directory?: string
+}
+
+export type ListArtifactFilesResponse = {
+  revision?: string
+  digest?: string
+  files?: Gitops_coreV1Types.ArtifactFile[]
+}
+
+export type GetArtifactFileRequest = {
+  clusterName?: string
+  kind?: string
+  name?: string
+  namespace?: string
+  path?: string
+}
+
+export type GetArtifactFileResponse = {
+  revision?: string
+  digest?: string
+  path?: string
+  size?: string
+  content?: Uint8Array
+  truncated?: boolean
+}
+
+export type ListTerraformObjectsRequest = {
+  clusterName?: string
+  namespace?: string
+}
+
+export type ListTerraformObjectsResponse = {
+  objects?: Gitops_coreV1Types.TerraformObject[]
+  errors?: ListError[]
+}
+
+export type GetTerraformPlanRequest = {
+  clusterName?: string
+  name?: string
+  namespace?: string
+}
+
+export type GetTerraformPlanResponse = {
+  planId?: string
+  format?: string
+  output?: string
+}
+
+export type ApproveTerraformPlanRequest = {
+  clusterName?: string
+  name?: string
+  namespace?: string
+  planId?: string
+}
+
+export type ApproveTerraformPlanResponse = {
+  planId?: string
+}
+
+export type ReplanTerraformObjectRequest = {
+  clusterName?: string
+  name?: string
+  namespace?: string
+}
+
+export type ReplanTerraformObjectResponse = {
 }
 
 export type PolicyValidation = {
@@ -147,6 +380,27 @@ export type ListObjectsRequest = {
   kind?: string
   clusterName?: string
   labels?: {[key: string]: string}
+  pageSize?: number
+  pageToken?: string
+  ready?: string
+  suspended?: string
+  sourceRef?: Gitops_coreV1Types.ObjectRef
+  nameContains?: string
+  orderBy?: string
+  descending?: boolean
+}
+
+export type WatchObjectsRequest = {
+  namespace?: string
+  kind?: string
+  clusterName?: string
+  labels?: {[key: string]: string}
+}
+
+export type WatchObjectsResponse = {
+  type?: string
+  object?: Gitops_coreV1Types.Object
+  error?: ListError
 }
 
 export type ClusterNamespaceList = {
@@ -158,6 +412,8 @@ export type ListObjectsResponse = {
   objects?: Gitops_coreV1Types.Object[]
   errors?: ListError[]
   searchedNamespaces?: ClusterNamespaceList[]
+  nextPageToken?: string
+  total?: number
 }
 
 export type GetReconciledObjectsRequest = {
@@ -205,12 +461,25 @@ export type ListEventsResponse = {
   events?: Gitops_coreV1Types.Event[]
 }
 
+export type GetReconciliationHistoryRequest = {
+  name?: string
+  namespace?: string
+  kind?: string
+  clusterName?: string
+}
+
+export type GetReconciliationHistoryResponse = {
+  records?: Gitops_coreV1Types.ReconciliationRecord[]
+}
+
 export type SyncFluxObjectRequest = {
   objects?: Gitops_coreV1Types.ObjectRef[]
   withSource?: boolean
+  cascade?: boolean
 }
 
 export type SyncFluxObjectResponse = {
+  results?: Gitops_coreV1Types.ObjectResult[]
 }
 
 export type GetVersionRequest = {
@@ -235,9 +504,15 @@ export type ToggleSuspendResourceRequest = {
   objects?: Gitops_coreV1Types.ObjectRef[]
   suspend?: boolean
   comment?: string
+  cascade?: boolean
+  suspendUntil?: string
+  freezeSchedule?: string
+  freezeDuration?: string
+  clearFreezeSchedule?: boolean
 }
 
 export type ToggleSuspendResourceResponse = {
+  results?: Gitops_coreV1Types.ObjectResult[]
 }
 
 export type GetSessionLogsRequest = {
@@ -263,6 +538,21 @@ export type GetSessionLogsResponse = {
   logSources?: string[]
 }
 
+export type GetControllerLogsRequest = {
+  clusterName?: string
+  kind?: string
+  name?: string
+  namespace?: string
+  sinceSeconds?: string
+  follow?: boolean
+  logLevelFilter?: string
+}
+
+export type GetControllerLogsResponse = {
+  entry?: LogEntry
+  error?: ListError
+}
+
 export type IsCRDAvailableRequest = {
   name?: string
 }
@@ -341,6 +631,9 @@ export class Core {
   static ListObjects(req: ListObjectsRequest, initReq?: fm.InitReq): Promise<ListObjectsResponse> {
     return fm.fetchReq<ListObjectsRequest, ListObjectsResponse>(`/v1/objects`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static WatchObjects(req: WatchObjectsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<WatchObjectsResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<WatchObjectsRequest, WatchObjectsResponse>(`/v1/watch_objects`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static ListFluxRuntimeObjects(req: ListFluxRuntimeObjectsRequest, initReq?: fm.InitReq): Promise<ListFluxRuntimeObjectsResponse> {
     return fm.fetchReq<ListFluxRuntimeObjectsRequest, ListFluxRuntimeObjectsResponse>(`/v1/flux_runtime_objects?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
@@ -368,6 +661,9 @@ export class Core {
   static ListEvents(req: ListEventsRequest, initReq?: fm.InitReq): Promise<ListEventsResponse> {
     return fm.fetchReq<ListEventsRequest, ListEventsResponse>(`/v1/events?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
+  static GetReconciliationHistory(req: GetReconciliationHistoryRequest, initReq?: fm.InitReq): Promise<GetReconciliationHistoryResponse> {
+    return fm.fetchReq<GetReconciliationHistoryRequest, GetReconciliationHistoryResponse>(`/v1/reconciliation_history?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
   static SyncFluxObject(req: SyncFluxObjectRequest, initReq?: fm.InitReq): Promise<SyncFluxObjectResponse> {
     return fm.fetchReq<SyncFluxObjectRequest, SyncFluxObjectResponse>(`/v1/sync`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
@@ -383,12 +679,75 @@ export class Core {
   static GetSessionLogs(req: GetSessionLogsRequest, initReq?: fm.InitReq): Promise<GetSessionLogsResponse> {
     return fm.fetchReq<GetSessionLogsRequest, GetSessionLogsResponse>(`/v1/session_logs`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static GetControllerLogs(req: GetControllerLogsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<GetControllerLogsResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<GetControllerLogsRequest, GetControllerLogsResponse>(`/v1/controller_logs`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static IsCRDAvailable(req: IsCRDAvailableRequest, initReq?: fm.InitReq): Promise<IsCRDAvailableResponse> {
     return fm.fetchReq<IsCRDAvailableRequest, IsCRDAvailableResponse>(`/v1/crd/is_available?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
   static GetInventory(req: GetInventoryRequest, initReq?: fm.InitReq): Promise<GetInventoryResponse> {
     return fm.fetchReq<GetInventoryRequest, GetInventoryResponse>(`/v1/inventory?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
+  static GetApplicationHealth(req: GetApplicationHealthRequest, initReq?: fm.InitReq): Promise<GetApplicationHealthResponse> {
+    return fm.fetchReq<GetApplicationHealthRequest, GetApplicationHealthResponse>(`/v1/application_health?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static GetDependencyGraph(req: GetDependencyGraphRequest, initReq?: fm.InitReq): Promise<GetDependencyGraphResponse> {
+    return fm.fetchReq<GetDependencyGraphRequest, GetDependencyGraphResponse>(`/v1/dependency_graph?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static DiffKustomization(req: DiffKustomizationRequest, initReq?: fm.InitReq): Promise<DiffKustomizationResponse> {
+    return fm.fetchReq<DiffKustomizationRequest, DiffKustomizationResponse>(`/v1/diff_kustomization`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListAuditEvents(req: ListAuditEventsRequest, initReq?: fm.InitReq): Promise<ListAuditEventsResponse> {
+    return fm.fetchReq<ListAuditEventsRequest, ListAuditEventsResponse>(`/v1/audit_events?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static CreateAPIToken(req: CreateAPITokenRequest, initReq?: fm.InitReq): Promise<CreateAPITokenResponse> {
+    return fm.fetchReq<CreateAPITokenRequest, CreateAPITokenResponse>(`/v1/api_tokens`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListAPITokens(req: ListAPITokensRequest, initReq?: fm.InitReq): Promise<ListAPITokensResponse> {
+    return fm.fetchReq<ListAPITokensRequest, ListAPITokensResponse>(`/v1/api_tokens?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static RevokeAPIToken(req: RevokeAPITokenRequest, initReq?: fm.InitReq): Promise<RevokeAPITokenResponse> {
+    return fm.fetchReq<RevokeAPITokenRequest, RevokeAPITokenResponse>(`/v1/api_tokens/revoke`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListSessions(req: ListSessionsRequest, initReq?: fm.InitReq): Promise<ListSessionsResponse> {
+    return fm.fetchReq<ListSessionsRequest, ListSessionsResponse>(`/v1/sessions?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static RevokeSessions(req: RevokeSessionsRequest, initReq?: fm.InitReq): Promise<RevokeSessionsResponse> {
+    return fm.fetchReq<RevokeSessionsRequest, RevokeSessionsResponse>(`/v1/sessions/revoke`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static GetOwningAutomation(req: GetOwningAutomationRequest, initReq?: fm.InitReq): Promise<GetOwningAutomationResponse> {
+    return fm.fetchReq<GetOwningAutomationRequest, GetOwningAutomationResponse>(`/v1/owning_automation?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static Search(req: SearchRequest, initReq?: fm.InitReq): Promise<SearchResponse> {
+    return fm.fetchReq<SearchRequest, SearchResponse>(`/v1/search`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static GetDrift(req: GetDriftRequest, initReq?: fm.InitReq): Promise<GetDriftResponse> {
+    return fm.fetchReq<GetDriftRequest, GetDriftResponse>(`/v1/drift?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static ListHelmReleaseRevisions(req: ListHelmReleaseRevisionsRequest, initReq?: fm.InitReq): Promise<ListHelmReleaseRevisionsResponse> {
+    return fm.fetchReq<ListHelmReleaseRevisionsRequest, ListHelmReleaseRevisionsResponse>(`/v1/helmrelease/revisions?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static RollbackHelmRelease(req: RollbackHelmReleaseRequest, initReq?: fm.InitReq): Promise<RollbackHelmReleaseResponse> {
+    return fm.fetchReq<RollbackHelmReleaseRequest, RollbackHelmReleaseResponse>(`/v1/helmrelease/rollback`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListArtifactFiles(req: ListArtifactFilesRequest, initReq?: fm.InitReq): Promise<ListArtifactFilesResponse> {
+    return fm.fetchReq<ListArtifactFilesRequest, ListArtifactFilesResponse>(`/v1/artifact/files?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static GetArtifactFile(req: GetArtifactFileRequest, initReq?: fm.InitReq): Promise<GetArtifactFileResponse> {
+    return fm.fetchReq<GetArtifactFileRequest, GetArtifactFileResponse>(`/v1/artifact/file?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static ListTerraformObjects(req: ListTerraformObjectsRequest, initReq?: fm.InitReq): Promise<ListTerraformObjectsResponse> {
+    return fm.fetchReq<ListTerraformObjectsRequest, ListTerraformObjectsResponse>(`/v1/terraform_objects?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static GetTerraformPlan(req: GetTerraformPlanRequest, initReq?: fm.InitReq): Promise<GetTerraformPlanResponse> {
+    return fm.fetchReq<GetTerraformPlanRequest, GetTerraformPlanResponse>(`/v1/terraform_objects/plan?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static ApproveTerraformPlan(req: ApproveTerraformPlanRequest, initReq?: fm.InitReq): Promise<ApproveTerraformPlanResponse> {
+    return fm.fetchReq<ApproveTerraformPlanRequest, ApproveTerraformPlanResponse>(`/v1/terraform_objects/approve`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ReplanTerraformObject(req: ReplanTerraformObjectRequest, initReq?: fm.InitReq): Promise<ReplanTerraformObjectResponse> {
+    return fm.fetchReq<ReplanTerraformObjectRequest, ReplanTerraformObjectResponse>(`/v1/terraform_objects/replan`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
   static ListPolicies(req: ListPoliciesRequest, initReq?: fm.InitReq): Promise<ListPoliciesResponse> {
     return fm.fetchReq<ListPoliciesRequest, ListPoliciesResponse>(`/v1/policies?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
@@ -40,6 +40,14 @@ export type ObjectRef = {
   clusterName?: string
 }
 
+export type ObjectResult = {
+  object?: ObjectRef
+  status?: string
+  error?: string
+  revision?: string
+  dependent?: boolean
+}
+
 export type Condition = {
   type?: string
   status?: string
@@ -77,6 +85,18 @@ export type InventoryEntry = {
   clusterName?: string
   health?: HealthStatus
   children?: InventoryEntry[]
+  rollupHealth?: HealthStatus
+}
+
+export type HealthSummary = {
+  status?: string
+  counts?: {[key: string]: number}
+  offenders?: HealthOffender[]
+}
+
+export type HealthOffender = {
+  object?: ObjectRef
+  health?: HealthStatus
 }
 
 export type Object = {
@@ -121,6 +141,49 @@ export type Namespace = {
   clusterName?: string
 }
 
+export type DependencyNode = {
+  id?: string
+  kind?: string
+  name?: string
+  namespace?: string
+  clusterName?: string
+  ready?: string
+  reason?: string
+  message?: string
+  suspended?: boolean
+  missing?: boolean
+}
+
+export type DependencyEdge = {
+  from?: string
+  to?: string
+  type?: string
+  dangling?: boolean
+}
+
+export type DependencyCycle = {
+  nodeIds?: string[]
+}
+
+export type ObjectDiff = {
+  action?: string
+  apiVersion?: string
+  kind?: string
+  namespace?: string
+  name?: string
+  diff?: string
+  error?: string
+}
+
+export type ReconciliationRecord = {
+  revision?: string
+  status?: string
+  reason?: string
+  message?: string
+  timestamp?: string
+  duration?: string
+}
+
 export type Event = {
   type?: string
   reason?: string
@@ -130,4 +193,104 @@ export type Event = {
   host?: string
   name?: string
   uid?: string
+}
+
+export type AuditEvent = {
+  timestamp?: string
+  requestId?: string
+  principal?: string
+  groups?: string[]
+  clusterName?: string
+  apiVersion?: string
+  kind?: string
+  namespace?: string
+  name?: string
+  action?: string
+  comment?: string
+  outcome?: string
+  error?: string
+}
+
+export type APIToken = {
+  id?: string
+  name?: string
+  owner?: string
+  groups?: string[]
+  scopes?: string[]
+  createdAt?: string
+  expiresAt?: string
+  lastUsedAt?: string
+}
+
+export type Session = {
+  id?: string
+  user?: string
+  createdAt?: string
+  expiresAt?: string
+}
+
+export type SearchResult = {
+  clusterName?: string
+  kind?: string
+  namespace?: string
+  name?: string
+  ready?: string
+  message?: string
+  sourceRef?: ObjectRef
+  score?: number
+}
+
+export type OwningAutomation = {
+  automation?: ObjectRef
+  sourceRef?: ObjectRef
+  foundBy?: string
+}
+
+export type ObjectDrift = {
+  apiVersion?: string
+  kind?: string
+  namespace?: string
+  name?: string
+  deleted?: boolean
+  fields?: FieldDrift[]
+  error?: string
+}
+
+export type FieldDrift = {
+  path?: string
+  applied?: string
+  live?: string
+}
+
+export type HelmReleaseRevision = {
+  version?: number
+  chartName?: string
+  chartVersion?: string
+  appVersion?: string
+  status?: string
+  description?: string
+  firstDeployed?: string
+  lastDeployed?: string
+  values?: string
+  valuesDiff?: string
+}
+
+export type ArtifactFile = {
+  path?: string
+  size?: string
+}
+
+export type TerraformObject = {
+  clusterName?: string
+  namespace?: string
+  name?: string
+  sourceRef?: ObjectRef
+  suspended?: boolean
+  ready?: string
+  message?: string
+  lastAppliedRevision?: string
+  lastPlannedRevision?: string
+  pendingPlan?: string
+  approvePlan?: string
+  storeReadablePlan?: string
 }
\ No newline at end of file
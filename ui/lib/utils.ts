@@ -9,7 +9,12 @@ import { AppVersion, repoUrl } from "../components/Version";
 import { ThemeTypes } from "../contexts/AppContext";
 import { AuthRoutes } from "../contexts/AuthContext";
 import { GetVersionResponse } from "./api/core/core.pb";
-import { Condition, Kind, ObjectRef } from "./api/core/types.pb";
+import {
+  Condition,
+  Kind,
+  ObjectRef,
+  ObjectResult,
+} from "./api/core/types.pb";
 import { Automation, HelmRelease, Kustomization } from "./objects";
 
 export function notifySuccess(message: string) {
@@ -20,6 +25,18 @@ export function notifyError(message: string) {
   toast["error"](`Error: ${message}`);
 }
 
+// failedResultsMessage describes the objects a bulk operation failed for,
+// or returns an empty string if it succeeded for all of them.
+export function failedResultsMessage(results: ObjectResult[] = []): string {
+  return results
+    .filter((r) => r.status === "failed")
+    .map(
+      (r) =>
+        `${r.object?.kind} ${r.object?.namespace}/${r.object?.name}: ${r.error}`,
+    )
+    .join(", ");
+}
+
 export function poller(cb, interval): any {
   if (process.env.NODE_ENV === "test") {
     // Stay synchronous in tests